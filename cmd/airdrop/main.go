@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	"go-evm-client/pkg/airdrop"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to send an airdrop
+	privateKey, rpc, tokenAddress, airdropFile string
+	statePath, output, policyFile, auditLog    string
+	maxInFlight, gasLimit, gasPrice            int
+	force, raw, checksumWarn                   bool
+	rpcTimeout                                 time.Duration
+	rpcRetries                                 int
+	rpcPolicy                                  string
+
+	// Flags needed by the airdrop
+	privateKeyFlag = cli.StringFlag{
+		Name:        "private, p",
+		Usage:       "Private key of the account sending the tokens.",
+		Destination: &privateKey,
+	}
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, several comma separated URLs fail over to each other.",
+		Destination: &rpc,
+	}
+	addressFlag = cli.StringFlag{
+		Name:        "address, a",
+		Usage:       "Address of the ERC20 token which is sent.",
+		Destination: &tokenAddress,
+	}
+	fileFlag = cli.StringFlag{
+		Name:        "file, f",
+		Usage:       "CSV file with one address,amount row per transfer, amounts follow the token amount rules of the interactor.",
+		Destination: &airdropFile,
+	}
+	stateFlag = cli.StringFlag{
+		Name:        "state, s",
+		Usage:       "File recording the progress of the airdrop, an existing one is resumed. Defaults to the airdrop file followed by .state.json.",
+		Destination: &statePath,
+	}
+	outputFlag = cli.StringFlag{
+		Name:        "output, o",
+		Usage:       "CSV file the transaction hash and status of every transfer are written to. Defaults to the airdrop file followed by .result.csv.",
+		Destination: &output,
+	}
+	maxInFlightFlag = cli.IntFlag{
+		Name:        "maxinflight, mif",
+		Usage:       "Number of transfers waiting to be mined at the same time.",
+		Value:       airdrop.DefaultMaxInFlight,
+		Destination: &maxInFlight,
+	}
+	gasLimitFlag = cli.IntFlag{
+		Name:        "gaslimit, gl",
+		Usage:       "Gas limit is the maximum amount of gas you are willing to pay for every transfer.",
+		Value:       100000,
+		Destination: &gasLimit,
+	}
+	gasPriceFlag = cli.IntFlag{
+		Name:        "gasprice, gp",
+		Usage:       "Gas Price is the amount you want to pay for every transfer.",
+		Value:       1000,
+		Destination: &gasPrice,
+	}
+	forceFlag = cli.BoolFlag{
+		Name:        "force",
+		Usage:       "Skip safety checks such as refusing to transfer tokens to the zero address.",
+		Destination: &force,
+	}
+	rawFlag = cli.BoolFlag{
+		Name:        "raw",
+		Usage:       "Take the amounts as integers of base units instead of scaling them by the token decimals.",
+		Destination: &raw,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address has an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+	policyFileFlag = cli.StringFlag{
+		Name:        "policy, pf",
+		Usage:       "Spending policy file the transactions must follow, the default file is only applied when it exists.",
+		Value:       cif.DefaultPolicyFile,
+		Destination: &policyFile,
+	}
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Append-only audit log recording every signed transaction, an empty value disables it.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+)
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "airdrop"
+	app.Usage = "Send tokens to many accounts from a CSV file on any chain!"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		privateKeyFlag,
+		evmRpcUrl,
+		addressFlag,
+		fileFlag,
+		stateFlag,
+		outputFlag,
+		maxInFlightFlag,
+		gasLimitFlag,
+		gasPriceFlag,
+		forceFlag,
+		rawFlag,
+		checksumWarnFlag,
+		policyFileFlag,
+		auditLogFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed airdrop exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{privateKey, rpc,
+		tokenAddress, airdropFile})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	airdropFacade, err := cif.NewAirdropFacade(
+		privateKey,
+		rpc,
+		tokenAddress,
+		airdropFile,
+		statePath,
+		output,
+		maxInFlight,
+		gasLimit,
+		gasPrice,
+		policyFile,
+		auditLog,
+		&utils.ArgParser{
+			AllowZeroAddress:  force,
+			WarnOnBadChecksum: checksumWarn,
+			RawAmounts:        raw,
+		},
+	)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := airdropFacade.SendAirdrop()
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
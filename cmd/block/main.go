@@ -0,0 +1,130 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to show a block
+	command, block string
+	rpc, format    string
+	batchSize      int
+	rpcTimeout     time.Duration
+	rpcRetries     int
+	rpcPolicy      string
+
+	// Flags needed by the block commands
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, several comma separated URLs fail over to each other.",
+		Destination: &rpc,
+	}
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+	batchSizeFlag = cli.IntFlag{
+		Name:        "batchsize, bs",
+		Usage:       "Number of receipts requested in a single JSON-RPC batch.",
+		Value:       ethrpc.DefaultBatchSize,
+		Destination: &batchSize,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+)
+
+// selectCommand records the command and the block given, the work itself
+// is done in main like the other CLIs
+func selectCommand(c *cli.Context) error {
+	command = c.Command.Name
+	block = c.Args().First()
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "block"
+	app.Usage = "Inspect the blocks of any chain!"
+	app.Version = "1.0.0"
+	app.Commands = []cli.Command{
+		{
+			Name:      "show",
+			Usage:     "Show the header fields, the gas usage and the transactions of a block: a number, a block hash, latest, pending or safe.",
+			ArgsUsage: "<block>",
+			Flags: []cli.Flag{
+				evmRpcUrl,
+				formatFlag,
+				batchSizeFlag,
+				rpcTimeoutFlag,
+				rpcRetriesFlag,
+				rpcPolicyFlag,
+			},
+			Action: selectCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed block command exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{command, block, rpc})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	inspect, err := cif.NewInspectFacade(rpc, format)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := inspect.ShowBlock(block, batchSize)
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to query the balances of many accounts
+	rpc, tokenAddress, addressFile, block, output string
+	batchSize                                     int
+	native, checksumWarn                          bool
+	rpcTimeout                                    time.Duration
+	rpcRetries                                    int
+	rpcPolicy                                     string
+
+	// Flags needed by the bulk query
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, several comma separated URLs fail over to each other.",
+		Destination: &rpc,
+	}
+	addressFlag = cli.StringFlag{
+		Name:        "address, a",
+		Usage:       "Address of the ERC20 token whose balances are read.",
+		Destination: &tokenAddress,
+	}
+	fileFlag = cli.StringFlag{
+		Name:        "file, f",
+		Usage:       "File with one account address per line, the first column of a CSV file is used.",
+		Destination: &addressFile,
+	}
+	blockFlag = cli.StringFlag{
+		Name:        "block, b",
+		Usage:       "Block at which every balance is read: a number, a block hash, latest or safe.",
+		Value:       "latest",
+		Destination: &block,
+	}
+	batchSizeFlag = cli.IntFlag{
+		Name:        "batchsize, bs",
+		Usage:       "Number of requests sent in a single JSON-RPC batch.",
+		Value:       ethrpc.DefaultBatchSize,
+		Destination: &batchSize,
+	}
+	nativeFlag = cli.BoolFlag{
+		Name:        "native, n",
+		Usage:       "Also read the native coin balance of every account.",
+		Destination: &native,
+	}
+	outputFlag = cli.StringFlag{
+		Name:        "output, o",
+		Usage:       "CSV file the balances are written to, defaults to the standard output.",
+		Destination: &output,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address has an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+)
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "bulkquery"
+	app.Usage = "Read the balances of many accounts at once on any chain!"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		evmRpcUrl,
+		addressFlag,
+		fileFlag,
+		blockFlag,
+		batchSizeFlag,
+		nativeFlag,
+		outputFlag,
+		checksumWarnFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed bulk query exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{rpc, addressFile})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	bulkQuery, err := cif.NewBulkQueryFacade(
+		rpc,
+		tokenAddress,
+		addressFile,
+		block,
+		batchSize,
+		native,
+		output,
+		&utils.ArgParser{WarnOnBadChecksum: checksumWarn},
+	)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := bulkQuery.QueryBalances()
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -6,8 +6,11 @@ import (
 	cst "go-evm-client/internal/constants"
 	cif "go-evm-client/internal/contract_interactor_facade"
 	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
 	"gopkg.in/urfave/cli.v1"
 	"os"
+	"strings"
+	"time"
 )
 
 var (
@@ -16,7 +19,16 @@ var (
 
 	// Variables needed to deploy contract
 	privateKey, rpc, contractType string
+	salt string
+	proxyKind, proxyAdmin, initData string
+	policyFile string
+	auditLog string
+	manifestFile string
 	gasLimit, gasPrice int
+	checksumWarn, raw bool
+	rpcTimeout time.Duration
+	rpcRetries int
+	rpcPolicy string
 	contractArguments cli.StringSlice
 
 	// Flags needed by the contract deployer
@@ -29,7 +41,7 @@ var (
 	evmRpcUrl = cli.StringFlag{
 		Name:        "rpc, r",
 		Usage:       "RPC URL of the EVM-compatible blockchain to deploy the " +
-			"contract on.",
+			"contract on, several comma separated URLs fail over to each other.",
 		Destination: &rpc,
 	}
 	gasLimitFlag = cli.IntFlag{
@@ -49,7 +61,8 @@ var (
 	contractFlag = cli.StringFlag{
 		Name:        "contract, c",
 		Usage:       "Name of the contract you want to deploy. Options: " +
-			"(detailed_test_token | fast_test_token )",
+			"(detailed_permit_token | detailed_test_token | fast_test_token | " +
+			"multicall3 | test_multi_token | test_nft | transparent_proxy )",
 		Destination: &contractType,
 	}
 	contractArgs = cli.StringSliceFlag{
@@ -58,6 +71,78 @@ var (
 			"constructor.",
 		Value: &contractArguments,
 	}
+	saltFlag = cli.StringFlag{
+		Name:        "salt, s",
+		Usage:       "Deploy through the CREATE2 factory with this salt, a hex " +
+			"value or any text which is hashed, the address is then the same on " +
+			"every chain.",
+		Destination: &salt,
+	}
+	proxyFlag = cli.StringFlag{
+		Name:        "proxy, px",
+		Usage:       "Deploy the contract as the implementation of a new EIP-1967 " +
+			"proxy. Options: (transparent )",
+		Destination: &proxyKind,
+	}
+	proxyAdminFlag = cli.StringFlag{
+		Name:        "proxyadmin, pa",
+		Usage:       "Admin of the transparent proxy, an account or ProxyAdmin " +
+			"contract other than the one used to call the implementation.",
+		Destination: &proxyAdmin,
+	}
+	initDataFlag = cli.StringFlag{
+		Name:        "initdata, id",
+		Usage:       "Hex encoded initializer call data the proxy delegates to the " +
+			"implementation when it is deployed.",
+		Destination: &initData,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	rawFlag = cli.BoolFlag{
+		Name:        "raw",
+		Usage:       "Take the token amount constructor argument as an integer of base units instead of scaling it by the token decimals.",
+		Destination: &raw,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address argument has " +
+			"an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+	policyFileFlag = cli.StringFlag{
+		Name:        "policy, pf",
+		Usage:       "Spending policy file the transactions must follow, the default file is only applied when it exists.",
+		Value:       cif.DefaultPolicyFile,
+		Destination: &policyFile,
+	}
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Append-only audit log recording every signed transaction, an empty value disables it.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+	manifestFileFlag = cli.StringFlag{
+		Name:        "manifest, mf",
+		Usage:       "Deployment manifest recording every deployed contract, an empty value disables it.",
+		Value:       cif.DefaultManifest,
+		Destination: &manifestFile,
+	}
 )
 
 // Start the CLI application with the required data
@@ -73,6 +158,18 @@ func init() {
 		gasPriceFlag,
 		contractFlag,
 		contractArgs,
+		saltFlag,
+		proxyFlag,
+		proxyAdminFlag,
+		initDataFlag,
+		rawFlag,
+		checksumWarnFlag,
+		policyFileFlag,
+		auditLogFlag,
+		manifestFileFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
 	}
 	if err := app.Run(os.Args); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err)
@@ -101,14 +198,40 @@ func main() {
 		exitProgramMsg()
 		os.Exit(1)
 	}
+	// Verify the proxy settings, the implementation is deployed normally
+	// before the proxy in front of it
+	err := verifyProxyFlags()
+	if err != nil {
+		fmt.Printf("%v \n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
 	// Create the contract interactor object to easily interact with the contract
 	contractInteractor, err := cif.NewContractDeployerFacade(
 		privateKey,
 		rpc,
 		contractArguments,
 		contractType,
-		gasLimit,
-		gasPrice,
+		cif.FacadeOptions{
+			GasLimit:     gasLimit,
+			GasPrice:     gasPrice,
+			PolicyPath:   policyFile,
+			AuditPath:    auditLog,
+			ManifestPath: manifestFile,
+			ArgParser: &utils.ArgParser{
+				WarnOnBadChecksum: checksumWarn,
+				RawAmounts:        raw,
+			},
+			Salt:         salt,
+			ProxyKind:    proxyKind,
+			ProxyAdmin:   proxyAdmin,
+			InitData:     initData,
+		},
 	)
 	if err != nil {
 		fmt.Printf("%v \n", err)
@@ -118,10 +241,44 @@ func main() {
 	// Using the interactor attempt to deploy the contract
 	err1 := contractInteractor.DeployContract()
 	if err1 != nil {
-		fmt.Printf("%v \n", err)
+		fmt.Printf("%v \n", err1)
 		exitProgramMsg()
 		os.Exit(1)
 	}
 	fmt.Println("Contract deployer finished successfully!")
 	os.Exit(0)
 }
+
+// verifyProxyFlags checks that the proxy flags are only used together with
+// a proxy kind and that the transparent proxy is given its admin
+func verifyProxyFlags() error {
+	if len(proxyKind) == 0 {
+		if len(proxyAdmin) != 0 || len(initData) != 0 {
+			return errors.New("error: --proxyadmin and --initdata can only be " +
+				"used with --proxy")
+		}
+		return nil
+	}
+	if proxyKind == "uups" {
+		return errors.New("error: UUPS proxies can't be deployed, the " +
+			"contracts this tool deploys don't implement the upgrade " +
+			"functions a UUPS proxy forwards to its implementation, use " +
+			"--proxy transparent, existing UUPS proxies can be loaded with " +
+			"--contract uups_proxy")
+	}
+	if proxyKind != "transparent" {
+		return fmt.Errorf("error: Unsupported proxy kind %s", proxyKind)
+	}
+	if strings.HasSuffix(contractType, "_proxy") {
+		return errors.New("error: a proxy can't be the implementation of " +
+			"another proxy")
+	}
+	if len(salt) != 0 {
+		return errors.New("error: --salt can't be used with --proxy")
+	}
+	if len(proxyAdmin) == 0 {
+		return errors.New("error: a transparent proxy requires its admin " +
+			"with --proxyadmin")
+	}
+	return nil
+}
@@ -6,9 +6,12 @@ import (
 	cst "go-evm-client/internal/constants"
 	cif "go-evm-client/internal/contract_interactor_facade"
 	"go-evm-client/internal/utils"
+	mc3 "go-evm-client/pkg/contracts/multicall3"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
 	"gopkg.in/urfave/cli.v1"
 	"os"
 	"strings"
+	"time"
 )
 
 var (
@@ -17,8 +20,16 @@ var (
 
 	// Variables needed to load contract and interact with contract
 	privateKey, rpc, contractType, contractAddress, funcName string
+	block, callerAddress string
+	multicallAddress, relayerKey, policyFile string
+	auditLog string
+	manifestFile string
 	funcArguments cli.StringSlice
 	gasLimit, gasPrice int
+	force, skipPreflight, raw, checksumWarn, multicall bool
+	rpcTimeout time.Duration
+	rpcRetries int
+	rpcPolicy string
 
 	// Flags needed by the contract deployer
 	privateKeyFlag = cli.StringFlag{
@@ -28,7 +39,7 @@ var (
 	}
 	evmRpcUrl = cli.StringFlag{
 		Name:        "rpc, r",
-		Usage:       "RPC URL of the EVM-compatible blockchain where the contract is deployed.",
+		Usage:       "RPC URL of the EVM-compatible blockchain where the contract is deployed, several comma separated URLs fail over to each other.",
 		Destination: &rpc,
 	}
 	gasLimitFlag = cli.IntFlag{
@@ -47,7 +58,7 @@ var (
 	}
 	contractFlag = cli.StringFlag{
 		Name:        "contract, c",
-		Usage:       "Name of the contract you want to deploy. Options: (detailed_test_token | fast_test_token ).",
+		Usage:       "Name of the contract you want to interact with, detected from the deployed contract when omitted. Options: (detailed_permit_token | detailed_test_token | fast_test_token | multicall3 | test_multi_token | test_nft | transparent_proxy | uups_proxy ).",
 		Destination: &contractType,
 	}
 	addressFlag = cli.StringFlag{
@@ -57,7 +68,7 @@ var (
 	}
 	funNameFlag = cli.StringFlag{
 		Name:        "function, f",
-		Usage:       "The name of the function which you wish to execute in the contract.",
+		Usage:       "The name of the function which you wish to execute in the contract, several comma separated queries with --multicall.",
 		Destination: &funcName,
 	}
 	funcArgs = cli.StringSliceFlag{
@@ -65,6 +76,89 @@ var (
 		Usage: "List of function arguments that are used in the contract function.",
 		Value: &funcArguments,
 	}
+	blockFlag = cli.StringFlag{
+		Name:        "block, b",
+		Usage:       "Block at which queries are executed: a number, a block hash, latest, pending or safe.",
+		Value:       "latest",
+		Destination: &block,
+	}
+	fromFlag = cli.StringFlag{
+		Name:        "from",
+		Usage:       "Address used as msg.sender for queries, defaults to the account of the private key.",
+		Destination: &callerAddress,
+	}
+	multicallFlag = cli.BoolFlag{
+		Name:        "multicall, mc",
+		Usage:       "Aggregate the queries into a single atomic call through Multicall3, balanceof takes one account per argument.",
+		Destination: &multicall,
+	}
+	multicallAddressFlag = cli.StringFlag{
+		Name:        "multicalladdress, mca",
+		Usage:       "Address of the Multicall3 contract used with --multicall.",
+		Value:       mc3.CanonicalAddress,
+		Destination: &multicallAddress,
+	}
+	relayerFlag = cli.StringFlag{
+		Name:        "relayer, rl",
+		Usage:       "Private key of the account submitting the permits signed by the account of the private key, which then needs no gas.",
+		Destination: &relayerKey,
+	}
+	forceFlag = cli.BoolFlag{
+		Name:        "force",
+		Usage:       "Allow transfers of tokens to the zero address.",
+		Destination: &force,
+	}
+	skipPreflightFlag = cli.BoolFlag{
+		Name:        "skip-preflight, sp",
+		Usage:       "Send ERC20 transfers without first checking the balance, allowance and gas funds.",
+		Destination: &skipPreflight,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	rawFlag = cli.BoolFlag{
+		Name:        "raw",
+		Usage:       "Take the token amounts as integers of base units instead of scaling them by the token decimals.",
+		Destination: &raw,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address has an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+	policyFileFlag = cli.StringFlag{
+		Name:        "policy, pf",
+		Usage:       "Spending policy file the transactions must follow, the default file is only applied when it exists.",
+		Value:       cif.DefaultPolicyFile,
+		Destination: &policyFile,
+	}
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Append-only audit log recording every signed transaction, an empty value disables it.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+	manifestFileFlag = cli.StringFlag{
+		Name:        "manifest, mf",
+		Usage:       "Deployment manifest the deployer of the contract is read from, an empty value disables it.",
+		Value:       cif.DefaultManifest,
+		Destination: &manifestFile,
+	}
 )
 
 // Start the CLI application with the required data
@@ -82,6 +176,21 @@ func init() {
 		addressFlag,
 		funNameFlag,
 		funcArgs,
+		blockFlag,
+		fromFlag,
+		multicallFlag,
+		multicallAddressFlag,
+		relayerFlag,
+		forceFlag,
+		skipPreflightFlag,
+		rawFlag,
+		checksumWarnFlag,
+		policyFileFlag,
+		auditLogFlag,
+		manifestFileFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
 	}
 	if err := app.Run(os.Args); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err)
@@ -98,39 +207,64 @@ func main() {
 	funcName = strings.ToLower(funcName)
 	// Verify that the required string arguments
 	okFlag := utils.RequiredFlagVerification(&[]string{
-		privateKey, rpc, contractType, contractAddress, funcName})
+		privateKey, rpc, contractAddress, funcName})
 	if !okFlag {
 		err := errors.New("error: Missing required arguments")
 		fmt.Printf("%v\n", err)
 		exitProgramMsg()
 		os.Exit(1)
 	}
-	// Verify if the contract type exists
-	okType := cst.VerifyContractTypeExists(contractType)
-	if !okType {
-		err := fmt.Errorf("error: Unsupported contract type %s", contractType)
-		fmt.Printf("%v\n", err)
-		exitProgramMsg()
-		os.Exit(1)
+	// Verify if the contract type exists and its function names, only
+	// queries can be aggregated. Without a contract type it is detected
+	// and verified once connected.
+	funcNames := strings.Split(funcName, ",")
+	if len(contractType) != 0 {
+		okType := cst.VerifyContractTypeExists(contractType)
+		if !okType {
+			err := fmt.Errorf("error: Unsupported contract type %s", contractType)
+			fmt.Printf("%v\n", err)
+			exitProgramMsg()
+			os.Exit(1)
+		}
+		err := cst.VerifyFunctionNames(contractType, funcNames, multicall)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			exitProgramMsg()
+			os.Exit(1)
+		}
 	}
-	// Verify if the function name exists
-	okFuncName := cst.VerifyFunctionNameExists(contractType, funcName)
-	if !okFuncName {
-		err := fmt.Errorf("error: Unsupported function name %s for contract " +
-			"type %s", funcName, contractType)
-		fmt.Printf("%v\n", err)
-		exitProgramMsg()
-		os.Exit(1)
+	if !multicall {
+		multicallAddress = ""
 	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
 	contractExecutor, err := cif.NewContractExecutionFacade(
 		privateKey,
 		rpc,
 		contractType,
 		contractAddress,
-		funcName,
+		funcNames,
 		funcArguments,
-		gasLimit,
-		gasPrice,
+		cif.FacadeOptions{
+			GasLimit:     gasLimit,
+			GasPrice:     gasPrice,
+			PolicyPath:   policyFile,
+			AuditPath:    auditLog,
+			ManifestPath: manifestFile,
+			ArgParser: &utils.ArgParser{
+				AllowZeroAddress:  force,
+				WarnOnBadChecksum: checksumWarn,
+				RawAmounts:        raw,
+			},
+			Block:            block,
+			CallerAddress:    callerAddress,
+			MulticallAddress: multicallAddress,
+			RelayerKey:       relayerKey,
+			SkipPreflight:    skipPreflight,
+		},
 	)
 	if err != nil {
 		fmt.Printf("%v\n", err)
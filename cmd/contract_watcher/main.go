@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cst "go-evm-client/internal/constants"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"strings"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to watch the events of a contract
+	rpc, contractType, contractAddress, eventName string
+	fromAddresses, toAddresses                    cli.StringSlice
+	pollInterval                                  time.Duration
+	pageSize                                      uint64
+	checksumWarn                                  bool
+	rpcTimeout                                    time.Duration
+	rpcRetries                                    int
+	rpcPolicy                                     string
+
+	// Flags needed by the events watcher
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, use a ws:// URL to stream events through subscriptions.",
+		Destination: &rpc,
+	}
+	contractFlag = cli.StringFlag{
+		Name:        "contract, c",
+		Usage:       "Name of the contract whose events are watched. Options: (detailed_permit_token | detailed_test_token | fast_test_token | test_multi_token | test_nft | transparent_proxy | uups_proxy ).",
+		Destination: &contractType,
+	}
+	addressFlag = cli.StringFlag{
+		Name:        "address, a",
+		Usage:       "Address of the contract.",
+		Destination: &contractAddress,
+	}
+	eventFlag = cli.StringFlag{
+		Name:        "event, e",
+		Usage:       "The name of the event to watch. Options: (transfer | approval | approvalforall | ownershiptransferred | transfersingle | transferbatch | upgraded | adminchanged ).",
+		Destination: &eventName,
+	}
+	pollIntervalFlag = cli.DurationFlag{
+		Name:        "pollinterval, pi",
+		Usage:       "Interval between polls for new events when the endpoint doesn't support subscriptions.",
+		Value:       5 * time.Second,
+		Destination: &pollInterval,
+	}
+	fromAddrFlag = cli.StringSliceFlag{
+		Name:  "from-addr",
+		Usage: "Only return events whose first indexed address (from, owner, account, previousOwner) matches, the operator of ERC1155 transfers is skipped.",
+		Value: &fromAddresses,
+	}
+	toAddrFlag = cli.StringSliceFlag{
+		Name:  "to-addr",
+		Usage: "Only return events whose second indexed address (to, spender, approved, operator, newOwner) matches.",
+		Value: &toAddresses,
+	}
+	pageSizeFlag = cli.Uint64Flag{
+		Name:        "pagesize, ps",
+		Usage:       "Number of blocks requested at once when catching up, halved automatically when the node refuses a range.",
+		Value:       5000,
+		Destination: &pageSize,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address has an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+)
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "watcher"
+	app.Usage = "Stream the events of solidity contracts on any chain!"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		evmRpcUrl,
+		contractFlag,
+		addressFlag,
+		eventFlag,
+		fromAddrFlag,
+		toAddrFlag,
+		pollIntervalFlag,
+		pageSizeFlag,
+		checksumWarnFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed contract events watch exiting program!")
+}
+
+func main() {
+	// Convert the event name to lowercase for ease of user use
+	eventName = strings.ToLower(eventName)
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{
+		rpc, contractType, contractAddress, eventName})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Verify if the contract type exists
+	okType := cst.VerifyContractTypeExists(contractType)
+	if !okType {
+		err := fmt.Errorf("error: Unsupported contract type %s", contractType)
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Verify if the event name exists
+	okEventName := cst.VerifyEventNameExists(contractType, eventName)
+	if !okEventName {
+		err := fmt.Errorf("error: Unsupported event name %s for contract "+
+			"type %s", eventName, contractType)
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	contractWatcher, err := cif.NewContractWatchFacade(
+		rpc,
+		contractType,
+		contractAddress,
+		eventName,
+		fromAddresses,
+		toAddresses,
+		pollInterval,
+		pageSize,
+		&utils.ArgParser{WarnOnBadChecksum: checksumWarn},
+	)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := contractWatcher.WatchEvents()
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to decode calldata and logs
+	command, calldata string
+	abiDir, sigFiles  string
+	format            string
+	topics, logData   string
+
+	// Flags needed by the decode commands
+	abiDirFlag = cli.StringFlag{
+		Name:        "abidir, a",
+		Usage:       "Directory of .abi and .json ABI files tried on top of the supported contracts.",
+		Value:       cif.DefaultAbiDir,
+		Destination: &abiDir,
+	}
+	sigFilesFlag = cli.StringFlag{
+		Name:        "sigfile, s",
+		Usage:       "Comma separated 4byte-style signature files naming unknown selectors and topics.",
+		Destination: &sigFiles,
+	}
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+	topicsFlag = cli.StringFlag{
+		Name:        "topics, t",
+		Usage:       "Comma separated topics of the log, starting with the event topic.",
+		Destination: &topics,
+	}
+	dataFlag = cli.StringFlag{
+		Name:        "data, d",
+		Usage:       "Data of the log in hex, empty when every argument is indexed.",
+		Destination: &logData,
+	}
+)
+
+// selectCommand records the command and the calldata given, the work
+// itself is done in main like the other CLIs
+func selectCommand(c *cli.Context) error {
+	command = c.Command.Name
+	calldata = c.Args().First()
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "decode"
+	app.Usage = "Decode calldata and event logs with the ABIs of the supported contracts and of an ABI directory!"
+	app.Version = "1.0.0"
+	app.Commands = []cli.Command{
+		{
+			Name:      "calldata",
+			Usage:     "Identify the function called by the calldata from its selector and decode its arguments.",
+			ArgsUsage: "<calldata>",
+			Flags: []cli.Flag{
+				abiDirFlag,
+				sigFilesFlag,
+				formatFlag,
+			},
+			Action: selectCommand,
+		},
+		{
+			Name:  "log",
+			Usage: "Identify the event of a log from its first topic and decode its arguments.",
+			Flags: []cli.Flag{
+				abiDirFlag,
+				sigFilesFlag,
+				formatFlag,
+				topicsFlag,
+				dataFlag,
+			},
+			Action: selectCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed decode command exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments, the log data is optional
+	required := []string{command, calldata}
+	if command == "log" {
+		required = []string{command, topics}
+	}
+	okFlag := utils.RequiredFlagVerification(&required)
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	decoder, err := cif.NewAbiFacade(abiDir, sigFiles, format)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	var err1 error
+	switch command {
+	case "calldata":
+		err1 = decoder.DecodeCalldata(calldata)
+	case "log":
+		err1 = decoder.DecodeLog(topics, logData)
+	}
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to encode calldata
+	signature, format string
+	funcArguments     []string
+
+	// Flags needed by the encode command
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text, the calldata only, or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+)
+
+// selectArgs records the signature and the arguments given, the work
+// itself is done in main like the other CLIs
+func selectArgs(c *cli.Context) error {
+	signature = c.Args().First()
+	funcArguments = c.Args().Tail()
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "encode"
+	app.Usage = "Encode the calldata of a function call from its signature and arguments!"
+	app.ArgsUsage = "<signature> [arguments...]"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		formatFlag,
+	}
+	app.Action = selectArgs
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed encode command exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{signature})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// No ABI nor signature file is needed, the signature describes the
+	// function
+	encoder, err := cif.NewAbiFacade("", "", format)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := encoder.Encode(signature, funcArguments)
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to show the transaction history
+	auditLog, rpc, format string
+	from, to, function    string
+	status, since, until  string
+	chainId               uint64
+	limit                 int
+	rpcTimeout            time.Duration
+	rpcRetries            int
+	rpcPolicy             string
+
+	// Flags needed by the history command
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Audit log written by the commands sending transactions.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL used to fetch the receipts of the pending transactions sent to its network, none is fetched without it.",
+		Destination: &rpc,
+	}
+	fromFlag = cli.StringFlag{
+		Name:        "from",
+		Usage:       "Only show the transactions sent by this address.",
+		Destination: &from,
+	}
+	toFlag = cli.StringFlag{
+		Name:        "to",
+		Usage:       "Only show the transactions sent to this address.",
+		Destination: &to,
+	}
+	chainIdFlag = cli.Uint64Flag{
+		Name:        "chainid, c",
+		Usage:       "Only show the transactions of this chain ID.",
+		Destination: &chainId,
+	}
+	functionFlag = cli.StringFlag{
+		Name:        "function, fn",
+		Usage:       "Only show the calls of this function, given by name or full signature.",
+		Destination: &function,
+	}
+	statusFlag = cli.StringFlag{
+		Name:        "status, s",
+		Usage:       "Only show the transactions with this status: pending, success or reverted.",
+		Destination: &status,
+	}
+	sinceFlag = cli.StringFlag{
+		Name:        "since",
+		Usage:       "Only show the transactions signed from this day (2006-01-02) or RFC 3339 time.",
+		Destination: &since,
+	}
+	untilFlag = cli.StringFlag{
+		Name:        "until",
+		Usage:       "Only show the transactions signed up to this day (2006-01-02) or RFC 3339 time.",
+		Destination: &until,
+	}
+	limitFlag = cli.IntFlag{
+		Name:        "limit, n",
+		Usage:       "Only show the latest transactions, 0 shows all of them.",
+		Destination: &limit,
+	}
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+)
+
+// storeFlags leaves the work to main like the other CLIs, the flags are
+// already stored in their destination. Without an action the help would be
+// printed on every run and break the JSON output.
+func storeFlags(c *cli.Context) error {
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "history"
+	app.Usage = "Show the transactions signed by the commands of this repository!"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		auditLogFlag,
+		evmRpcUrl,
+		fromFlag,
+		toFlag,
+		chainIdFlag,
+		functionFlag,
+		statusFlag,
+		sinceFlag,
+		untilFlag,
+		limitFlag,
+		formatFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+	}
+	app.Action = storeFlags
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed history command exiting program!")
+}
+
+func main() {
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	history, err := cif.NewHistoryFacade(auditLog, rpc, from, to, chainId,
+		function, status, since, until, limit, format)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := history.Show()
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to generate load
+	privateKey, rpc, tokenAddress            string
+	fundAmount, tokenAmount                  string
+	senders, concurrency, gasLimit, gasPrice int
+	rate                                     float64
+	duration, pollInterval, inclusionTimeout time.Duration
+	raw, checksumWarn                        bool
+	rpcTimeout                               time.Duration
+	rpcRetries                               int
+	rpcPolicy                                string
+	policyFile, auditLog                     string
+
+	// Flags needed by the load test
+	privateKeyFlag = cli.StringFlag{
+		Name:        "private, p",
+		Usage:       "Private key of the account funding the senders, which are derived from it.",
+		Destination: &privateKey,
+	}
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, several comma separated URLs fail over to each other.",
+		Destination: &rpc,
+	}
+	addressFlag = cli.StringFlag{
+		Name:        "address, a",
+		Usage:       "Address of the ERC20 token transferred, native coins are sent when omitted.",
+		Destination: &tokenAddress,
+	}
+	sendersFlag = cli.IntFlag{
+		Name:        "senders, n",
+		Usage:       "Number of accounts sending transactions.",
+		Value:       10,
+		Destination: &senders,
+	}
+	rateFlag = cli.Float64Flag{
+		Name:        "rate, tps",
+		Usage:       "Transactions submitted per second, 0 submits them as fast as the concurrency allows.",
+		Destination: &rate,
+	}
+	concurrencyFlag = cli.IntFlag{
+		Name:        "concurrency, cc",
+		Usage:       "Maximum number of transactions waiting to be included at the same time.",
+		Value:       100,
+		Destination: &concurrency,
+	}
+	durationFlag = cli.DurationFlag{
+		Name:        "duration, d",
+		Usage:       "How long transactions are submitted.",
+		Value:       30 * time.Second,
+		Destination: &duration,
+	}
+	fundFlag = cli.StringFlag{
+		Name:        "fund",
+		Usage:       "Native balance every sender is topped up to, in wei or in decimal coins such as 0.5.",
+		Value:       "1.0",
+		Destination: &fundAmount,
+	}
+	tokenFundFlag = cli.StringFlag{
+		Name:        "tokenfund",
+		Usage:       "Token balance every sender is topped up to, amounts follow the token amount rules of the interactor.",
+		Value:       "1",
+		Destination: &tokenAmount,
+	}
+	pollIntervalFlag = cli.DurationFlag{
+		Name:        "pollinterval, pi",
+		Usage:       "Interval between two checks for the inclusion of the transactions.",
+		Value:       time.Second,
+		Destination: &pollInterval,
+	}
+	inclusionTimeoutFlag = cli.DurationFlag{
+		Name:        "inclusiontimeout, it",
+		Usage:       "How long the transactions still pending at the end of the duration are waited for.",
+		Value:       time.Minute,
+		Destination: &inclusionTimeout,
+	}
+	gasLimitFlag = cli.IntFlag{
+		Name:        "gaslimit, gl",
+		Usage:       "Gas limit is the maximum amount of gas you are willing to pay for every transaction.",
+		Value:       100000,
+		Destination: &gasLimit,
+	}
+	gasPriceFlag = cli.IntFlag{
+		Name:        "gasprice, gp",
+		Usage:       "Gas Price is the amount you want to pay for every transaction.",
+		Value:       1000,
+		Destination: &gasPrice,
+	}
+	rawFlag = cli.BoolFlag{
+		Name:        "raw",
+		Usage:       "Take the token amount as an integer of base units instead of scaling it by the token decimals.",
+		Destination: &raw,
+	}
+	checksumWarnFlag = cli.BoolFlag{
+		Name:        "checksumwarn, cw",
+		Usage:       "Only warn instead of failing when an address has an invalid EIP-55 checksum.",
+		Destination: &checksumWarn,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	policyFileFlag = cli.StringFlag{
+		Name:        "policy, pf",
+		Usage:       "Spending policy file the funding transactions must follow, the default file is only applied when it exists.",
+		Value:       cif.DefaultPolicyFile,
+		Destination: &policyFile,
+	}
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Append-only audit log recording every funding transaction, an empty value disables it.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+)
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "loadtest"
+	app.Usage = "Generate transaction load on any chain!"
+	app.Version = "1.0.0"
+	app.Flags = []cli.Flag{
+		privateKeyFlag,
+		evmRpcUrl,
+		addressFlag,
+		sendersFlag,
+		rateFlag,
+		concurrencyFlag,
+		durationFlag,
+		fundFlag,
+		tokenFundFlag,
+		pollIntervalFlag,
+		inclusionTimeoutFlag,
+		gasLimitFlag,
+		gasPriceFlag,
+		rawFlag,
+		checksumWarnFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+		policyFileFlag,
+		auditLogFlag,
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed load test exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	okFlag := utils.RequiredFlagVerification(&[]string{privateKey, rpc})
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	loadTest, err := cif.NewLoadTestFacade(
+		privateKey,
+		rpc,
+		tokenAddress,
+		senders,
+		rate,
+		concurrency,
+		duration,
+		fundAmount,
+		tokenAmount,
+		pollInterval,
+		inclusionTimeout,
+		gasLimit,
+		gasPrice,
+		policyFile,
+		auditLog,
+		&utils.ArgParser{WarnOnBadChecksum: checksumWarn, RawAmounts: raw},
+	)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	err1 := loadTest.RunLoadTest()
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to query the signature database
+	command, query   string
+	abiDir, sigFiles string
+	format           string
+
+	// Flags needed by the sig commands
+	abiDirFlag = cli.StringFlag{
+		Name:        "abidir, a",
+		Usage:       "Directory of .abi and .json ABI files indexed on top of the supported contracts.",
+		Value:       cif.DefaultAbiDir,
+		Destination: &abiDir,
+	}
+	sigFilesFlag = cli.StringFlag{
+		Name:        "sigfile, s",
+		Usage:       "Comma separated 4byte-style signature files to index.",
+		Destination: &sigFiles,
+	}
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+)
+
+// selectCommand records the command and the query given, the work itself
+// is done in main like the other CLIs
+func selectCommand(c *cli.Context) error {
+	command = c.Command.Name
+	query = c.Args().First()
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "sig"
+	app.Usage = "Look up function selectors and event topics in an offline signature database!"
+	app.Version = "1.0.0"
+	app.Commands = []cli.Command{
+		{
+			Name:      "lookup",
+			Usage:     "List the signatures of a 4 byte selector, of a 32 byte event topic or of the key of a text signature.",
+			ArgsUsage: "<selector|topic|signature>",
+			Flags: []cli.Flag{
+				abiDirFlag,
+				sigFilesFlag,
+				formatFlag,
+			},
+			Action: selectCommand,
+		},
+		{
+			Name:  "collisions",
+			Usage: "List the selectors and topics shared by several signatures.",
+			Flags: []cli.Flag{
+				abiDirFlag,
+				sigFilesFlag,
+				formatFlag,
+			},
+			Action: selectCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed sig command exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments
+	required := []string{command, query}
+	if command == "collisions" {
+		required = []string{command}
+	}
+	okFlag := utils.RequiredFlagVerification(&required)
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	signatures, err := cif.NewSignatureFacade(abiDir, sigFiles, format)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	var err1 error
+	switch command {
+	case "lookup":
+		err1 = signatures.Lookup(query)
+	case "collisions":
+		err1 = signatures.Collisions()
+	}
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
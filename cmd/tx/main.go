@@ -0,0 +1,209 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	cif "go-evm-client/internal/contract_interactor_facade"
+	"go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"gopkg.in/urfave/cli.v1"
+	"os"
+	"time"
+)
+
+var (
+	// CLI Application
+	app *cli.App
+
+	// Variables needed to show or replace a transaction
+	command, txHash string
+	privateKey, rpc string
+	format          string
+	bumpPercent     int
+	waitTimeout     time.Duration
+	rpcTimeout      time.Duration
+	rpcRetries      int
+	rpcPolicy       string
+	policyFile      string
+	auditLog        string
+
+	// Flags shared by the transaction commands
+	privateKeyFlag = cli.StringFlag{
+		Name:        "private, p",
+		Usage:       "Private key of the account which sent the pending transaction.",
+		Destination: &privateKey,
+	}
+	evmRpcUrl = cli.StringFlag{
+		Name:        "rpc, r",
+		Usage:       "RPC URL of the EVM-compatible blockchain, several comma separated URLs fail over to each other.",
+		Destination: &rpc,
+	}
+	formatFlag = cli.StringFlag{
+		Name:        "format, f",
+		Usage:       "Output format: text or json.",
+		Value:       cif.TextFormat,
+		Destination: &format,
+	}
+	bumpFlag = cli.IntFlag{
+		Name:        "bump, b",
+		Usage:       "Percentage the fees of the pending transaction are bumped by, nodes require at least 10.",
+		Value:       ethrpc.MinPriceBump,
+		Destination: &bumpPercent,
+	}
+	waitFlag = cli.DurationFlag{
+		Name:        "wait, w",
+		Usage:       "How long to wait for the original or the replacement transaction to be mined, 0 doesn't wait.",
+		Value:       5 * time.Minute,
+		Destination: &waitTimeout,
+	}
+	rpcTimeoutFlag = cli.DurationFlag{
+		Name:        "rpctimeout, rt",
+		Usage:       "Timeout of every RPC call, failed attempts count as a retry.",
+		Value:       ethrpc.DefaultRetryConfig.CallTimeout,
+		Destination: &rpcTimeout,
+	}
+	rpcRetriesFlag = cli.IntFlag{
+		Name:        "rpcretries, rr",
+		Usage:       "Number of retries with exponential backoff for transient RPC failures.",
+		Value:       ethrpc.DefaultRetryConfig.MaxRetries,
+		Destination: &rpcRetries,
+	}
+	rpcPolicyFlag = cli.StringFlag{
+		Name:        "rpcpolicy, rp",
+		Usage:       "How reads are spread when several comma separated RPC URLs are given: roundrobin or freshest.",
+		Value:       ethrpc.DefaultEndpointConfig.ReadPolicy,
+		Destination: &rpcPolicy,
+	}
+	policyFileFlag = cli.StringFlag{
+		Name:        "policy, pf",
+		Usage:       "Spending policy file the replacements must follow, the default file is only applied when it exists.",
+		Value:       cif.DefaultPolicyFile,
+		Destination: &policyFile,
+	}
+	auditLogFlag = cli.StringFlag{
+		Name:        "auditlog, al",
+		Usage:       "Append-only audit log recording every signed transaction, an empty value disables it.",
+		Value:       cif.DefaultAuditLog,
+		Destination: &auditLog,
+	}
+	txFlags = []cli.Flag{
+		privateKeyFlag,
+		evmRpcUrl,
+		bumpFlag,
+		waitFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+		policyFileFlag,
+		auditLogFlag,
+	}
+	showFlags = []cli.Flag{
+		evmRpcUrl,
+		formatFlag,
+		rpcTimeoutFlag,
+		rpcRetriesFlag,
+		rpcPolicyFlag,
+	}
+)
+
+// selectCommand records the command and the transaction hash given, the
+// work itself is done in main like the other CLIs
+func selectCommand(c *cli.Context) error {
+	command = c.Command.Name
+	txHash = c.Args().First()
+	return nil
+}
+
+// Start the CLI application with the required data
+func init() {
+	app = cli.NewApp()
+	app.Name = "tx"
+	app.Usage = "Show, speed up or cancel transactions on any chain!"
+	app.Version = "1.0.0"
+	app.Commands = []cli.Command{
+		{
+			Name:      "show",
+			Usage:     "Show the transaction with its decoded calldata, receipt and decoded logs.",
+			ArgsUsage: "<hash>",
+			Flags:     showFlags,
+			Action:    selectCommand,
+		},
+		{
+			Name:      "speedup",
+			Usage:     "Send the pending transaction again with the same nonce and bumped fees.",
+			ArgsUsage: "<hash>",
+			Flags:     txFlags,
+			Action:    selectCommand,
+		},
+		{
+			Name:      "cancel",
+			Usage:     "Replace the pending transaction with a zero value transfer to yourself.",
+			ArgsUsage: "<hash>",
+			Flags:     txFlags,
+			Action:    selectCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func exitProgramMsg() {
+	fmt.Println("Failed transaction command exiting program!")
+}
+
+func main() {
+	// Verify that the required string arguments, only the replacements
+	// need the private key of the sender
+	required := []string{command, txHash, rpc}
+	if command != "show" {
+		required = append(required, privateKey)
+	}
+	okFlag := utils.RequiredFlagVerification(&required)
+	if !okFlag {
+		err := errors.New("error: Missing required arguments")
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	// Apply the RPC timeout, retry and endpoint settings to every client
+	// created
+	ethrpc.DefaultRetryConfig.CallTimeout = rpcTimeout
+	ethrpc.DefaultRetryConfig.MaxRetries = rpcRetries
+	ethrpc.DefaultEndpointConfig.ReadPolicy = rpcPolicy
+	if command == "show" {
+		inspect, err := cif.NewInspectFacade(rpc, format)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			exitProgramMsg()
+			os.Exit(1)
+		}
+		err1 := inspect.ShowTransaction(txHash)
+		if err1 != nil {
+			fmt.Printf("%v\n", err1)
+			exitProgramMsg()
+			os.Exit(1)
+		}
+		return
+	}
+	tx, err := cif.NewTxFacade(privateKey, rpc, txHash, bumpPercent,
+		waitTimeout, policyFile, auditLog)
+	if err != nil {
+		fmt.Printf("%v\n", err)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+	var err1 error
+	switch command {
+	case "speedup":
+		err1 = tx.SpeedUp()
+	case "cancel":
+		err1 = tx.Cancel()
+	}
+	if err1 != nil {
+		fmt.Printf("%v\n", err1)
+		exitProgramMsg()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+/**
+ * @dev Variant of the DetailedTestToken implementing the EIP-2612 permit
+ * extension: the owner of the tokens signs an EIP-712 Permit message off
+ * chain and any account can submit it to set the allowance, so that the
+ * owner doesn't need to send an approve transaction.
+ */
+contract DetailedPermitToken {
+    mapping (address => uint256) private _balances;
+
+    mapping (address => mapping (address => uint256)) private _allowances;
+
+    uint256 private _totalSupply;
+
+    string private _name;
+    string private _symbol;
+    uint8 private _decimals;
+
+    // Owner of the contract, the only account allowed to mint and burn
+    address private _owner;
+
+    // Nonce of every token owner, consumed by each permit
+    mapping (address => uint256) private _nonces;
+
+    bytes32 private constant _TYPE_HASH = keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)");
+
+    bytes32 private constant _PERMIT_TYPEHASH = keccak256("Permit(address owner,address spender,uint256 value,uint256 nonce,uint256 deadline)");
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    /**
+     * @dev Sets the values for {name}, {symbol} and {decimals}, mints `_amount`
+     * tokens to `owner_` which becomes the owner. The EIP-712 domain uses the
+     * name and the version "1".
+     */
+    constructor (string memory name_, string memory symbol_, uint8 decimals_, uint256 _amount, address owner_) {
+        require(owner_ != address(0), "Ownable: new owner is the zero address");
+        _name = name_;
+        _symbol = symbol_;
+        _decimals = decimals_;
+        _owner = owner_;
+        emit OwnershipTransferred(address(0), owner_);
+        _mint(owner_, _amount);
+    }
+
+    /**
+     * @dev Throws if called by any account other than the owner.
+     */
+    modifier onlyOwner() {
+        require(_owner == msg.sender, "Ownable: caller is not the owner");
+        _;
+    }
+
+    function name() public view returns (string memory) {
+        return _name;
+    }
+
+    function symbol() public view returns (string memory) {
+        return _symbol;
+    }
+
+    function decimals() public view returns (uint8) {
+        return _decimals;
+    }
+
+    function totalSupply() public view returns (uint256) {
+        return _totalSupply;
+    }
+
+    function balanceOf(address account) public view returns (uint256) {
+        return _balances[account];
+    }
+
+    function transfer(address recipient, uint256 amount) public returns (bool) {
+        _transfer(msg.sender, recipient, amount);
+        return true;
+    }
+
+    function allowance(address owner_, address spender) public view returns (uint256) {
+        return _allowances[owner_][spender];
+    }
+
+    function approve(address spender, uint256 amount) public returns (bool) {
+        _approve(msg.sender, spender, amount);
+        return true;
+    }
+
+    function transferFrom(address sender, address recipient, uint256 amount) public returns (bool) {
+        _transfer(sender, recipient, amount);
+        uint256 currentAllowance = _allowances[sender][msg.sender];
+        require(currentAllowance >= amount, "ERC20: transfer amount exceeds allowance");
+        _approve(sender, msg.sender, currentAllowance - amount);
+        return true;
+    }
+
+    function increaseAllowance(address spender, uint256 addedValue) public returns (bool) {
+        uint256 increased = _allowances[msg.sender][spender] + addedValue;
+        require(increased >= addedValue, "SafeMath: addition overflow");
+        _approve(msg.sender, spender, increased);
+        return true;
+    }
+
+    function decreaseAllowance(address spender, uint256 subtractedValue) public returns (bool) {
+        uint256 currentAllowance = _allowances[msg.sender][spender];
+        require(currentAllowance >= subtractedValue, "ERC20: decreased allowance below zero");
+        _approve(msg.sender, spender, currentAllowance - subtractedValue);
+        return true;
+    }
+
+    function mint(address _to, uint256 _amount) public onlyOwner {
+        _mint(_to, _amount);
+    }
+
+    function burn(address _from, uint256 _amount) public onlyOwner {
+        _burn(_from, _amount);
+    }
+
+    /**
+     * @dev See {IERC20Permit-permit}. The signature must be made by `owner_`
+     * over the EIP-712 Permit message holding its current nonce.
+     */
+    function permit(address owner_, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) public {
+        require(block.timestamp <= deadline, "ERC20Permit: expired deadline");
+        bytes32 structHash = keccak256(abi.encode(_PERMIT_TYPEHASH, owner_, spender, value, _nonces[owner_]++, deadline));
+        bytes32 digest = keccak256(abi.encodePacked("\x19\x01", DOMAIN_SEPARATOR(), structHash));
+        require(uint256(s) <= 0x7FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF5D576E7357A4501DDFE92F46681B20A0, "ECDSA: invalid signature 's' value");
+        require(v == 27 || v == 28, "ECDSA: invalid signature 'v' value");
+        address signer = ecrecover(digest, v, r, s);
+        require(signer != address(0), "ECDSA: invalid signature");
+        require(signer == owner_, "ERC20Permit: invalid signature");
+        _approve(owner_, spender, value);
+    }
+
+    /**
+     * @dev See {IERC20Permit-nonces}.
+     */
+    function nonces(address owner_) public view returns (uint256) {
+        return _nonces[owner_];
+    }
+
+    /**
+     * @dev See {IERC20Permit-DOMAIN_SEPARATOR}. It is computed on every call
+     * so that it follows the chain id after a fork.
+     */
+    // solhint-disable-next-line func-name-mixedcase
+    function DOMAIN_SEPARATOR() public view returns (bytes32) {
+        uint256 chainId;
+        // solhint-disable-next-line no-inline-assembly
+        assembly { chainId := chainid() }
+        return keccak256(abi.encode(_TYPE_HASH, keccak256(bytes(_name)), keccak256(bytes("1")), chainId, address(this)));
+    }
+
+    /**
+     * @dev Returns the address of the current owner.
+     */
+    function owner() public view returns (address) {
+        return _owner;
+    }
+
+    /**
+     * @dev Leaves the contract without owner, no more tokens can be minted
+     * or burned.
+     */
+    function renounceOwnership() public onlyOwner {
+        emit OwnershipTransferred(_owner, address(0));
+        _owner = address(0);
+    }
+
+    /**
+     * @dev Transfers ownership of the contract to a new account (`newOwner`).
+     */
+    function transferOwnership(address newOwner) public onlyOwner {
+        require(newOwner != address(0), "Ownable: new owner is the zero address");
+        emit OwnershipTransferred(_owner, newOwner);
+        _owner = newOwner;
+    }
+
+    function _transfer(address sender, address recipient, uint256 amount) internal {
+        require(sender != address(0), "ERC20: transfer from the zero address");
+        require(recipient != address(0), "ERC20: transfer to the zero address");
+        require(_balances[sender] >= amount, "ERC20: transfer amount exceeds balance");
+        _balances[sender] -= amount;
+        _credit(recipient, amount);
+        emit Transfer(sender, recipient, amount);
+    }
+
+    function _mint(address account, uint256 amount) internal {
+        require(account != address(0), "ERC20: mint to the zero address");
+        uint256 supply = _totalSupply + amount;
+        require(supply >= amount, "SafeMath: addition overflow");
+        _totalSupply = supply;
+        _credit(account, amount);
+        emit Transfer(address(0), account, amount);
+    }
+
+    function _burn(address account, uint256 amount) internal {
+        require(account != address(0), "ERC20: burn from the zero address");
+        require(_balances[account] >= amount, "ERC20: burn amount exceeds balance");
+        _balances[account] -= amount;
+        _totalSupply -= amount;
+        emit Transfer(account, address(0), amount);
+    }
+
+    function _credit(address account, uint256 amount) internal {
+        uint256 balance = _balances[account] + amount;
+        require(balance >= amount, "SafeMath: addition overflow");
+        _balances[account] = balance;
+    }
+
+    function _approve(address owner_, address spender, uint256 amount) internal {
+        require(owner_ != address(0), "ERC20: approve from the zero address");
+        require(spender != address(0), "ERC20: approve to the zero address");
+        _allowances[owner_][spender] = amount;
+        emit Approval(owner_, spender, amount);
+    }
+}
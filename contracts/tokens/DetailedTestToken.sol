@@ -1,6 +1,12 @@
 // SPDX-License-Identifier: MIT
 
-pragma solidity 0.6.12;
+pragma solidity 0.8.21;
+
+// OpenZeppelin Contracts v3.x flattened and ported to solc 0.8 like the
+// v4.0.0 release did: _msgSender returns an address and the constructors
+// lose their visibility. Ownable is given its initial owner, so that the
+// token can be deployed through a CREATE2 factory without the factory
+// becoming the owner.
 
 // File: @openzeppelin/contracts/GSN/Context.sol
 
@@ -14,12 +20,8 @@ pragma solidity 0.6.12;
  *
  * This contract is only required for intermediate, library-like contracts.
  */
-contract Context {
-    // Empty internal constructor, to prevent people from mistakenly deploying
-    // an instance of this contract, which should be used via inheritance.
-    constructor () internal { }
-
-    function _msgSender() internal view virtual returns (address payable) {
+abstract contract Context {
+    function _msgSender() internal view virtual returns (address) {
         return msg.sender;
     }
 
@@ -365,7 +367,7 @@ contract ERC20 is Context, IERC20 {
      * All three of these values are immutable: they can only be set once during
      * construction.
      */
-    constructor (string memory name, string memory symbol) public {
+    constructor (string memory name, string memory symbol) {
         _name = name;
         _symbol = symbol;
         _decimals = 18;
@@ -624,8 +626,8 @@ contract ERC20 is Context, IERC20 {
  * there is an account (an owner) that can be granted exclusive access to
  * specific functions.
  *
- * By default, the owner account will be the one that deploys the contract. This
- * can later be changed with {transferOwnership}.
+ * The initial owner is given to the constructor. This can later be changed with
+ * {transferOwnership}.
  *
  * This module is used through inheritance. It will make available the modifier
  * `onlyOwner`, which can be applied to your functions to restrict their use to
@@ -637,12 +639,12 @@ contract Ownable is Context {
     event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
 
     /**
-     * @dev Initializes the contract setting the deployer as the initial owner.
+     * @dev Initializes the contract setting `owner_` as the initial owner.
      */
-    constructor () internal {
-        address msgSender = _msgSender();
-        _owner = msgSender;
-        emit OwnershipTransferred(address(0), msgSender);
+    constructor (address owner_) {
+        require(owner_ != address(0), "Ownable: new owner is the zero address");
+        _owner = owner_;
+        emit OwnershipTransferred(address(0), owner_);
     }
 
     /**
@@ -689,9 +691,12 @@ contract DetailedTestToken is ERC20, Ownable {
     constructor(
         string memory _name,
         string memory _symbol,
-        uint256 _amount
-    ) ERC20(_name, _symbol) public {
-        _mint(msg.sender, _amount);
+        uint8 _decimals,
+        uint256 _amount,
+        address _owner
+    ) ERC20(_name, _symbol) Ownable(_owner) {
+        _setupDecimals(_decimals);
+        _mint(_owner, _amount);
     }
 
     function mint(address _to, uint256 _amount) public onlyOwner {
@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity 0.8.21;
+
+/**
+ * @dev ERC1155 token receiver interface, implemented by contracts that want
+ * to accept transfers from ERC1155 multi-token contracts.
+ */
+interface IERC1155Receiver {
+    /**
+     * @dev Handles the receipt of a single ERC1155 token type. It must return
+     * its Solidity selector to accept the transfer.
+     */
+    function onERC1155Received(address operator, address from, uint256 id, uint256 value, bytes calldata data) external returns (bytes4);
+
+    /**
+     * @dev Handles the receipt of multiple ERC1155 token types. It must
+     * return its Solidity selector to accept the transfer.
+     */
+    function onERC1155BatchReceived(address operator, address from, uint256[] calldata ids, uint256[] calldata values, bytes calldata data) external returns (bytes4);
+}
+
+/**
+ * @dev Basic ERC1155 multi-token with the metadata URI extension. The owner
+ * of the contract mints the tokens, one or several types at once, and every
+ * token type shares the same URI in which clients substitute `{id}`.
+ */
+contract TestMultiToken {
+    // Mapping from token ID to account balances
+    mapping (uint256 => mapping(address => uint256)) private _balances;
+
+    // Mapping from account to operator approvals
+    mapping (address => mapping(address => bool)) private _operatorApprovals;
+
+    // Used as the URI for all token types by relying on ID substitution
+    string private _uri;
+
+    // Owner of the contract, the only account allowed to mint
+    address private _owner;
+
+    bytes4 private constant _INTERFACE_ID_ERC165 = 0x01ffc9a7;
+    bytes4 private constant _INTERFACE_ID_ERC1155 = 0xd9b67a26;
+    bytes4 private constant _INTERFACE_ID_ERC1155_METADATA_URI = 0x0e89341c;
+
+    /**
+     * @dev Emitted when `value` tokens of token type `id` are transferred from `from` to `to` by `operator`.
+     */
+    event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value);
+
+    /**
+     * @dev Equivalent to multiple {TransferSingle} events, where `operator`, `from` and `to` are the same for all
+     * transfers.
+     */
+    event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values);
+
+    /**
+     * @dev Emitted when `account` grants or revokes permission to `operator` to transfer their tokens, according to
+     * `approved`.
+     */
+    event ApprovalForAll(address indexed account, address indexed operator, bool approved);
+
+    event OwnershipTransferred(address indexed previousOwner, address indexed newOwner);
+
+    /**
+     * @dev Sets the URI of all the token types, the deployer becomes the
+     * owner.
+     */
+    constructor (string memory uri_) {
+        _uri = uri_;
+        _owner = msg.sender;
+        emit OwnershipTransferred(address(0), msg.sender);
+    }
+
+    /**
+     * @dev Throws if called by any account other than the owner.
+     */
+    modifier onlyOwner() {
+        require(_owner == msg.sender, "Ownable: caller is not the owner");
+        _;
+    }
+
+    /**
+     * @dev See {IERC165-supportsInterface}.
+     */
+    function supportsInterface(bytes4 interfaceId) public pure returns (bool) {
+        return interfaceId == _INTERFACE_ID_ERC165 ||
+            interfaceId == _INTERFACE_ID_ERC1155 ||
+            interfaceId == _INTERFACE_ID_ERC1155_METADATA_URI;
+    }
+
+    /**
+     * @dev See {IERC1155MetadataURI-uri}.
+     */
+    function uri(uint256) public view returns (string memory) {
+        return _uri;
+    }
+
+    /**
+     * @dev See {IERC1155-balanceOf}.
+     */
+    function balanceOf(address account, uint256 id) public view returns (uint256) {
+        require(account != address(0), "ERC1155: balance query for the zero address");
+        return _balances[id][account];
+    }
+
+    /**
+     * @dev See {IERC1155-balanceOfBatch}.
+     */
+    function balanceOfBatch(address[] memory accounts, uint256[] memory ids) public view returns (uint256[] memory) {
+        require(accounts.length == ids.length, "ERC1155: accounts and ids length mismatch");
+        uint256[] memory batchBalances = new uint256[](accounts.length);
+        for (uint256 i = 0; i < accounts.length; ++i) {
+            require(accounts[i] != address(0), "ERC1155: batch balance query for the zero address");
+            batchBalances[i] = _balances[ids[i]][accounts[i]];
+        }
+        return batchBalances;
+    }
+
+    /**
+     * @dev See {IERC1155-setApprovalForAll}.
+     */
+    function setApprovalForAll(address operator, bool approved) public {
+        require(msg.sender != operator, "ERC1155: setting approval status for self");
+        _operatorApprovals[msg.sender][operator] = approved;
+        emit ApprovalForAll(msg.sender, operator, approved);
+    }
+
+    /**
+     * @dev See {IERC1155-isApprovedForAll}.
+     */
+    function isApprovedForAll(address account, address operator) public view returns (bool) {
+        return _operatorApprovals[account][operator];
+    }
+
+    /**
+     * @dev See {IERC1155-safeTransferFrom}.
+     */
+    function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes memory data) public {
+        require(to != address(0), "ERC1155: transfer to the zero address");
+        require(from == msg.sender || isApprovedForAll(from, msg.sender), "ERC1155: caller is not owner nor approved");
+        _move(from, to, id, amount);
+        emit TransferSingle(msg.sender, from, to, id, amount);
+        _doSafeTransferAcceptanceCheck(from, to, id, amount, data);
+    }
+
+    /**
+     * @dev See {IERC1155-safeBatchTransferFrom}.
+     */
+    function safeBatchTransferFrom(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) public {
+        require(ids.length == amounts.length, "ERC1155: ids and amounts length mismatch");
+        require(to != address(0), "ERC1155: transfer to the zero address");
+        require(from == msg.sender || isApprovedForAll(from, msg.sender), "ERC1155: transfer caller is not owner nor approved");
+        for (uint256 i = 0; i < ids.length; ++i) {
+            _move(from, to, ids[i], amounts[i]);
+        }
+        emit TransferBatch(msg.sender, from, to, ids, amounts);
+        _doSafeBatchTransferAcceptanceCheck(from, to, ids, amounts, data);
+    }
+
+    /**
+     * @dev Creates `amount` tokens of token type `id` and assigns them to
+     * `to`, only the owner can mint.
+     *
+     * Emits a {TransferSingle} event.
+     */
+    function mint(address to, uint256 id, uint256 amount, bytes memory data) public onlyOwner {
+        require(to != address(0), "ERC1155: mint to the zero address");
+        _credit(to, id, amount);
+        emit TransferSingle(msg.sender, address(0), to, id, amount);
+        _doSafeTransferAcceptanceCheck(address(0), to, id, amount, data);
+    }
+
+    /**
+     * @dev Batched version of {mint}.
+     *
+     * Emits a {TransferBatch} event.
+     */
+    function mintBatch(address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) public onlyOwner {
+        require(to != address(0), "ERC1155: mint to the zero address");
+        require(ids.length == amounts.length, "ERC1155: ids and amounts length mismatch");
+        for (uint256 i = 0; i < ids.length; i++) {
+            _credit(to, ids[i], amounts[i]);
+        }
+        emit TransferBatch(msg.sender, address(0), to, ids, amounts);
+        _doSafeBatchTransferAcceptanceCheck(address(0), to, ids, amounts, data);
+    }
+
+    /**
+     * @dev Returns the address of the current owner.
+     */
+    function owner() public view returns (address) {
+        return _owner;
+    }
+
+    /**
+     * @dev Leaves the contract without owner, no more tokens can be minted.
+     */
+    function renounceOwnership() public onlyOwner {
+        emit OwnershipTransferred(_owner, address(0));
+        _owner = address(0);
+    }
+
+    /**
+     * @dev Transfers ownership of the contract to a new account (`newOwner`).
+     */
+    function transferOwnership(address newOwner) public onlyOwner {
+        require(newOwner != address(0), "Ownable: new owner is the zero address");
+        emit OwnershipTransferred(_owner, newOwner);
+        _owner = newOwner;
+    }
+
+    function _move(address from, address to, uint256 id, uint256 amount) internal {
+        require(_balances[id][from] >= amount, "ERC1155: insufficient balance for transfer");
+        _balances[id][from] -= amount;
+        _credit(to, id, amount);
+    }
+
+    function _credit(address to, uint256 id, uint256 amount) internal {
+        uint256 balance = _balances[id][to] + amount;
+        require(balance >= amount, "SafeMath: addition overflow");
+        _balances[id][to] = balance;
+    }
+
+    /**
+     * @dev Calls {IERC1155Receiver-onERC1155Received} when the recipient is
+     * a contract, its revert reason is kept.
+     */
+    function _doSafeTransferAcceptanceCheck(address from, address to, uint256 id, uint256 amount, bytes memory data) private {
+        uint256 size;
+        // solhint-disable-next-line no-inline-assembly
+        assembly { size := extcodesize(to) }
+        if (size == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155Received(msg.sender, from, id, amount, data) returns (bytes4 response) {
+            require(response == IERC1155Receiver.onERC1155Received.selector, "ERC1155: ERC1155Receiver rejected tokens");
+        } catch (bytes memory reason) {
+            if (reason.length == 0) {
+                revert("ERC1155: transfer to non ERC1155Receiver implementer");
+            }
+            // solhint-disable-next-line no-inline-assembly
+            assembly { revert(add(32, reason), mload(reason)) }
+        }
+    }
+
+    /**
+     * @dev Calls {IERC1155Receiver-onERC1155BatchReceived} when the
+     * recipient is a contract, its revert reason is kept.
+     */
+    function _doSafeBatchTransferAcceptanceCheck(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) private {
+        uint256 size;
+        // solhint-disable-next-line no-inline-assembly
+        assembly { size := extcodesize(to) }
+        if (size == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155BatchReceived(msg.sender, from, ids, amounts, data) returns (bytes4 response) {
+            require(response == IERC1155Receiver.onERC1155BatchReceived.selector, "ERC1155: ERC1155Receiver rejected tokens");
+        } catch (bytes memory reason) {
+            if (reason.length == 0) {
+                revert("ERC1155: transfer to non ERC1155Receiver implementer");
+            }
+            // solhint-disable-next-line no-inline-assembly
+            assembly { revert(add(32, reason), mload(reason)) }
+        }
+    }
+}
@@ -1,17 +1,46 @@
 package constants
 
 import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	abidec "go-evm-client/pkg/abi_decoder"
 	dtt "go-evm-client/pkg/contracts/detailed_test_token"
 	ftt "go-evm-client/pkg/contracts/fast_test_token"
+	mc3 "go-evm-client/pkg/contracts/multicall3"
+	"go-evm-client/pkg/contracts/proxy"
+	mt "go-evm-client/pkg/contracts/test_multi_token"
+	nft "go-evm-client/pkg/contracts/test_nft"
+	"sort"
 )
 
 // ContractNamesDict contains the contract name to the contract struct
 // this is used to easily retrieve the required struct without a switch
 // statement
 var ContractNamesDict = map[string]cc.IContract{
-	"detailed_test_token": &dtt.DetailedTestTokenContract{},
-	"fast_test_token":     &ftt.FastTestTokenContract{},
+	"detailed_test_token":   &dtt.DetailedTestTokenContract{},
+	"detailed_permit_token": &dtt.DetailedPermitTokenContract{},
+	"fast_test_token":       &ftt.FastTestTokenContract{},
+	"multicall3":            &mc3.Multicall3Contract{},
+	"transparent_proxy":     &proxy.ProxyContract{Kind: proxy.TransparentKind},
+	"uups_proxy":            &proxy.ProxyContract{Kind: proxy.UUPSKind},
+	"test_nft":              &nft.TestNFTContract{},
+	"test_multi_token":      &mt.TestMultiTokenContract{},
+}
+
+// ContractNamesToMetaData contains the contract name to the generated
+// binding metadata, its ABI and bytecode are used to detect the type of
+// a deployed contract
+var ContractNamesToMetaData = map[string]*bind.MetaData{
+	"detailed_test_token":   dtt.DetailedTestTokenMetaData,
+	"detailed_permit_token": dtt.DetailedPermitTokenMetaData,
+	"fast_test_token":       ftt.FastTestTokenMetaData,
+	"multicall3":            mc3.Multicall3MetaData,
+	"transparent_proxy":     proxy.TransparentUpgradeableProxyMetaData,
+	"uups_proxy":            proxy.UUPSProxyMetaData,
+	"test_nft":              nft.TestNFTMetaData,
+	"test_multi_token":      mt.TestMultiTokenMetaData,
 }
 
 // baseERC20Queries contains the list of accepted base queries
@@ -23,6 +52,7 @@ var baseERC20Queries = []string{
 	"totalsupply",
 	"balanceof",
 	"allowance",
+	"info",
 }
 
 // baseERC20Queries contains the list of accepted base writes
@@ -35,6 +65,101 @@ var baseERC20Writes = []string{
 	"decreaseallowance",
 }
 
+// baseERC20Events contains the list of events that can be queried
+// on an ownable erc20 token
+var baseERC20Events = []string{
+	"transfer",
+	"approval",
+	"ownershiptransferred",
+}
+
+// permitQueries contains the list of accepted EIP-2612 queries, signpermit
+// only signs the permit off chain
+var permitQueries = []string{
+	"nonces",
+	"domainseparator",
+	"signpermit",
+}
+
+// multicall3Queries contains the list of accepted queries of the
+// Multicall3 contract, its aggregation is used through --multicall
+var multicall3Queries = []string{
+	"getblocknumber",
+	"getethbalance",
+}
+
+// proxyQueries contains the list of accepted queries of an EIP-1967
+// proxy, they read its storage slots
+var proxyQueries = []string{
+	"implementation",
+	"admin",
+}
+
+// proxyEvents contains the list of events that can be queried on an
+// EIP-1967 proxy
+var proxyEvents = []string{
+	"upgraded",
+	"adminchanged",
+}
+
+// baseERC721Queries contains the list of accepted base queries
+// of an erc721 token
+var baseERC721Queries = []string{
+	"name",
+	"symbol",
+	"owner",
+	"balanceof",
+	"ownerof",
+	"tokenuri",
+	"getapproved",
+	"isapprovedforall",
+}
+
+// baseERC721Writes contains the list of accepted base writes
+// of an erc721 token
+var baseERC721Writes = []string{
+	"approve",
+	"setapprovalforall",
+	"transferfrom",
+	"safetransferfrom",
+}
+
+// baseERC721Events contains the list of events that can be queried
+// on an ownable erc721 token
+var baseERC721Events = []string{
+	"transfer",
+	"approval",
+	"approvalforall",
+	"ownershiptransferred",
+}
+
+// baseERC1155Queries contains the list of accepted base queries
+// of an erc1155 token
+var baseERC1155Queries = []string{
+	"owner",
+	"uri",
+	"balanceof",
+	"balanceofbatch",
+	"isapprovedforall",
+}
+
+// baseERC1155Writes contains the list of accepted base writes
+// of an erc1155 token
+var baseERC1155Writes = []string{
+	"setapprovalforall",
+	"safetransferfrom",
+	"safebatchtransferfrom",
+}
+
+// baseERC1155Events contains the list of events that can be queried
+// on an ownable erc1155 token
+var baseERC1155Events = []string{
+	"transfersingle",
+	"transferbatch",
+	"approvalforall",
+	"ownershiptransferred",
+}
+
 // ContractNamesToFuncNames contains the mapping of the possible
 // query/write functions that a contract can have
 var ContractNamesToFuncNames = map[string]map[string][]string{
@@ -42,11 +167,50 @@ var ContractNamesToFuncNames = map[string]map[string][]string{
 		"query": baseERC20Queries,
 		"write": append(baseERC20Writes, []string{"mint", "burn"}...),
 		"all": append(baseERC20Queries, append(baseERC20Writes, []string{"mint", "burn"}...)...),
+		"events": baseERC20Events,
+	},
+	"detailed_permit_token": {
+		"query": append(baseERC20Queries, permitQueries...),
+		"write": append(baseERC20Writes, []string{"mint", "burn", "permit"}...),
+		"all": append(append(baseERC20Queries, permitQueries...),
+			append(baseERC20Writes, []string{"mint", "burn", "permit"}...)...),
+		"events": baseERC20Events,
 	},
 	"fast_test_token": {
 		"query": baseERC20Queries,
 		"write": baseERC20Writes,
 		"all": append(baseERC20Queries, baseERC20Writes...),
+		"events": baseERC20Events,
+	},
+	"multicall3": {
+		"query": multicall3Queries,
+		"write": {},
+		"all": multicall3Queries,
+		"events": {},
+	},
+	"transparent_proxy": {
+		"query": proxyQueries,
+		"write": {"upgradeto", "upgradetoandcall", "changeadmin"},
+		"all": append(proxyQueries, []string{"upgradeto", "upgradetoandcall", "changeadmin"}...),
+		"events": proxyEvents,
+	},
+	"uups_proxy": {
+		"query": proxyQueries,
+		"write": {"upgradeto", "upgradetoandcall"},
+		"all": append(proxyQueries, []string{"upgradeto", "upgradetoandcall"}...),
+		"events": proxyEvents,
+	},
+	"test_nft": {
+		"query": baseERC721Queries,
+		"write": append(baseERC721Writes, []string{"mint"}...),
+		"all": append(baseERC721Queries, append(baseERC721Writes, []string{"mint"}...)...),
+		"events": baseERC721Events,
+	},
+	"test_multi_token": {
+		"query": baseERC1155Queries,
+		"write": append(baseERC1155Writes, []string{"mint", "mintbatch"}...),
+		"all": append(baseERC1155Queries, append(baseERC1155Writes, []string{"mint", "mintbatch"}...)...),
+		"events": baseERC1155Events,
 	},
 }
 
@@ -66,4 +230,71 @@ func VerifyFunctionNameExists(contractType string, funcName string) bool {
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
+
+// VerifyEventNameExists check if the event name exists for the
+// requested contract
+func VerifyEventNameExists(contractType string, eventName string) bool {
+	allEvents := ContractNamesToFuncNames[contractType]["events"]
+	for _, v := range allEvents {
+		if v == eventName {
+			return true
+		}
+	}
+	return false
+}
+// VerifyFunctionNames checks that every function name exists for the
+// requested contract, when only queries are allowed the functions
+// changing the contract state are refused as well
+func VerifyFunctionNames(
+	contractType string,
+	funcNames []string,
+	queriesOnly bool,
+) error {
+	queryFuncs := ContractNamesToFuncNames[contractType]["query"]
+	for _, name := range funcNames {
+		if !VerifyFunctionNameExists(contractType, name) {
+			return fmt.Errorf("error: Unsupported function name %s for "+
+				"contract type %s", name, contractType)
+		}
+		if queriesOnly && !utils.Contains(&queryFuncs, name) {
+			return fmt.Errorf("error: Only queries can be aggregated, %s "+
+				"changes the contract state", name)
+		}
+	}
+	return nil
+}
+
+// KnownABIs returns the parsed ABIs of every supported contract in name
+// order
+func KnownABIs() ([]abidec.NamedABI, error) {
+	names := make([]string, 0, len(ContractNamesToMetaData))
+	for name := range ContractNamesToMetaData {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	abis := make([]abidec.NamedABI, len(names))
+	for i, name := range names {
+		parsed, err := ContractNamesToMetaData[name].GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("error: failed to parse the ABI of %s: %v",
+				name, err)
+		}
+		abis[i] = abidec.NamedABI{Name: name, ABI: *parsed}
+	}
+	return abis, nil
+}
+
+// NewKnownDecoder creates a decoder holding the functions and events of
+// every supported contract, used to decode calldata and logs
+func NewKnownDecoder() (*abidec.Decoder, error) {
+	abis, err := KnownABIs()
+	if err != nil {
+		return nil, err
+	}
+	decoder := abidec.NewDecoder()
+	for _, named := range abis {
+		decoder.AddABI(named.Name, named.ABI)
+	}
+	return decoder, nil
+}
@@ -0,0 +1,232 @@
+package contract_interactor_facade
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	consts "go-evm-client/internal/constants"
+	utils "go-evm-client/internal/utils"
+	abidec "go-evm-client/pkg/abi_decoder"
+	sigdb "go-evm-client/pkg/signature_db"
+	"os"
+	"strings"
+)
+
+// DefaultAbiDir is the directory holding the ABIs written by solc
+const DefaultAbiDir = "abi"
+
+// abiFacade will keep all the necessary data needed to decode and encode
+// calldata and logs, no node is involved
+type abiFacade struct {
+	decoder    *abidec.Decoder
+	signatures *sigdb.SignatureDB
+	format     string
+}
+
+// encodedCall is the output of the encode command
+type encodedCall struct {
+	Signature string `json:"signature"`
+	Selector  string `json:"selector"`
+	Calldata  string `json:"calldata"`
+}
+
+// NewAbiFacade loads the ABIs of the supported contracts followed by the
+// ABI files of the given directory. Calldata calling none of their
+// functions is decoded with the signatures of the comma separated 4byte
+// style files given.
+func NewAbiFacade(abiDir string, sigFiles string, format string) (
+	*abiFacade, error) {
+	err := checkFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	abis, err1 := loadAbis(abiDir)
+	if err1 != nil {
+		return nil, err1
+	}
+	decoder := abidec.NewDecoder()
+	for _, named := range abis {
+		decoder.AddABI(named.Name, named.ABI)
+	}
+	signatures, err2 := newSignatureDB(abis, sigFiles)
+	if err2 != nil {
+		return nil, err2
+	}
+	return &abiFacade{
+		decoder:    decoder,
+		signatures: signatures,
+		format:     format,
+	}, nil
+}
+
+// loadAbis returns the ABIs of the supported contracts followed by the ABI
+// files of the given directory. No directory is read when it is empty and
+// a missing default directory only leaves the supported contracts, for
+// instance when the command isn't run from the root of the repository.
+func loadAbis(abiDir string) ([]abidec.NamedABI, error) {
+	abis, err := consts.KnownABIs()
+	if err != nil {
+		return nil, err
+	}
+	if len(abiDir) == 0 {
+		return abis, nil
+	}
+	_, err1 := os.Stat(abiDir)
+	if os.IsNotExist(err1) && abiDir == DefaultAbiDir {
+		// The warning goes to stderr to keep the JSON output parsable
+		_, _ = fmt.Fprintf(os.Stderr, "warning: the ABI directory %s "+
+			"doesn't exist, only the supported contracts are known\n",
+			abiDir)
+		return abis, nil
+	}
+	dirAbis, err2 := abidec.ReadDir(abiDir)
+	if err2 != nil {
+		return nil, err2
+	}
+	return append(abis, dirAbis...), nil
+}
+
+// newSignatureDB indexes the signatures of the ABIs and of the comma
+// separated 4byte-style files given
+func newSignatureDB(abis []abidec.NamedABI, sigFiles string) (
+	*sigdb.SignatureDB, error) {
+	signatures := sigdb.NewSignatureDB()
+	for _, named := range abis {
+		signatures.AddABI(named.Name, named.ABI)
+	}
+	if len(sigFiles) == 0 {
+		return signatures, nil
+	}
+	paths, err := utils.SplitList(sigFiles)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		_, err1 := signatures.ImportFile(path)
+		if err1 != nil {
+			return nil, err1
+		}
+	}
+	return signatures, nil
+}
+
+// DecodeCalldata prints the function called by the calldata and its
+// arguments
+func (a *abiFacade) DecodeCalldata(calldata string) error {
+	data, err := decodeHex(calldata, "calldata")
+	if err != nil {
+		return err
+	}
+	call, err1 := a.decoder.DecodeCall(data)
+	if err1 != nil {
+		call = a.decodeWithSignatures(data)
+		if call == nil {
+			return err1
+		}
+	}
+	return printReport(a.format, call, call.Text)
+}
+
+// decodeWithSignatures decodes calldata with the text signatures sharing
+// its selector, nil is returned when none of them decodes it. The first
+// signature decoding it is kept and the other ones are only listed, the
+// calldata alone can't tell them apart.
+func (a *abiFacade) decodeWithSignatures(data []byte) *abidec.Call {
+	if len(data) < 4 {
+		return nil
+	}
+	match, err := a.signatures.Lookup(hexutil.Encode(data[:4]))
+	if err != nil {
+		return nil
+	}
+	var calls []*abidec.Call
+	for _, entry := range match.Entries {
+		call, err1 := abidec.DecodeMethodCall(strings.Join(entry.Sources,
+			","), entry.Method(), data)
+		if err1 == nil {
+			calls = append(calls, call)
+		}
+	}
+	if len(calls) == 0 {
+		return nil
+	}
+	for _, other := range calls[1:] {
+		// The warning goes to stderr to keep the JSON output parsable
+		_, _ = fmt.Fprintf(os.Stderr, "warning: the calldata also decodes "+
+			"as %s from %s\n", other.Signature, other.Source)
+	}
+	return calls[0]
+}
+
+// DecodeLog prints the event emitted by a log given as its comma separated
+// topics and its data
+func (a *abiFacade) DecodeLog(topics string, data string) error {
+	topicList, err := utils.SplitList(topics)
+	if err != nil {
+		return err
+	}
+	hashes := make([]common.Hash, len(topicList))
+	for i, topic := range topicList {
+		hash, err1 := utils.ParseHash(topic)
+		if err1 != nil {
+			return err1
+		}
+		hashes[i] = hash
+	}
+	var logData []byte
+	if len(data) != 0 {
+		var err2 error
+		logData, err2 = decodeHex(data, "log data")
+		if err2 != nil {
+			return err2
+		}
+	}
+	event, err3 := a.decoder.DecodeLog(hashes, logData)
+	if err3 != nil {
+		// Text signatures don't tell which arguments are indexed, they can
+		// only name the event
+		match, err4 := a.signatures.Lookup(hashes[0].Hex())
+		if err4 != nil || len(match.Entries) == 0 {
+			return err3
+		}
+		names := make([]string, len(match.Entries))
+		for i, entry := range match.Entries {
+			names[i] = entry.Signature
+		}
+		return fmt.Errorf("%v, the signature database names it %s but its "+
+			"indexed arguments are unknown", err3, strings.Join(names, " or "))
+	}
+	return printReport(a.format, event, event.Text)
+}
+
+// Encode prints the calldata calling the function of the signature with
+// the given arguments. Only the calldata is printed with the text format
+// so that it can be used in scripts.
+func (a *abiFacade) Encode(signature string, args []string) error {
+	method, err := abidec.ParseSignature(signature)
+	if err != nil {
+		return err
+	}
+	data, err1 := abidec.Encode(method, args)
+	if err1 != nil {
+		return err1
+	}
+	encoded := &encodedCall{
+		Signature: method.Sig,
+		Selector:  hexutil.Encode(method.ID),
+		Calldata:  hexutil.Encode(data),
+	}
+	return printReport(a.format, encoded, func() string {
+		return encoded.Calldata + "\n"
+	})
+}
+
+// decodeHex decodes a hex argument starting with 0x
+func decodeHex(arg string, name string) ([]byte, error) {
+	data, err := hexutil.Decode(strings.TrimSpace(arg))
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not valid hex %s: %v", arg,
+			name, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,193 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	utils "go-evm-client/internal/utils"
+	"go-evm-client/pkg/airdrop"
+	dtt "go-evm-client/pkg/contracts/detailed_test_token"
+	ethacc "go-evm-client/pkg/eth_account"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+)
+
+// airdropFacade will keep all the necessary data needed to send the
+// transfers of an airdrop file
+type airdropFacade struct {
+	ethClient  *ethrpc.EthRpcClient
+	airdrop    *airdrop.Airdrop
+	resultPath string
+}
+
+// NewAirdropFacade goes through the processes of connecting to the node,
+// reading and validating every row of the airdrop file and checking that
+// the sender can pay for all of them before anything is sent. The airdrop
+// recorded in the state file is resumed when it exists.
+func NewAirdropFacade(
+	privateKey string,
+	rpc string,
+	tokenAddress string,
+	airdropFile string,
+	statePath string,
+	resultPath string,
+	maxInFlight int,
+	gasLimit int,
+	gasPrice int,
+	policyPath string,
+	auditPath string,
+	argParser *utils.ArgParser,
+) (*airdropFacade, error) {
+	// Validate the token address before connecting to anything
+	token, err := argParser.ParseAddress(tokenAddress)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Starting account and blockchain connection process.")
+	// Process the private key from the flag
+	userAccount, err1 := ethacc.CreateAccount(privateKey)
+	if err1 != nil {
+		return nil, err1
+	}
+	fmt.Printf("Succesfully accessed account returned Public Key: %s\n",
+		userAccount.Account)
+
+	// Connect to the RPC client with the give URL
+	ethClient, err2 := ethrpc.CreateClient(rpc)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err2)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err3 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err3)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+
+	facade, err4 := newAirdropFacade(ethClient, userAccount,
+		currBlockchainState.ChainId, token, airdropFile, statePath,
+		resultPath, maxInFlight, gasLimit, gasPrice, policyPath, auditPath,
+		argParser)
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, err4
+	}
+	fmt.Println("Successfully completed account and blockchain connection " +
+		"process.")
+	return facade, nil
+}
+
+// newAirdropFacade reads the airdrop file with the token denomination and
+// validates the airdrop once connected
+func newAirdropFacade(
+	ethClient *ethrpc.EthRpcClient,
+	userAccount *ethacc.UserAccount,
+	chainId *big.Int,
+	token common.Address,
+	airdropFile string,
+	statePath string,
+	resultPath string,
+	maxInFlight int,
+	gasLimit int,
+	gasPrice int,
+	policyPath string,
+	auditPath string,
+	argParser *utils.ArgParser,
+) (*airdropFacade, error) {
+	ok := ethClient.VerifyContractExistsAtAddress(context.Background(), nil,
+		token)
+	if !ok {
+		return nil, fmt.Errorf("error: contract doesn't exist at given "+
+			"address : %s\n", token.Hex())
+	}
+	// Every supported token shares the ERC20 functions of this binding
+	instance, err := dtt.NewDetailedTestToken(token, ethClient.EthClient)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := &bind.CallOpts{Context: context.Background()}
+	decimals, err1 := instance.Decimals(callOpts)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to read the token decimals, "+
+			"is %s an ERC20 token? %v", token.Hex(), err1)
+	}
+	symbol, err2 := instance.Symbol(callOpts)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to read the token symbol: %v",
+			err2)
+	}
+
+	transfers, err3 := airdrop.ReadTransfers(airdropFile,
+		argParser.ParseRecipient, func(amount string) (*big.Int, error) {
+			return argParser.ParseAmount(amount, decimals, symbol)
+		})
+	if err3 != nil {
+		return nil, err3
+	}
+	fmt.Printf("Read %d transfers from %s\n", len(transfers), airdropFile)
+
+	// Using the client and the account get data needed for the transfers
+	auth, err4 := ethClient.GetDataForTransaction(context.Background(),
+		userAccount, chainId, gasLimit, gasPrice)
+	if err4 != nil {
+		return nil, fmt.Errorf("error: failed to get data for transaction "+
+			"processing: %v\n", err4)
+	}
+	err5 := guardTransactions(policyPath, chainId, auth)
+	if err5 != nil {
+		return nil, err5
+	}
+	err6 := auditTransactions(auditPath, ethClient.RawUrl, auth)
+	if err6 != nil {
+		return nil, err6
+	}
+	if len(statePath) == 0 {
+		statePath = airdropFile + ".state.json"
+	}
+	if len(resultPath) == 0 {
+		resultPath = airdropFile + ".result.csv"
+	}
+	drop, err7 := airdrop.NewAirdrop(instance, ethClient.EthClient, auth,
+		token, transfers, statePath, maxInFlight)
+	if err7 != nil {
+		return nil, err7
+	}
+	drop.Decimals, drop.Symbol = decimals, symbol
+	err8 := drop.Validate(context.Background())
+	if err8 != nil {
+		return nil, err8
+	}
+	return &airdropFacade{
+		ethClient:  ethClient,
+		airdrop:    drop,
+		resultPath: resultPath,
+	}, nil
+}
+
+// SendAirdrop sends the transfers left and writes the result file, which
+// is written even when some transfers failed
+func (a *airdropFacade) SendAirdrop() error {
+	defer a.ethClient.CloseClient()
+	fmt.Printf("Starting airdrop process, progress is recorded in %s.\n",
+		a.airdrop.StatePath)
+	err := a.airdrop.Run(context.Background())
+	err1 := a.airdrop.State.WriteResults(a.resultPath)
+	if err1 != nil {
+		return err1
+	}
+	fmt.Printf("Wrote the result of %d transfers to %s\n",
+		len(a.airdrop.State.Transfers), a.resultPath)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Successfully completed airdrop process.")
+	return nil
+}
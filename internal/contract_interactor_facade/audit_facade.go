@@ -0,0 +1,70 @@
+package contract_interactor_facade
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	consts "go-evm-client/internal/constants"
+	audit "go-evm-client/pkg/audit_log"
+	"net/url"
+	"strings"
+)
+
+// DefaultAuditLog is the audit log written when no other log file is given
+const DefaultAuditLog = "audit_log.jsonl"
+
+// networkName names the network of an RPC URL in the audit log. Several
+// comma separated URLs are named one by one. Only the scheme and the host
+// are kept since the path and the query of provider URLs often hold an API
+// key, endpoints without a host are never written as given.
+func networkName(rawUrl string) string {
+	var names []string
+	for _, endpoint := range strings.Split(rawUrl, ",") {
+		endpoint = strings.TrimSpace(endpoint)
+		parsed, err := url.Parse(endpoint)
+		switch {
+		case err == nil && len(parsed.Host) != 0:
+			names = append(names, parsed.Scheme+"://"+parsed.Host)
+		case strings.HasSuffix(endpoint, ".ipc"):
+			names = append(names, "ipc")
+		default:
+			names = append(names, "unknown")
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// newRecorder creates the recorder appending to the audit log, nil when
+// the path is empty which disables the log
+func newRecorder(auditPath string, rawUrl string) (*audit.Recorder, error) {
+	if len(auditPath) == 0 {
+		return nil, nil
+	}
+	decoder, err := consts.NewKnownDecoder()
+	if err != nil {
+		return nil, err
+	}
+	return audit.NewRecorder(audit.NewAuditLog(auditPath),
+		networkName(rawUrl), decoder), nil
+}
+
+// auditTransactions records every transaction signed with the options to
+// the audit log. The signers are wrapped last so that the transactions
+// blocked by the spending policy aren't recorded.
+func auditTransactions(
+	auditPath string,
+	rawUrl string,
+	auths ...*bind.TransactOpts,
+) error {
+	recorder, err := newRecorder(auditPath, rawUrl)
+	if err != nil || recorder == nil {
+		return err
+	}
+	for _, auth := range auths {
+		if auth != nil {
+			auth.Signer = recorder.WrapSigner(auth.Signer)
+		}
+	}
+	fmt.Printf("info: Signed transactions are recorded to the audit log %s\n",
+		auditPath)
+	return nil
+}
@@ -0,0 +1,265 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	utils "go-evm-client/internal/utils"
+	erc20 "go-evm-client/pkg/contracts/erc20_token"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"io"
+	"math/big"
+	"os"
+)
+
+// bulkQueryFacade will keep all the necessary data needed to read the
+// balances of many accounts at once
+type bulkQueryFacade struct {
+	ethClient    *ethrpc.EthRpcClient
+	tokenAddress *common.Address
+	accounts     []common.Address
+	blockNumber  *big.Int
+	batchSize    int
+	native       bool
+	output       string
+}
+
+// NewBulkQueryFacade goes through the processes of reading the accounts,
+// connecting to the node and pinning the block all balances are read at
+func NewBulkQueryFacade(
+	rpc string,
+	tokenAddress string,
+	addressFile string,
+	block string,
+	batchSize int,
+	native bool,
+	output string,
+	argParser *utils.ArgParser,
+) (*bulkQueryFacade, error) {
+	if len(tokenAddress) == 0 && !native {
+		return nil, fmt.Errorf("error: nothing to query, give a token " +
+			"address or request native balances")
+	}
+	// Validate the address arguments before connecting to anything
+	var token *common.Address
+	if len(tokenAddress) != 0 {
+		address, err := argParser.ParseAddress(tokenAddress)
+		if err != nil {
+			return nil, err
+		}
+		token = &address
+	}
+	accounts, err1 := argParser.ReadAddressFile(addressFile)
+	if err1 != nil {
+		return nil, err1
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("error: no addresses found in %s", addressFile)
+	}
+	fmt.Printf("Read %d addresses from %s\n", len(accounts), addressFile)
+
+	fmt.Println("Starting blockchain connection process.")
+	// Connect to the RPC client with the give URL
+	ethClient, err2 := ethrpc.CreateClient(rpc)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err2)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err3 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err3)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+
+	// Pin the block so that every batch reads the same state
+	callOpts, err4 := ethClient.GetDataForCall(context.Background(), block,
+		common.Address{})
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, err4
+	}
+	if callOpts.Pending {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: the pending block changes between " +
+			"batches, use a fixed block instead")
+	}
+	blockNumber := callOpts.BlockNumber
+	if blockNumber == nil {
+		blockNumber = new(big.Int).SetUint64(currBlockchainState.BlockNumber)
+	}
+
+	if token != nil {
+		ok := ethClient.VerifyContractExistsAtAddress(context.Background(),
+			blockNumber, *token)
+		if !ok {
+			ethClient.CloseClient()
+			return nil, fmt.Errorf("error: contract doesn't exist at given "+
+				"address : %s at block %d\n", tokenAddress, blockNumber)
+		}
+	}
+	fmt.Println("Successfully completed blockchain connection process.")
+	return &bulkQueryFacade{
+		ethClient:    ethClient,
+		tokenAddress: token,
+		accounts:     accounts,
+		blockNumber:  blockNumber,
+		batchSize:    batchSize,
+		native:       native,
+		output:       output,
+	}, nil
+}
+
+// QueryBalances reads the balances of every account at the pinned block
+// in JSON-RPC batches and writes them as CSV. Accounts whose balance
+// couldn't be read are reported in the CSV and make the query fail once
+// everything else was written.
+func (b *bulkQueryFacade) QueryBalances() error {
+	defer b.ethClient.CloseClient()
+	fmt.Printf("Starting bulk query process at block %d.\n", b.blockNumber)
+	ctx := context.Background()
+	header := []string{"address"}
+	columns := [][]string{}
+	failures := 0
+
+	if b.tokenAddress != nil {
+		tokenColumns, failed, err := b.queryTokenBalances(ctx)
+		if err != nil {
+			return err
+		}
+		header = append(header, "balance", "amount")
+		columns = append(columns, tokenColumns...)
+		failures += failed
+	}
+	if b.native {
+		balances, errs, err1 := b.ethClient.BatchBalanceAt(ctx, b.accounts,
+			b.blockNumber, b.batchSize)
+		if err1 != nil {
+			return err1
+		}
+		column := make([]string, len(b.accounts))
+		for i := range b.accounts {
+			if errs[i] != nil {
+				column[i] = errs[i].Error()
+				failures++
+				continue
+			}
+			column[i] = balances[i].String()
+		}
+		header = append(header, "native")
+		columns = append(columns, column)
+	}
+
+	err2 := b.writeCSV(header, columns)
+	if err2 != nil {
+		return err2
+	}
+	if failures > 0 {
+		return fmt.Errorf("error: %d balances of %d accounts could not be "+
+			"read", failures, len(b.accounts))
+	}
+	fmt.Printf("Successfully completed bulk query process for %d "+
+		"accounts.\n", len(b.accounts))
+	return nil
+}
+
+// queryTokenBalances reads the token decimals and the balanceOf every
+// account, it returns the raw and the formatted balances columns
+func (b *bulkQueryFacade) queryTokenBalances(ctx context.Context) (
+	[][]string, int, error) {
+	parsed, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, 0, err
+	}
+	msgs := []ethereum.CallMsg{}
+	for _, method := range []string{"decimals", "symbol"} {
+		data, _ := parsed.Pack(method)
+		msgs = append(msgs, ethereum.CallMsg{To: b.tokenAddress, Data: data})
+	}
+	for _, account := range b.accounts {
+		data, err1 := parsed.Pack("balanceOf", account)
+		if err1 != nil {
+			return nil, 0, err1
+		}
+		msgs = append(msgs, ethereum.CallMsg{To: b.tokenAddress, Data: data})
+	}
+	outputs, errs, err2 := b.ethClient.BatchCallContract(ctx, msgs,
+		b.blockNumber, b.batchSize)
+	if err2 != nil {
+		return nil, 0, err2
+	}
+
+	// The denomination is read in the same batches as the balances
+	if errs[0] != nil || errs[1] != nil {
+		return nil, 0, fmt.Errorf("error: failed to read the token "+
+			"denomination: %v %v", errs[0], errs[1])
+	}
+	decimalsValues, err3 := parsed.Unpack("decimals", outputs[0])
+	if err3 != nil {
+		return nil, 0, err3
+	}
+	symbolValues, err4 := parsed.Unpack("symbol", outputs[1])
+	if err4 != nil {
+		return nil, 0, err4
+	}
+	decimals, symbol := decimalsValues[0].(uint8), symbolValues[0].(string)
+
+	failures := 0
+	raw := make([]string, len(b.accounts))
+	formatted := make([]string, len(b.accounts))
+	for i := range b.accounts {
+		var values []interface{}
+		callErr := errs[i+2]
+		if callErr == nil {
+			values, callErr = parsed.Unpack("balanceOf", outputs[i+2])
+		}
+		if callErr != nil {
+			raw[i], formatted[i] = callErr.Error(), ""
+			failures++
+			continue
+		}
+		balance := values[0].(*big.Int)
+		raw[i] = balance.String()
+		formatted[i] = utils.FormatTokenAmount(balance, decimals) + " " + symbol
+	}
+	return [][]string{raw, formatted}, failures, nil
+}
+
+// writeCSV writes one row per account to the output file, or to stdout
+// when no output file is given
+func (b *bulkQueryFacade) writeCSV(header []string, columns [][]string) error {
+	var out io.Writer = os.Stdout
+	if len(b.output) != 0 {
+		file, err := os.Create(b.output)
+		if err != nil {
+			return fmt.Errorf("error: failed to create output file: %v", err)
+		}
+		defer file.Close()
+		out = file
+	}
+	writer := csv.NewWriter(out)
+	rows := [][]string{header}
+	for i, account := range b.accounts {
+		row := []string{account.Hex()}
+		for _, column := range columns {
+			row = append(row, column[i])
+		}
+		rows = append(rows, row)
+	}
+	err1 := writer.WriteAll(rows)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to write balances: %v", err1)
+	}
+	if len(b.output) != 0 {
+		fmt.Printf("Wrote %d balances to %s\n", len(b.accounts), b.output)
+	}
+	return nil
+}
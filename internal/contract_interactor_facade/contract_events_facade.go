@@ -0,0 +1,174 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	consts "go-evm-client/internal/constants"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+)
+
+// contractEventsFacade will keep all the necessary data needed to query
+// the events of a contract. Reading logs doesn't require an account so
+// no private key is involved.
+type contractEventsFacade struct {
+	ethClient       *ethrpc.EthRpcClient
+	contractType    string
+	contractAddress common.Address
+	eventName       string
+	filter          cc.EventFilter
+	fromBlock       uint64
+	toBlock         uint64
+	pageSize        uint64
+	argParser       *utils.ArgParser
+}
+
+// NewContractEventsFacade goes through the processes of connecting to the
+// node and resolving the block range and filters which are then used to
+// query the events of a contract
+func NewContractEventsFacade(
+	rpc string,
+	contractType string,
+	contractAddress string,
+	eventName string,
+	fromBlock string,
+	toBlock string,
+	fromAddresses []string,
+	toAddresses []string,
+	pageSize uint64,
+	argParser *utils.ArgParser,
+) (*contractEventsFacade, error) {
+	// Validate the address arguments before connecting to anything
+	contAddress, err := argParser.ParseAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	filter, err1 := parseEventFilter(argParser, fromAddresses, toAddresses)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	fmt.Println("Starting blockchain connection process.")
+	// Connect to the RPC client with the give URL
+	ethClient, err2 := ethrpc.CreateClient(rpc)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err2)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err3 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err3)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+
+	// Resolve both ends of the range to block numbers
+	start, err4 := resolveEventBlock(ethClient, fromBlock,
+		currBlockchainState.BlockNumber)
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, err4
+	}
+	end, err5 := resolveEventBlock(ethClient, toBlock,
+		currBlockchainState.BlockNumber)
+	if err5 != nil {
+		ethClient.CloseClient()
+		return nil, err5
+	}
+
+	// Verify the contract exists at the specified address
+	ok := ethClient.VerifyContractExistsAtAddress(context.Background(), nil,
+		contAddress)
+	if !ok {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: contract doesn't exist at given "+
+			"address : %s\n", contractAddress)
+	}
+	fmt.Println("Successfully completed blockchain connection process.")
+	return &contractEventsFacade{
+		ethClient:       ethClient,
+		contractType:    contractType,
+		contractAddress: contAddress,
+		eventName:       eventName,
+		filter:          filter,
+		fromBlock:       start,
+		toBlock:         end,
+		pageSize:        pageSize,
+		argParser:       argParser,
+	}, nil
+}
+
+// parseEventFilter validates the addresses the indexed event arguments
+// are filtered on
+func parseEventFilter(
+	argParser *utils.ArgParser,
+	fromAddresses []string,
+	toAddresses []string,
+) (cc.EventFilter, error) {
+	filter := cc.EventFilter{}
+	for _, arg := range fromAddresses {
+		address, err := argParser.ParseAddress(arg)
+		if err != nil {
+			return cc.EventFilter{}, err
+		}
+		filter.From = append(filter.From, address)
+	}
+	for _, arg := range toAddresses {
+		address, err := argParser.ParseAddress(arg)
+		if err != nil {
+			return cc.EventFilter{}, err
+		}
+		filter.To = append(filter.To, address)
+	}
+	return filter, nil
+}
+
+// resolveEventBlock converts a block given on the command line into a
+// block number, latest and pending resolve to the current block height
+func resolveEventBlock(
+	ethClient *ethrpc.EthRpcClient,
+	block string,
+	currentBlock uint64,
+) (uint64, error) {
+	callOpts, err := ethClient.GetDataForCall(context.Background(), block,
+		common.Address{})
+	if err != nil {
+		return 0, err
+	}
+	if callOpts.BlockNumber == nil {
+		return currentBlock, nil
+	}
+	return callOpts.BlockNumber.Uint64(), nil
+}
+
+// QueryEvents loads the contract and retrieves its events over the
+// resolved block range
+func (c *contractEventsFacade) QueryEvents() error {
+	defer c.ethClient.CloseClient()
+	fmt.Println("Starting contract events process.")
+	contract := cc.Contract{
+		IContract: consts.ContractNamesDict[c.contractType],
+	}
+	contract.IContract.SetArgParser(c.argParser)
+	err := contract.LoadContract(
+		&c.contractAddress,
+		c.ethClient.EthClient)
+	if err != nil {
+		return err
+	}
+	_, err1 := contract.QueryEvents(context.Background(), c.eventName,
+		c.filter, c.fromBlock, c.toBlock, c.pageSize)
+	if err1 != nil {
+		return err1
+	}
+	fmt.Println("Successfully completed contract events process.")
+	return nil
+}
@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	consts "go-evm-client/internal/constants"
 	cc "go-evm-client/internal/contracts_template_interface"
 	utils "go-evm-client/internal/utils"
+	c2 "go-evm-client/pkg/create2_deployer"
 	ethacc "go-evm-client/pkg/eth_account"
+	mc3 "go-evm-client/pkg/contracts/multicall3"
+	"go-evm-client/pkg/contracts/proxy"
 	ethrpc "go-evm-client/pkg/eth_rpc_client"
 	"math/big"
+	"strings"
+	"time"
 )
 
 // baseContractInteractorFacade holds data common to both the deployer 
@@ -21,6 +27,47 @@ type baseContractInteractorFacade struct {
 	currBlockchainState *ethrpc.BlockChainState
 	auth                *bind.TransactOpts
 	contractType        string
+	argParser           *utils.ArgParser
+}
+
+// FacadeOptions holds the settings of the contract deployer and executor
+// facades besides the account, the RPC URL and the contract
+type FacadeOptions struct {
+	GasLimit int
+	GasPrice int
+	// PolicyPath is the spending policy file the transactions must follow
+	PolicyPath string
+	// AuditPath is the audit log recording every signed transaction, empty
+	// when they aren't recorded
+	AuditPath string
+	// ManifestPath is the deployment manifest the deployed contracts are
+	// recorded to and their deployer read from, empty when it isn't used
+	ManifestPath string
+	// ArgParser validates the constructor and function arguments
+	ArgParser *utils.ArgParser
+	// Salt deploys the contract through the CREATE2 factory when set
+	Salt string
+	// ProxyKind deploys the contract as the implementation of a new proxy
+	// of this kind, which is given ProxyAdmin and initialized with the
+	// InitData call data
+	ProxyKind string
+	ProxyAdmin string
+	InitData string
+	// Block is the block the view calls are executed at, the latest when
+	// empty
+	Block string
+	// CallerAddress makes the view calls on behalf of another account
+	// than the user account
+	CallerAddress string
+	// MulticallAddress aggregates the queries through the Multicall3 at
+	// this address when set
+	MulticallAddress string
+	// RelayerKey is the private key of the account submitting the messages
+	// signed by the user account, such as permits
+	RelayerKey string
+	// SkipPreflight sends the token transfers without first checking that
+	// the balance, the allowance and the gas funds cover them
+	SkipPreflight bool
 }
 
 // contractDeployerFacade will keep all the necessary data needed to handle 
@@ -28,19 +75,58 @@ type baseContractInteractorFacade struct {
 type contractDeployerFacade struct {
 	baseContractInteractorFacade
 	contractArgs []string
+	// create2Deployer is set when the contract is deployed through the
+	// CREATE2 factory
+	create2Deployer *c2.Create2Deployer
+	// proxyType is set when the contract is deployed as the implementation
+	// of an EIP-1967 proxy of this type, proxyArgs then holds the proxy
+	// constructor arguments following the implementation
+	proxyType string
+	proxyArgs []string
+	// deployments is set when the deployed contracts are recorded to the
+	// deployment manifest
+	deployments *deploymentRecorder
 }
 
 // NewContractDeployerFacade goes through the processes of creating an
 // interactive contract deployer object which is then used to deploy
-// contracts
+// contracts. With a salt the contract is deployed through the CREATE2
+// factory, which is deployed first when missing. With a proxy kind the
+// contract is deployed as the implementation of a new proxy, which is
+// initialized with the call data. The transactions follow the spending
+// policy of the policy file and the deployed contracts are recorded to the
+// deployment manifest.
 func NewContractDeployerFacade(
 	privateKey string,
 	rpc string,
 	contractArgs []string,
 	contractType string,
-	gasLimit int,
-	gasPrice int,
+	opts FacadeOptions,
 ) (*contractDeployerFacade, error) {
+	var create2Salt [32]byte
+	if len(opts.Salt) != 0 {
+		_, ok := consts.ContractNamesDict[contractType].(cc.ICreate2Contract)
+		if !ok {
+			return nil, fmt.Errorf("error: %s can't be deployed through a "+
+				"CREATE2 factory, its constructor would make the factory the "+
+				"owner", contractType)
+		}
+		parsedSalt, err := c2.ParseSalt(opts.Salt)
+		if err != nil {
+			return nil, err
+		}
+		create2Salt = parsedSalt
+	}
+	proxyType, proxyArgs := "", []string{}
+	if len(opts.ProxyKind) != 0 {
+		proxyType = opts.ProxyKind + "_proxy"
+		if len(opts.ProxyAdmin) != 0 {
+			proxyArgs = append(proxyArgs, opts.ProxyAdmin)
+		}
+		if len(opts.InitData) != 0 {
+			proxyArgs = append(proxyArgs, opts.InitData)
+		}
+	}
 	fmt.Println("Starting account and blockchain connection process.")
 	// Process the private key from the flag
 	userAccount, err := ethacc.CreateAccount(privateKey)
@@ -56,11 +142,11 @@ func NewContractDeployerFacade(
 		return nil, fmt.Errorf("error: failed to connect to given " +
 			"rpc url : %v \n", err1)
 	}
-	defer ethClient.CloseClient()
 
 	// Attempt to load data from the blockchain given the connected RPC Client
 	currBlockchainState, err2 := ethClient.LoadBlockChainState(context.Background())
 	if err2 != nil {
+		ethClient.CloseClient()
 		return nil, fmt.Errorf("error: failed to retrieve account using " +
 			"provided private key : %v\n", err2)
 	}
@@ -71,11 +157,36 @@ func NewContractDeployerFacade(
 
 	// Using the client and the account get data needed for contract deployment
 	auth, err3 := ethClient.GetDataForTransaction(context.Background(),
-		userAccount, currBlockchainState.ChainId, gasLimit, gasPrice)
+		userAccount, currBlockchainState.ChainId, opts.GasLimit, opts.GasPrice)
 	if err3 != nil {
+		ethClient.CloseClient()
 		return nil, fmt.Errorf("error: failed to get data for transaction " +
 			"processing: %v\n", err3)
 	}
+	err = guardTransactions(opts.PolicyPath, currBlockchainState.ChainId,
+		auth)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	err = auditTransactions(opts.AuditPath, ethClient.RawUrl, auth)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	deployments := recordDeployments(opts.ManifestPath,
+		currBlockchainState.ChainId, auth)
+
+	var create2Deployer *c2.Create2Deployer
+	if len(opts.Salt) != 0 {
+		create2Deployer = c2.NewCreate2Deployer(ethClient.EthClient,
+			create2Salt)
+		err4 := create2Deployer.EnsureFactory(context.Background(), auth)
+		if err4 != nil {
+			ethClient.CloseClient()
+			return nil, err4
+		}
+	}
 
 	contractDeployerFacade := &contractDeployerFacade{
 		baseContractInteractorFacade{
@@ -84,8 +195,13 @@ func NewContractDeployerFacade(
 			currBlockchainState,
 			auth,
 			contractType,
+			opts.ArgParser,
 		},
 		contractArgs,
+		create2Deployer,
+		proxyType,
+		proxyArgs,
+		deployments,
 	}
 	fmt.Println("Successfully completed account and blockchain connection " +
 		"process.")
@@ -95,10 +211,49 @@ func NewContractDeployerFacade(
 // DeployContract deploys the contract according to the
 // contract types deployment procedure
 func (c *contractDeployerFacade) DeployContract() error {
+	defer c.ethClient.CloseClient()
 	fmt.Println("Starting contract deployer process.")
 	contract := cc.Contract{
 		IContract: consts.ContractNamesDict[c.contractType],
 	}
+	contract.IContract.SetArgParser(c.argParser)
+	// Multicall3 is only deployed on the chains lacking the canonical one
+	if c.contractType == "multicall3" {
+		canonical := common.HexToAddress(mc3.CanonicalAddress)
+		ok := c.ethClient.VerifyContractExistsAtAddress(context.Background(),
+			nil, canonical)
+		if ok {
+			fmt.Printf("info: Multicall3 is already deployed at its "+
+				"canonical address %s, which the contract interactor uses "+
+				"by default\n", canonical.Hex())
+			fmt.Println("Successfully completed contract deployer process.")
+			return nil
+		}
+	}
+	c.deployments.begin()
+	if c.create2Deployer != nil {
+		err := contract.DeployContractCreate2(
+			c.contractArgs,
+			c.auth,
+			c.ethClient.EthClient,
+			c.create2Deployer)
+		if err != nil {
+			return err
+		}
+		initCode, err1 := contract.DeploymentCode()
+		if err1 != nil {
+			return err1
+		}
+		c.deployments.record(c.contractType,
+			c.create2Deployer.ComputeAddress(initCode))
+		fmt.Println("Successfully completed contract deployer process.")
+		return nil
+	}
+	// The implementation address follows from the nonce of the deployment
+	var implementation common.Address
+	if len(c.proxyType) != 0 {
+		implementation = crypto.CreateAddress(c.auth.From, c.auth.Nonce.Uint64())
+	}
 	err := contract.DeployContract(
 		c.contractArgs,
 		c.auth,
@@ -106,32 +261,120 @@ func (c *contractDeployerFacade) DeployContract() error {
 	if err != nil {
 		return err
 	}
+	c.deployments.recordCreation(c.contractType)
+	if len(c.proxyType) != 0 {
+		err1 := c.deployProxy(implementation)
+		if err1 != nil {
+			return err1
+		}
+	}
 	fmt.Println("Successfully completed contract deployer process.")
 	return nil
 }
 
+// deployProxy deploys the proxy in front of the implementation once its
+// deployment is mined, since the proxy constructor checks its code
+func (c *contractDeployerFacade) deployProxy(
+	implementation common.Address,
+) error {
+	c.auth.Nonce = new(big.Int).Add(c.auth.Nonce, big.NewInt(1))
+	fmt.Printf("info: Waiting for the implementation at %s to be mined\n",
+		implementation.Hex())
+	err := waitForCode(c.ethClient, implementation, 5*time.Minute)
+	if err != nil {
+		return err
+	}
+	proxyContract := cc.Contract{
+		IContract: consts.ContractNamesDict[c.proxyType],
+	}
+	proxyContract.IContract.SetArgParser(c.argParser)
+	c.deployments.begin()
+	err1 := proxyContract.DeployContract(
+		append([]string{implementation.Hex()}, c.proxyArgs...),
+		c.auth,
+		c.ethClient.EthClient)
+	if err1 != nil {
+		return err1
+	}
+	c.deployments.recordCreation(c.proxyType)
+	return nil
+}
+
+// waitForCode polls the address until contract code is deployed at it or
+// the timeout expires
+func waitForCode(
+	ethClient *ethrpc.EthRpcClient,
+	address common.Address,
+	timeout time.Duration,
+) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		code, err := ethClient.EthClient.CodeAt(ctx, address, nil)
+		if err == nil && len(code) != 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error: no contract code at %s after %s",
+				address.Hex(), timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
 // contractExecutorFacade will keep all the necessary data needed to handle
 // contract execution
 type contractExecutorFacade struct {
 	baseContractInteractorFacade
 	contractAddress common.Address
 	funcName        string
+	funcNames       []string
 	funcArguments   []string
+	callOpts        *bind.CallOpts
+	// multicaller is set when the queries are aggregated through Multicall3
+	multicaller *mc3.Multicaller
+	// relayerAuth is set when the messages signed by the user account, such
+	// as permits, are submitted by a relayer account
+	relayerAuth *bind.TransactOpts
+	// manifestPath is the deployment manifest the contract reads its
+	// deployer from, empty when it isn't read
+	manifestPath string
+	// skipPreflight sends the token transfers without the pre-flight
+	// checks
+	skipPreflight bool
 }
 
 // NewContractExecutionFacade goes through the processes of creating an
 // interactive contract executor object which is then used to interact with
-// contracts
+// contracts. Several function names are only accepted together with a
+// Multicall3 address which aggregates them into a single call. An empty
+// contract type is detected from the contract deployed at the address.
+// With a relayer key the messages signed by the user account are
+// submitted from the relayer account. The transactions follow the
+// spending policy of the policy file. The deployment manifest records the
+// deployments the contract information is read from.
 func NewContractExecutionFacade(
 	privateKey string,
 	rpc string,
 	contractType string,
 	contractAddress string,
-	funcName string,
+	funcNames []string,
 	funcArguments []string,
-	gasLimit int,
-	gasPrice int,
+	opts FacadeOptions,
 ) (*contractExecutorFacade, error) {
+	argParser := opts.ArgParser
+	if len(funcNames) > 1 && len(opts.MulticallAddress) == 0 {
+		return nil, fmt.Errorf("error: several functions can only be " +
+			"queried together through a multicall")
+	}
+	// Validate the contract address before connecting to anything
+	contAddress, err := argParser.ParseAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
 	fmt.Println("Starting account and blockchain connection process.")
 	// Process the private key from the flag
 	userAccount, err := ethacc.CreateAccount(privateKey)
@@ -141,17 +384,26 @@ func NewContractExecutionFacade(
 	fmt.Printf("Succesfully accessed account returned Public Key: %s\n",
 		userAccount.Account)
 
+	// View calls are made on behalf of the user unless a caller is given
+	caller := userAccount.Account
+	if len(opts.CallerAddress) != 0 {
+		caller, err = argParser.ParseAddress(opts.CallerAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Connect to the RPC client with the give URL
 	ethClient, err1 := ethrpc.CreateClient(rpc)
 	if err1 != nil {
 		return nil, fmt.Errorf("error: failed to connect to given " +
 			"rpc url : %v \n", err1)
 	}
-	defer ethClient.CloseClient()
 
 	// Attempt to load data from the blockchain given the connected RPC Client
 	currBlockchainState, err2 := ethClient.LoadBlockChainState(context.Background())
 	if err2 != nil {
+		ethClient.CloseClient()
 		return nil, fmt.Errorf("error: failed to retrieve account using " +
 			"provided private key : %v\n", err2)
 	}
@@ -160,20 +412,86 @@ func NewContractExecutionFacade(
 		"Height %d, for chain id: %d\n", ethClient.RawUrl,
 		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
 
-	contAddress := common.HexToAddress(contractAddress)
+	// Resolve the block the view calls will be executed at
+	callOpts, err3 := ethClient.GetDataForCall(context.Background(),
+		opts.Block, caller)
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, err3
+	}
+	verifyBlock := big.NewInt(int64(currBlockchainState.BlockNumber))
+	if callOpts.BlockNumber != nil {
+		verifyBlock = callOpts.BlockNumber
+		fmt.Printf("Queries will be executed at block %d\n", verifyBlock)
+	}
+
 	// Verify the contract exists at the specified address
 	ok := ethClient.VerifyContractExistsAtAddress(context.Background(),
-		big.NewInt(int64(currBlockchainState.BlockNumber)), contAddress)
+		verifyBlock, contAddress)
 	if !ok {
+		ethClient.CloseClient()
 		return nil, fmt.Errorf("error: contract doesn't exist at given address " +
-			": %s\n", contractAddress)
+			": %s at block %d\n", contractAddress, verifyBlock)
+	}
+	// A proxy forwards the calls to its implementation, whose type is
+	// used to encode them
+	typeAddress, err := resolveProxy(ethClient, contAddress, verifyBlock,
+		contractType, caller, userAccount.Account)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	// Detect the contract type when it isn't given, the functions can only
+	// be validated once it is known
+	contractType, err = resolveContractType(ethClient, typeAddress,
+		verifyBlock, contractType)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	err = consts.VerifyFunctionNames(contractType, funcNames,
+		len(opts.MulticallAddress) != 0)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	var multicaller *mc3.Multicaller
+	if len(opts.MulticallAddress) != 0 {
+		multicaller, err = newMulticaller(ethClient, opts.MulticallAddress,
+			verifyBlock, argParser)
+		if err != nil {
+			ethClient.CloseClient()
+			return nil, err
+		}
 	}
 	// Using the client and the account get data needed for contract deployment
-	auth, err3 := ethClient.GetDataForTransaction(context.Background(),
-		userAccount, currBlockchainState.ChainId, gasLimit, gasPrice)
-	if err3 != nil {
+	auth, err4 := ethClient.GetDataForTransaction(context.Background(),
+		userAccount, currBlockchainState.ChainId, opts.GasLimit, opts.GasPrice)
+	if err4 != nil {
+		ethClient.CloseClient()
 		return nil, fmt.Errorf("error: failed to get data for transaction " +
-			"processing: %v\n", err3)
+			"processing: %v\n", err4)
+	}
+	var relayerAuth *bind.TransactOpts
+	if len(opts.RelayerKey) != 0 {
+		relayerAuth, err = newRelayerAuth(ethClient, opts.RelayerKey,
+			currBlockchainState.ChainId, opts.GasLimit, opts.GasPrice)
+		if err != nil {
+			ethClient.CloseClient()
+			return nil, err
+		}
+	}
+	err = guardTransactions(opts.PolicyPath, currBlockchainState.ChainId,
+		auth, relayerAuth)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
+	}
+	err = auditTransactions(opts.AuditPath, ethClient.RawUrl, auth,
+		relayerAuth)
+	if err != nil {
+		ethClient.CloseClient()
+		return nil, err
 	}
 
 	contractExecutorFacade := &contractExecutorFacade{
@@ -183,10 +501,17 @@ func NewContractExecutionFacade(
 			currBlockchainState,
 			auth,
 			contractType,
+			argParser,
 		},
 		contAddress,
-		funcName,
+		funcNames[0],
+		funcNames,
 		funcArguments,
+		callOpts,
+		multicaller,
+		relayerAuth,
+		opts.ManifestPath,
+		opts.SkipPreflight,
 	}
 	fmt.Println("Successfully completed account and blockchain connection " +
 		"process.")
@@ -200,6 +525,10 @@ func (c *contractExecutorFacade) LoadContract() error {
 	contract := cc.Contract{
 		IContract: consts.ContractNamesDict[c.contractType],
 	}
+	contract.IContract.SetArgParser(c.argParser)
+	contract.SetSigner(c.userAccount, c.relayerAuth)
+	contract.SetSkipPreflight(c.skipPreflight)
+	loadDeployments(&contract, c.manifestPath, c.currBlockchainState.ChainId)
 	err := contract.LoadContract(
 		&c.contractAddress,
 		c.ethClient.EthClient)
@@ -214,15 +543,23 @@ func (c *contractExecutorFacade) LoadContract() error {
 // contract. It checks if the function is a query or write operation
 // and calls the appropriate functions for each.
 func (c *contractExecutorFacade) ExecuteContract() error {
+	defer c.ethClient.CloseClient()
 	fmt.Println("Starting contract executor process.")
 	queryFuncs := consts.ContractNamesToFuncNames[c.contractType]["query"]
 	writeFuncs := consts.ContractNamesToFuncNames[c.contractType]["write"]
 	contract := cc.Contract{
 		IContract: consts.ContractNamesDict[c.contractType],
 	}
-	if utils.Contains(&queryFuncs, c.funcName) {
+	if c.multicaller != nil {
+		// Aggregate every query function into a single call
+		err := contract.QueryContractMulticall(c.callOpts, c.multicaller,
+			c.funcNames, c.funcArguments)
+		if err != nil {
+			return err
+		}
+	} else if utils.Contains(&queryFuncs, c.funcName) {
 		// Use the query function
-		err := contract.QueryContract(c.funcName, c.funcArguments)
+		err := contract.QueryContract(c.callOpts, c.funcName, c.funcArguments)
 		if err != nil {
 			return err
 		}
@@ -238,3 +575,142 @@ func (c *contractExecutorFacade) ExecuteContract() error {
 	fmt.Println("Successfully completed contract execution process.")
 	return nil
 }
+
+// newRelayerAuth processes the private key of the relayer account and gets
+// the data needed for it to send the transactions
+func newRelayerAuth(
+	ethClient *ethrpc.EthRpcClient,
+	relayerKey string,
+	chainId *big.Int,
+	gasLimit int,
+	gasPrice int,
+) (*bind.TransactOpts, error) {
+	relayerAccount, err := ethacc.CreateAccount(relayerKey)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Signed messages will be submitted by the relayer %s\n",
+		relayerAccount.Account)
+	auth, err1 := ethClient.GetDataForTransaction(context.Background(),
+		relayerAccount, chainId, gasLimit, gasPrice)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to get data for the relayer "+
+			"transaction processing: %v", err1)
+	}
+	return auth, nil
+}
+
+// newMulticaller verifies that Multicall3 is deployed at the given address
+// and creates the multicaller used to aggregate the queries
+func newMulticaller(
+	ethClient *ethrpc.EthRpcClient,
+	multicallAddress string,
+	block *big.Int,
+	argParser *utils.ArgParser,
+) (*mc3.Multicaller, error) {
+	address, err := argParser.ParseAddress(multicallAddress)
+	if err != nil {
+		return nil, err
+	}
+	ok := ethClient.VerifyContractExistsAtAddress(context.Background(),
+		block, address)
+	if !ok {
+		return nil, fmt.Errorf("error: Multicall3 doesn't exist at %s at "+
+			"block %d, deploy it with the contract deployer and pass its "+
+			"address with --multicalladdress", multicallAddress, block)
+	}
+	fmt.Printf("Queries will be aggregated through Multicall3 at %s\n",
+		address.Hex())
+	return mc3.NewMulticaller(address, ethClient.EthClient)
+}
+
+// resolveProxy returns the address whose code decides the contract type,
+// which is the implementation when the address is an EIP-1967 proxy unless
+// a proxy type is given to manage the proxy itself. A warning is printed
+// when one of the accounts is the admin, as a transparent proxy doesn't
+// forward the calls of its admin.
+func resolveProxy(
+	ethClient *ethrpc.EthRpcClient,
+	address common.Address,
+	block *big.Int,
+	contractType string,
+	accounts ...common.Address,
+) (common.Address, error) {
+	if strings.HasSuffix(contractType, "_proxy") {
+		return address, nil
+	}
+	implementation, err := proxy.ReadSlot(context.Background(),
+		ethClient.EthClient, address, proxy.ImplementationSlot, block)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if implementation == (common.Address{}) {
+		return address, nil
+	}
+	fmt.Printf("info: %s is an EIP-1967 proxy forwarding to the "+
+		"implementation %s, use --contract transparent_proxy or uups_proxy "+
+		"to manage the proxy itself\n", address.Hex(), implementation.Hex())
+	admin, err1 := proxy.ReadSlot(context.Background(), ethClient.EthClient,
+		address, proxy.AdminSlot, block)
+	if err1 != nil {
+		return common.Address{}, err1
+	}
+	for _, account := range accounts {
+		if admin != (common.Address{}) && account == admin {
+			fmt.Printf("warning: %s is the admin of the proxy, a transparent "+
+				"proxy doesn't forward the calls of its admin to the "+
+				"implementation\n", account.Hex())
+			break
+		}
+	}
+	return implementation, nil
+}
+
+// resolveContractType detects the type of the contract deployed at the
+// address when none is given. A given type is kept, but a warning is
+// printed when the deployed contract looks like another known type.
+func resolveContractType(
+	ethClient *ethrpc.EthRpcClient,
+	address common.Address,
+	block *big.Int,
+	contractType string,
+) (string, error) {
+	detection, err := cc.DetectContractType(context.Background(),
+		ethClient.EthClient, address, block, consts.ContractNamesToMetaData)
+	if len(contractType) != 0 {
+		if err != nil {
+			fmt.Printf("warning: failed to detect the contract type: %v\n", err)
+		} else if len(detection.ContractType) != 0 &&
+			!utils.Contains(&detection.Candidates, contractType) {
+			fmt.Printf("warning: the contract at %s looks like %s rather "+
+				"than %s\n", address.Hex(), detection.ContractType, contractType)
+		}
+		return contractType, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(detection.ContractType) == 0 {
+		if len(detection.Candidates) > 1 {
+			return "", fmt.Errorf("error: the contract at %s could be any of "+
+				"%s, pass its type with --contract", address.Hex(),
+				strings.Join(detection.Candidates, ", "))
+		}
+		standards := "no known standard"
+		if len(detection.Standards) != 0 {
+			standards = strings.Join(detection.Standards, ", ")
+		}
+		return "", fmt.Errorf("error: failed to detect the type of the "+
+			"contract at %s which implements %s, pass its type with "+
+			"--contract", address.Hex(), standards)
+	}
+	if detection.ByBytecode {
+		fmt.Printf("info: Detected contract type %s from the bytecode at %s\n",
+			detection.ContractType, address.Hex())
+	} else {
+		fmt.Printf("info: Detected contract type %s at %s which implements "+
+			"%s\n", detection.ContractType, address.Hex(),
+			strings.Join(detection.Standards, ", "))
+	}
+	return detection.ContractType, nil
+}
@@ -0,0 +1,132 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	consts "go-evm-client/internal/constants"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// contractWatchFacade will keep all the necessary data needed to stream
+// the events of a contract as they are emitted
+type contractWatchFacade struct {
+	ethClient       *ethrpc.EthRpcClient
+	contractType    string
+	contractAddress common.Address
+	eventName       string
+	filter          cc.EventFilter
+	subscribe       bool
+	pollInterval    time.Duration
+	pageSize        uint64
+	argParser       *utils.ArgParser
+}
+
+// NewContractWatchFacade goes through the processes of connecting to the
+// node and resolving the filters which are then used to stream the events
+// of a contract. Subscriptions are used for ws:// and wss:// endpoints,
+// any other endpoint is polled.
+func NewContractWatchFacade(
+	rpc string,
+	contractType string,
+	contractAddress string,
+	eventName string,
+	fromAddresses []string,
+	toAddresses []string,
+	pollInterval time.Duration,
+	pageSize uint64,
+	argParser *utils.ArgParser,
+) (*contractWatchFacade, error) {
+	// Validate the address arguments before connecting to anything
+	contAddress, err := argParser.ParseAddress(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+	filter, err1 := parseEventFilter(argParser, fromAddresses, toAddresses)
+	if err1 != nil {
+		return nil, err1
+	}
+
+	fmt.Println("Starting blockchain connection process.")
+	// Connect to the RPC client with the give URL
+	ethClient, err2 := ethrpc.CreateClient(rpc)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err2)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err3 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err3)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+
+	// Verify the contract exists at the specified address
+	ok := ethClient.VerifyContractExistsAtAddress(context.Background(), nil,
+		contAddress)
+	if !ok {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: contract doesn't exist at given "+
+			"address : %s\n", contractAddress)
+	}
+
+	scheme := strings.ToLower(strings.SplitN(rpc, "://", 2)[0])
+	subscribe := scheme == "ws" || scheme == "wss"
+	if !subscribe {
+		fmt.Printf("info: %s is not a websocket endpoint, polling for new "+
+			"events every %s\n", rpc, pollInterval)
+	}
+	fmt.Println("Successfully completed blockchain connection process.")
+	return &contractWatchFacade{
+		ethClient:       ethClient,
+		contractType:    contractType,
+		contractAddress: contAddress,
+		eventName:       eventName,
+		filter:          filter,
+		subscribe:       subscribe,
+		pollInterval:    pollInterval,
+		pageSize:        pageSize,
+		argParser:       argParser,
+	}, nil
+}
+
+// WatchEvents loads the contract and streams its events until the
+// process is interrupted
+func (c *contractWatchFacade) WatchEvents() error {
+	defer c.ethClient.CloseClient()
+	fmt.Println("Starting contract watch process.")
+	contract := cc.Contract{
+		IContract: consts.ContractNamesDict[c.contractType],
+	}
+	contract.IContract.SetArgParser(c.argParser)
+	err := contract.LoadContract(
+		&c.contractAddress,
+		c.ethClient.EthClient)
+	if err != nil {
+		return err
+	}
+	// Stop watching gracefully on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt,
+		syscall.SIGTERM)
+	defer stop()
+	err1 := contract.WatchEvents(ctx, c.ethClient.EthClient, c.eventName,
+		c.filter, c.subscribe, c.pollInterval, c.pageSize)
+	if err1 != nil {
+		return err1
+	}
+	fmt.Println("Successfully completed contract watch process.")
+	return nil
+}
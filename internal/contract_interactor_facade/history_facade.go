@@ -0,0 +1,202 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	consts "go-evm-client/internal/constants"
+	utils "go-evm-client/internal/utils"
+	audit "go-evm-client/pkg/audit_log"
+	ci "go-evm-client/pkg/chain_inspector"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"strings"
+	"time"
+)
+
+// dateFormat is the layout of the days accepted by the time filters
+const dateFormat = "2006-01-02"
+
+// historyFacade will keep the audit log and the filter of the entries
+// shown, the node is only used to update the status of pending entries
+type historyFacade struct {
+	log       *audit.AuditLog
+	filter    *audit.Filter
+	ethClient *ethrpc.EthRpcClient
+	chainId   uint64
+	format    string
+}
+
+// NewHistoryFacade parses the filters of the history command. When an RPC
+// URL is given, the receipts of the pending transactions sent to its
+// network are fetched and appended to the audit log.
+func NewHistoryFacade(
+	auditPath string,
+	rpc string,
+	from string,
+	to string,
+	chainId uint64,
+	function string,
+	status string,
+	since string,
+	until string,
+	limit int,
+	format string,
+) (*historyFacade, error) {
+	err := checkFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	filter := &audit.Filter{
+		ChainId:  chainId,
+		Function: function,
+		Status:   status,
+		Limit:    limit,
+	}
+	var parser *utils.ArgParser
+	if len(from) != 0 {
+		address, err1 := parser.ParseAddress(from)
+		if err1 != nil {
+			return nil, err1
+		}
+		filter.From = &address
+	}
+	if len(to) != 0 {
+		address, err2 := parser.ParseAddress(to)
+		if err2 != nil {
+			return nil, err2
+		}
+		filter.To = &address
+	}
+	switch status {
+	case "", audit.StatusPending, audit.StatusSuccess, audit.StatusReverted:
+	default:
+		return nil, fmt.Errorf("error: unknown status %q, expected %s, %s "+
+			"or %s", status, audit.StatusPending, audit.StatusSuccess,
+			audit.StatusReverted)
+	}
+	filter.Since, err = parseHistoryTime(since, false)
+	if err != nil {
+		return nil, err
+	}
+	filter.Until, err = parseHistoryTime(until, true)
+	if err != nil {
+		return nil, err
+	}
+	facade := &historyFacade{
+		log:    audit.NewAuditLog(auditPath),
+		filter: filter,
+		format: format,
+	}
+	if len(rpc) == 0 {
+		return facade, nil
+	}
+	// Connect to the RPC client with the give URL
+	ethClient, err3 := ethrpc.CreateClient(rpc)
+	if err3 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err3)
+	}
+	currBlockchainState, err4 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err4)
+	}
+	if format == TextFormat {
+		fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+			"Height %d, for chain id: %d\n", ethClient.RawUrl,
+			currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+	}
+	facade.ethClient = ethClient
+	facade.chainId = currBlockchainState.ChainId.Uint64()
+	return facade, nil
+}
+
+// parseHistoryTime parses a time filter given in RFC 3339 or as a day in
+// UTC, a day given as the end of the range includes the whole day
+func parseHistoryTime(arg string, endOfDay bool) (time.Time, error) {
+	if len(arg) == 0 {
+		return time.Time{}, nil
+	}
+	day, err := time.Parse(dateFormat, arg)
+	if err == nil {
+		if endOfDay {
+			return day.Add(24*time.Hour - time.Nanosecond), nil
+		}
+		return day, nil
+	}
+	parsed, err1 := time.Parse(time.RFC3339, arg)
+	if err1 != nil {
+		return time.Time{}, fmt.Errorf("error: invalid time %q, expected a "+
+			"day such as 2006-01-02 or an RFC 3339 time such as "+
+			"2006-01-02T15:04:05Z", arg)
+	}
+	return parsed, nil
+}
+
+// Show prints the entries of the audit log selected by the filter, from
+// the oldest to the latest
+func (h *historyFacade) Show() error {
+	if h.ethClient != nil {
+		defer h.ethClient.CloseClient()
+	}
+	records, err := audit.ReadRecords(h.log.Path())
+	if err != nil {
+		return err
+	}
+	history := audit.History(records)
+	if h.ethClient != nil {
+		err1 := h.updatePending(history)
+		if err1 != nil {
+			return err1
+		}
+	}
+	selected := h.filter.Apply(history)
+	return printReport(h.format, selected, func() string {
+		return audit.HistoryText(selected)
+	})
+}
+
+// updatePending replaces the pending entries of the connected network
+// which got mined with their receipt record, which is appended to the log
+func (h *historyFacade) updatePending(history []*audit.Record) error {
+	decoder, err := consts.NewKnownDecoder()
+	if err != nil {
+		return err
+	}
+	inspector := ci.NewInspector(h.ethClient, decoder)
+	var unknown []string
+	updated := 0
+	for i, record := range history {
+		if record.Status != audit.StatusPending || record.ChainId != h.chainId {
+			continue
+		}
+		report, err1 := inspector.Transaction(context.Background(),
+			record.TxHash)
+		if err1 != nil {
+			// Replaced and dropped transactions are unknown to the node
+			unknown = append(unknown, record.TxHash.Hex())
+			continue
+		}
+		if report.Status == audit.StatusPending {
+			continue
+		}
+		outcome := record.WithReceipt(report, time.Now())
+		err2 := h.log.Append(outcome)
+		if err2 != nil {
+			return err2
+		}
+		history[i] = outcome
+		updated++
+	}
+	if h.format == TextFormat {
+		fmt.Printf("info: Updated the status of %d pending transactions\n",
+			updated)
+		if len(unknown) != 0 {
+			fmt.Printf("warning: %d pending transactions are unknown to the "+
+				"node, they were dropped or replaced:\n  %s\n", len(unknown),
+				strings.Join(unknown, "\n  "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	consts "go-evm-client/internal/constants"
+	utils "go-evm-client/internal/utils"
+	ci "go-evm-client/pkg/chain_inspector"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+)
+
+// Output formats of the inspection commands
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+)
+
+// inspectFacade will keep all the necessary data needed to show
+// transactions and blocks
+type inspectFacade struct {
+	ethClient *ethrpc.EthRpcClient
+	inspector *ci.Inspector
+	format    string
+}
+
+// NewInspectFacade goes through the processes of connecting to the node
+// and loading the ABIs of the supported contracts used to decode calldata
+// and logs. Nothing but the report is printed with the JSON format so
+// that the output can be piped.
+func NewInspectFacade(rpc string, format string) (*inspectFacade, error) {
+	err := checkFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := consts.NewKnownDecoder()
+	if err != nil {
+		return nil, err
+	}
+	// Connect to the RPC client with the give URL
+	ethClient, err1 := ethrpc.CreateClient(rpc)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err1)
+	}
+	if format == TextFormat {
+		currBlockchainState, err2 := ethClient.LoadBlockChainState(
+			context.Background())
+		if err2 != nil {
+			ethClient.CloseClient()
+			return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+				"state : %v\n", err2)
+		}
+		fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+			"Height %d, for chain id: %d\n", ethClient.RawUrl,
+			currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+	}
+	return &inspectFacade{
+		ethClient: ethClient,
+		inspector: ci.NewInspector(ethClient, decoder),
+		format:    format,
+	}, nil
+}
+
+// ShowTransaction prints the transaction of the given hash with its
+// decoded calldata, receipt and decoded logs
+func (i *inspectFacade) ShowTransaction(hash string) error {
+	defer i.ethClient.CloseClient()
+	txHash, err := utils.ParseHash(hash)
+	if err != nil {
+		return err
+	}
+	report, err1 := i.inspector.Transaction(context.Background(), txHash)
+	if err1 != nil {
+		return err1
+	}
+	return printReport(i.format, report, report.Text)
+}
+
+// ShowBlock prints the header fields, the gas usage and the transactions
+// of the block given as a number, a hash or a tag
+func (i *inspectFacade) ShowBlock(block string, batchSize int) error {
+	defer i.ethClient.CloseClient()
+	report, err := i.inspector.Block(context.Background(), block, batchSize)
+	if err != nil {
+		return err
+	}
+	return printReport(i.format, report, report.Text)
+}
+
+// checkFormat validates the output format given to a facade
+func checkFormat(format string) error {
+	if format != TextFormat && format != JSONFormat {
+		return fmt.Errorf("error: unsupported output format %q, expected "+
+			"%s or %s", format, TextFormat, JSONFormat)
+	}
+	return nil
+}
+
+// printReport writes the report as text or as indented JSON
+func printReport(format string, report interface{}, text func() string) error {
+	if format == TextFormat {
+		fmt.Print(text())
+		return nil
+	}
+	output, err := ci.JSON(report)
+	if err != nil {
+		return err
+	}
+	fmt.Print(output)
+	return nil
+}
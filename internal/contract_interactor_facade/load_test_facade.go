@@ -0,0 +1,193 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	utils "go-evm-client/internal/utils"
+	dtt "go-evm-client/pkg/contracts/detailed_test_token"
+	ethacc "go-evm-client/pkg/eth_account"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	lg "go-evm-client/pkg/load_generator"
+	"math/big"
+	"time"
+)
+
+// loadTestFacade will keep all the necessary data needed to generate
+// transaction load on a node
+type loadTestFacade struct {
+	ethClient *ethrpc.EthRpcClient
+	generator *lg.LoadGenerator
+}
+
+// NewLoadTestFacade goes through the processes of connecting to the node
+// and deriving the sender accounts from the account of the private key.
+// Token transfers are generated when a token address is given, native
+// sends otherwise. The fund amount is the native balance of every sender
+// in wei or in decimal coins, the token amount follows the token amount
+// rules of the interactor. The funding transactions of the account must
+// follow the spending policy file, their value and fees count toward its
+// daily caps, and are recorded to the audit log unless auditPath is empty.
+func NewLoadTestFacade(
+	privateKey string,
+	rpc string,
+	tokenAddress string,
+	senders int,
+	rate float64,
+	concurrency int,
+	duration time.Duration,
+	fundAmount string,
+	tokenAmount string,
+	pollInterval time.Duration,
+	inclusionTimeout time.Duration,
+	gasLimit int,
+	gasPrice int,
+	policyPath string,
+	auditPath string,
+	argParser *utils.ArgParser,
+) (*loadTestFacade, error) {
+	var token *common.Address
+	if len(tokenAddress) != 0 {
+		address, err := argParser.ParseAddress(tokenAddress)
+		if err != nil {
+			return nil, err
+		}
+		token = &address
+	}
+	nativeAmount, err1 := utils.ParseNativeAmount(fundAmount)
+	if err1 != nil {
+		return nil, err1
+	}
+	fmt.Println("Starting account and blockchain connection process.")
+	// Process the private key from the flag
+	userAccount, err2 := ethacc.CreateAccount(privateKey)
+	if err2 != nil {
+		return nil, err2
+	}
+	fmt.Printf("Succesfully accessed account returned Public Key: %s\n",
+		userAccount.Account)
+
+	// Connect to the RPC client with the give URL
+	ethClient, err3 := ethrpc.CreateClient(rpc)
+	if err3 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err3)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err4 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err4)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+
+	config := lg.Config{
+		Senders:          senders,
+		Token:            token,
+		Rate:             rate,
+		Concurrency:      concurrency,
+		Duration:         duration,
+		GasLimit:         uint64(gasLimit),
+		GasPrice:         big.NewInt(int64(gasPrice)),
+		FundAmount:       nativeAmount,
+		PollInterval:     pollInterval,
+		InclusionTimeout: inclusionTimeout,
+	}
+	if token != nil {
+		config.TokenAmount, err4 = parseLoadTokenAmount(ethClient, *token,
+			tokenAmount, argParser)
+		if err4 != nil {
+			ethClient.CloseClient()
+			return nil, err4
+		}
+	}
+	generator, err5 := lg.NewLoadGenerator(ethClient.EthClient,
+		currBlockchainState.ChainId, userAccount.PrivateKey, config)
+	if err5 != nil {
+		ethClient.CloseClient()
+		return nil, err5
+	}
+	// Only the funder spends from the account, the senders circulate what
+	// they were funded with
+	funderAuth := &bind.TransactOpts{
+		From:   userAccount.Account,
+		Signer: generator.FunderSigner,
+	}
+	err6 := guardTransactions(policyPath, currBlockchainState.ChainId,
+		funderAuth)
+	if err6 != nil {
+		ethClient.CloseClient()
+		return nil, err6
+	}
+	err7 := auditTransactions(auditPath, ethClient.RawUrl, funderAuth)
+	if err7 != nil {
+		ethClient.CloseClient()
+		return nil, err7
+	}
+	generator.FunderSigner = funderAuth.Signer
+	fmt.Printf("Transactions will be sent by %d accounts derived from %s\n",
+		senders, userAccount.Account.Hex())
+	fmt.Println("Successfully completed account and blockchain connection " +
+		"process.")
+	return &loadTestFacade{
+		ethClient: ethClient,
+		generator: generator,
+	}, nil
+}
+
+// parseLoadTokenAmount verifies the token contract and converts the amount
+// every sender is funded with using the token decimals
+func parseLoadTokenAmount(
+	ethClient *ethrpc.EthRpcClient,
+	token common.Address,
+	tokenAmount string,
+	argParser *utils.ArgParser,
+) (*big.Int, error) {
+	ok := ethClient.VerifyContractExistsAtAddress(context.Background(), nil,
+		token)
+	if !ok {
+		return nil, fmt.Errorf("error: contract doesn't exist at given "+
+			"address : %s\n", token.Hex())
+	}
+	// Every supported token shares the ERC20 functions of this binding
+	instance, err := dtt.NewDetailedTestToken(token, ethClient.EthClient)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := &bind.CallOpts{Context: context.Background()}
+	decimals, err1 := instance.Decimals(callOpts)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to read the token decimals, "+
+			"is %s an ERC20 token? %v", token.Hex(), err1)
+	}
+	symbol, err2 := instance.Symbol(callOpts)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to read the token symbol: %v",
+			err2)
+	}
+	return argParser.ParseAmount(tokenAmount, decimals, symbol)
+}
+
+// RunLoadTest funds the senders, generates the load and prints the report
+func (l *loadTestFacade) RunLoadTest() error {
+	defer l.ethClient.CloseClient()
+	fmt.Println("Starting load test funding process.")
+	err := l.generator.Fund(context.Background())
+	if err != nil {
+		return err
+	}
+	fmt.Println("Starting load test process.")
+	report, err1 := l.generator.Run(context.Background())
+	if err1 != nil {
+		return err1
+	}
+	fmt.Print(report.String())
+	fmt.Println("Successfully completed load test process.")
+	return nil
+}
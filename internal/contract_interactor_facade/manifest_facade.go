@@ -0,0 +1,107 @@
+package contract_interactor_facade
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	cc "go-evm-client/internal/contracts_template_interface"
+	manifest "go-evm-client/pkg/deployment_manifest"
+	"math/big"
+)
+
+// DefaultManifest is the deployment manifest written when no other file
+// is given
+const DefaultManifest = "deployments.jsonl"
+
+// deploymentRecorder writes the contracts deployed with the options to the
+// deployment manifest. The transactions signed with the options are kept
+// so that a deployment is recorded with the transaction which sent it.
+type deploymentRecorder struct {
+	manifest *manifest.Manifest
+	auth     *bind.TransactOpts
+	signed   *types.Transaction
+}
+
+// recordDeployments creates the recorder of the contracts deployed with
+// the options, nil when the path is empty which disables the manifest. The
+// signer is wrapped last so that the transactions blocked by the spending
+// policy aren't kept.
+func recordDeployments(
+	manifestPath string,
+	chainId *big.Int,
+	auth *bind.TransactOpts,
+) *deploymentRecorder {
+	if len(manifestPath) == 0 {
+		return nil
+	}
+	recorder := &deploymentRecorder{
+		manifest: manifest.NewManifest(manifestPath, chainId.Uint64()),
+		auth:     auth,
+	}
+	signer := auth.Signer
+	auth.Signer = func(
+		from common.Address,
+		tx *types.Transaction,
+	) (*types.Transaction, error) {
+		signedTx, err := signer(from, tx)
+		if err == nil {
+			recorder.signed = signedTx
+		}
+		return signedTx, err
+	}
+	fmt.Printf("info: Deployed contracts are recorded to the deployment "+
+		"manifest %s\n", manifestPath)
+	return recorder
+}
+
+// begin forgets the transactions signed so far, it is called before a
+// contract is deployed
+func (r *deploymentRecorder) begin() {
+	if r != nil {
+		r.signed = nil
+	}
+}
+
+// record writes the contract deployed at the address with the transaction
+// signed since begin was called. Nothing is written when no transaction
+// was signed, such as when the contract was already deployed. The contract
+// is deployed by then, so a failure to write it is only a warning.
+func (r *deploymentRecorder) record(
+	contract string,
+	address common.Address,
+) {
+	if r == nil || r.signed == nil {
+		return
+	}
+	err := r.manifest.Append(contract, address, r.auth.From, r.signed.Hash())
+	if err != nil {
+		fmt.Printf("warning: the deployment of %s at %s wasn't recorded: "+
+			"%v\n", contract, address.Hex(), err)
+	}
+}
+
+// recordCreation writes the contract deployed by the contract creation
+// transaction signed since begin was called, its address follows from
+// the nonce of the transaction
+func (r *deploymentRecorder) recordCreation(contract string) {
+	if r == nil || r.signed == nil {
+		return
+	}
+	r.record(contract, crypto.CreateAddress(r.auth.From, r.signed.Nonce()))
+}
+
+// loadDeployments passes the deployments of the manifest to the contract,
+// nothing is passed when the path is empty
+func loadDeployments(
+	contract *cc.Contract,
+	manifestPath string,
+	chainId *big.Int,
+) {
+	if len(manifestPath) == 0 {
+		return
+	}
+	contract.SetDeployments(manifest.NewManifest(manifestPath,
+		chainId.Uint64()))
+}
@@ -0,0 +1,51 @@
+package contract_interactor_facade
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	policy "go-evm-client/pkg/spending_policy"
+	"math/big"
+	"os"
+)
+
+// DefaultPolicyFile is the spending policy applied when no other policy
+// file is given, it is only read when it exists
+const DefaultPolicyFile = "spending_policy.json"
+
+// guardTransactions makes the transactions signed with the options follow
+// the rules of the policy file for the chain. No policy is applied when
+// the path is empty or when the default file doesn't exist, for instance
+// when the command isn't run from the root of the repository. The daily
+// spending is recorded in a state file next to the policy file.
+func guardTransactions(
+	policyPath string,
+	chainId *big.Int,
+	auths ...*bind.TransactOpts,
+) error {
+	if len(policyPath) == 0 {
+		return nil
+	}
+	_, err := os.Stat(policyPath)
+	if os.IsNotExist(err) && policyPath == DefaultPolicyFile {
+		return nil
+	}
+	spendingPolicy, err1 := policy.LoadPolicy(policyPath)
+	if err1 != nil {
+		return err1
+	}
+	if spendingPolicy.RulesOf(chainId) == nil {
+		fmt.Printf("info: The spending policy %s has no rules for chain %d\n",
+			policyPath, chainId)
+		return nil
+	}
+	guard := policy.NewGuard(spendingPolicy, chainId,
+		policyPath+".state.json")
+	for _, auth := range auths {
+		if auth != nil {
+			auth.Signer = guard.WrapSigner(auth.Signer)
+		}
+	}
+	fmt.Printf("info: Transactions follow the spending policy %s for chain "+
+		"%d\n", policyPath, chainId)
+	return nil
+}
@@ -0,0 +1,70 @@
+package contract_interactor_facade
+
+import (
+	"fmt"
+	sigdb "go-evm-client/pkg/signature_db"
+	"strings"
+)
+
+// signatureFacade will keep the signature database built from the ABIs
+// and the signature files, no node is involved
+type signatureFacade struct {
+	signatures *sigdb.SignatureDB
+	format     string
+}
+
+// collisionReport is the output of the collisions command
+type collisionReport struct {
+	Functions  int            `json:"functions"`
+	Events     int            `json:"events"`
+	Collisions []*sigdb.Match `json:"collisions"`
+}
+
+// NewSignatureFacade indexes the signatures of the supported contracts,
+// of the ABI files of the given directory and of the comma separated
+// 4byte-style files given
+func NewSignatureFacade(abiDir string, sigFiles string, format string) (
+	*signatureFacade, error) {
+	err := checkFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	abis, err1 := loadAbis(abiDir)
+	if err1 != nil {
+		return nil, err1
+	}
+	signatures, err2 := newSignatureDB(abis, sigFiles)
+	if err2 != nil {
+		return nil, err2
+	}
+	return &signatureFacade{signatures: signatures, format: format}, nil
+}
+
+// Lookup prints the signatures of a selector, of a topic or of the key of
+// a text signature
+func (s *signatureFacade) Lookup(query string) error {
+	match, err := s.signatures.Lookup(query)
+	if err != nil {
+		return err
+	}
+	return printReport(s.format, match, match.Text)
+}
+
+// Collisions prints the selectors and topics shared by several signatures
+func (s *signatureFacade) Collisions() error {
+	functions, events := s.signatures.Size()
+	report := &collisionReport{
+		Functions:  functions,
+		Events:     events,
+		Collisions: s.signatures.Collisions(),
+	}
+	return printReport(s.format, report, func() string {
+		var b strings.Builder
+		fmt.Fprintf(&b, "%d collisions among %d function and %d event "+
+			"signatures\n", len(report.Collisions), functions, events)
+		for _, collision := range report.Collisions {
+			b.WriteString(collision.Text())
+		}
+		return b.String()
+	})
+}
@@ -0,0 +1,182 @@
+package contract_interactor_facade
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	utils "go-evm-client/internal/utils"
+	ethacc "go-evm-client/pkg/eth_account"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"time"
+)
+
+// txPollInterval is the delay between two checks for the mining of the
+// original and the replacement transactions
+const txPollInterval = 2 * time.Second
+
+// txFacade will keep all the necessary data needed to replace a pending
+// transaction of the account
+type txFacade struct {
+	ethClient   *ethrpc.EthRpcClient
+	userAccount *ethacc.UserAccount
+	auth        *bind.TransactOpts
+	chainId     *big.Int
+	hash        common.Hash
+	bumpPercent int
+	waitTimeout time.Duration
+}
+
+// NewTxFacade goes through the processes of connecting to the node with
+// the account which sent the pending transaction of the given hash. The
+// fees of the replacement are bumped by bumpPercent and the mining of one
+// of the transactions is waited for during waitTimeout, zero doesn't wait.
+// The replacement must follow the spending policy file and is recorded to
+// the audit log unless auditPath is empty.
+func NewTxFacade(
+	privateKey string,
+	rpc string,
+	hash string,
+	bumpPercent int,
+	waitTimeout time.Duration,
+	policyPath string,
+	auditPath string,
+) (*txFacade, error) {
+	txHash, err := utils.ParseHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Starting account and blockchain connection process.")
+	// Process the private key from the flag
+	userAccount, err1 := ethacc.CreateAccount(privateKey)
+	if err1 != nil {
+		return nil, err1
+	}
+	fmt.Printf("Succesfully accessed account returned Public Key: %s\n",
+		userAccount.Account)
+
+	// Connect to the RPC client with the give URL
+	ethClient, err2 := ethrpc.CreateClient(rpc)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to connect to given "+
+			"rpc url : %v \n", err2)
+	}
+
+	// Attempt to load data from the blockchain given the connected RPC Client
+	currBlockchainState, err3 := ethClient.LoadBlockChainState(
+		context.Background())
+	if err3 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to retrieve blockchain "+
+			"state : %v\n", err3)
+	}
+	fmt.Printf("Succesfully connected to RPC client %s. Current Block "+
+		"Height %d, for chain id: %d\n", ethClient.RawUrl,
+		currBlockchainState.BlockNumber, currBlockchainState.ChainId)
+	// The replacements are signed through the options so that they follow
+	// the spending policy and are recorded like the other transactions
+	auth, err4 := bind.NewKeyedTransactorWithChainID(userAccount.PrivateKey,
+		currBlockchainState.ChainId)
+	if err4 != nil {
+		ethClient.CloseClient()
+		return nil, fmt.Errorf("error: failed to create the signer: %v", err4)
+	}
+	err5 := guardTransactions(policyPath, currBlockchainState.ChainId, auth)
+	if err5 != nil {
+		ethClient.CloseClient()
+		return nil, err5
+	}
+	err6 := auditTransactions(auditPath, ethClient.RawUrl, auth)
+	if err6 != nil {
+		ethClient.CloseClient()
+		return nil, err6
+	}
+	fmt.Println("Successfully completed account and blockchain connection " +
+		"process.")
+	return &txFacade{
+		ethClient:   ethClient,
+		userAccount: userAccount,
+		auth:        auth,
+		chainId:     currBlockchainState.ChainId,
+		hash:        txHash,
+		bumpPercent: bumpPercent,
+		waitTimeout: waitTimeout,
+	}, nil
+}
+
+// SpeedUp sends the pending transaction again with bumped fees and
+// reports which of the two transactions got mined
+func (t *txFacade) SpeedUp() error {
+	defer t.ethClient.CloseClient()
+	fmt.Println("Starting transaction speed up process.")
+	original, replacement, err := t.ethClient.SpeedUpTransaction(
+		context.Background(), t.auth, t.chainId, t.hash,
+		t.bumpPercent)
+	if err != nil {
+		return err
+	}
+	err1 := t.waitForReplacement(original, replacement)
+	if err1 != nil {
+		return err1
+	}
+	fmt.Println("Successfully completed transaction speed up process.")
+	return nil
+}
+
+// Cancel replaces the pending transaction with a zero value transfer to
+// the account itself and reports which of the two transactions got mined
+func (t *txFacade) Cancel() error {
+	defer t.ethClient.CloseClient()
+	fmt.Println("Starting transaction cancel process.")
+	original, replacement, err := t.ethClient.CancelTransaction(
+		context.Background(), t.auth, t.chainId, t.hash,
+		t.bumpPercent)
+	if err != nil {
+		return err
+	}
+	err1 := t.waitForReplacement(original, replacement)
+	if err1 != nil {
+		return err1
+	}
+	fmt.Println("Successfully completed transaction cancel process.")
+	return nil
+}
+
+// waitForReplacement prints the replacement sent and waits
+// until either the original or the replacement transaction is mined
+func (t *txFacade) waitForReplacement(
+	original *types.Transaction,
+	replacement *types.Transaction,
+) error {
+	fmt.Printf("info: Sent replacement %s with nonce %d, fee cap %d wei "+
+		"and tip %d wei replacing %s with fee cap %d wei and tip %d wei\n",
+		replacement.Hash().Hex(), replacement.Nonce(), replacement.GasFeeCap(),
+		replacement.GasTipCap(), original.Hash().Hex(), original.GasFeeCap(),
+		original.GasTipCap())
+	if t.waitTimeout <= 0 {
+		fmt.Println("info: Not waiting for the transactions to be mined")
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), t.waitTimeout)
+	defer cancel()
+	receipt, err := t.ethClient.WaitForReplacement(ctx,
+		t.userAccount.Account, original.Nonce(),
+		[]common.Hash{replacement.Hash(), original.Hash()}, txPollInterval)
+	if err != nil {
+		return err
+	}
+	mined := "Replacement"
+	if receipt.TxHash == original.Hash() {
+		mined = "Original"
+	}
+	fmt.Printf("info: %s transaction %s was mined in block %d using %d "+
+		"gas\n", mined, receipt.TxHash.Hex(), receipt.BlockNumber,
+		receipt.GasUsed)
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		fmt.Printf("warning: transaction %s reverted\n",
+			receipt.TxHash.Hex())
+	}
+	return nil
+}
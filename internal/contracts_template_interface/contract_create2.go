@@ -0,0 +1,101 @@
+package contracts_template_interface
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go-evm-client/pkg/eth_rpc_client"
+)
+
+// Create2Deployer deploys init code through a CREATE2 factory, the address
+// of the deployment only depends on the factory, the salt and the init code
+type Create2Deployer interface {
+
+	// ComputeAddress predicts the address the init code is deployed at
+	ComputeAddress(initCode []byte) common.Address
+
+	// Deploy sends the init code to the factory and returns the address
+	// the contract is deployed at together with the transaction
+	Deploy(auth *bind.TransactOpts, initCode []byte) (
+		common.Address, *types.Transaction, error)
+}
+
+// ICreate2Contract is implemented by the contracts which can be deployed
+// through a CREATE2 factory, the contracts granting the ownership to the
+// msg.sender of their constructor would be owned by the factory
+type ICreate2Contract interface {
+
+	// DeploymentCode returns the creation bytecode followed by the
+	// parsed constructor arguments
+	DeploymentCode() ([]byte, error)
+
+	// DeployContractCreate2 is used to deploy a contract to the chain
+	// through a CREATE2 factory at a predictable address
+	DeployContractCreate2(
+		auth *bind.TransactOpts,
+		client eth_rpc_client.IEthClient,
+		deployer Create2Deployer,
+	) error
+}
+
+// DeploymentCode returns the creation bytecode of the contract followed
+// by its parsed constructor arguments, it fails for the contracts which
+// can't be deployed through a CREATE2 factory
+func (i *Contract) DeploymentCode() ([]byte, error) {
+	create2, ok := i.IContract.(ICreate2Contract)
+	if !ok {
+		return nil, errCreate2Unsupported
+	}
+	return create2.DeploymentCode()
+}
+
+// errCreate2Unsupported is returned for the contracts which can't be
+// deployed through a CREATE2 factory
+var errCreate2Unsupported = errors.New("error: the contract can't be " +
+	"deployed through a CREATE2 factory, its constructor would make the " +
+	"factory the owner")
+
+// DeployContractCreate2 first parses the constructor arguments, it then
+// predicts the address the contract is deployed at through the CREATE2
+// deployer. The deployment is skipped when code already exists at the
+// predicted address and the existing contract is loaded instead.
+func (i *Contract) DeployContractCreate2(
+	contractArgs []string,
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer Create2Deployer,
+) error {
+	create2, ok := i.IContract.(ICreate2Contract)
+	if !ok {
+		return errCreate2Unsupported
+	}
+	err := i.IContract.ParseConstructorArguments(contractArgs)
+	if err != nil {
+		return err
+	}
+	initCode, err1 := create2.DeploymentCode()
+	if err1 != nil {
+		return err1
+	}
+	address := deployer.ComputeAddress(initCode)
+	code, err2 := client.CodeAt(context.Background(), address, nil)
+	if err2 != nil {
+		return err2
+	}
+	if len(code) != 0 {
+		fmt.Printf("info: Code already exists at the predicted address %s, "+
+			"skipping deployment\n", address.Hex())
+		return i.LoadContract(&address, client)
+	}
+	fmt.Printf("info: Contract will be deployed at the predicted address "+
+		"%s\n", address.Hex())
+	err3 := create2.DeployContractCreate2(auth, client, deployer)
+	if err3 != nil {
+		return err3
+	}
+	i.IContract.PrintDeploymentData()
+	return nil
+}
@@ -0,0 +1,317 @@
+package contracts_template_interface
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"sort"
+)
+
+// Detection is the result of detecting the type of a deployed contract
+type Detection struct {
+	// ContractType is set when a single known type matches the contract
+	ContractType string
+	// ByBytecode is true when the runtime bytecode matched exactly
+	ByBytecode bool
+	// Candidates are the known types matching the probed standards
+	Candidates []string
+	// Standards are the standards the contract answered the probes of
+	Standards []string
+}
+
+// probe is a view call which a contract implementing a standard answers
+type probe struct {
+	data   []byte
+	accept func(out []byte) bool
+}
+
+// standard is an interface detected by probing its view functions, a
+// known contract implements it when its ABI has all of the methods
+type standard struct {
+	name    string
+	methods []string
+	probes  []probe
+}
+
+// standards contains the standards probed when the bytecode of a contract
+// doesn't match any known contract
+var standards = []standard{
+	{
+		name: "ERC20",
+		methods: []string{"totalSupply", "balanceOf", "allowance", "transfer",
+			"approve", "transferFrom"},
+		probes: []probe{
+			{selector("totalSupply()"), returnsWord},
+			{selector("balanceOf(address)", common.Address{}.Bytes()),
+				returnsWord},
+			{selector("allowance(address,address)", common.Address{}.Bytes(),
+				common.Address{}.Bytes()), returnsWord},
+		},
+	},
+	{
+		name:    "ERC2612",
+		methods: []string{"permit", "nonces", "DOMAIN_SEPARATOR"},
+		probes: []probe{
+			{selector("DOMAIN_SEPARATOR()"), returnsWord},
+			{selector("nonces(address)", common.Address{}.Bytes()),
+				returnsWord},
+		},
+	},
+	{
+		name:    "Ownable",
+		methods: []string{"owner", "transferOwnership", "renounceOwnership"},
+		probes:  []probe{{selector("owner()"), returnsWord}},
+	},
+	{
+		name:    "ERC165",
+		methods: []string{"supportsInterface"},
+		probes: []probe{
+			{selector("supportsInterface(bytes4)",
+				common.RightPadBytes([]byte{0x01, 0xff, 0xc9, 0xa7}, 32)),
+				returnsBool(true)},
+			{selector("supportsInterface(bytes4)",
+				common.RightPadBytes([]byte{0xff, 0xff, 0xff, 0xff}, 32)),
+				returnsBool(false)},
+		},
+	},
+	{
+		name: "ERC721",
+		methods: []string{"balanceOf", "ownerOf", "getApproved",
+			"isApprovedForAll", "approve", "setApprovalForAll", "transferFrom",
+			"safeTransferFrom"},
+		probes: []probe{
+			{selector("supportsInterface(bytes4)",
+				common.RightPadBytes([]byte{0x80, 0xac, 0x58, 0xcd}, 32)),
+				returnsBool(true)},
+		},
+	},
+	{
+		name: "ERC1155",
+		methods: []string{"balanceOf", "balanceOfBatch", "isApprovedForAll",
+			"setApprovalForAll", "safeTransferFrom", "safeBatchTransferFrom"},
+		probes: []probe{
+			{selector("supportsInterface(bytes4)",
+				common.RightPadBytes([]byte{0xd9, 0xb6, 0x7a, 0x26}, 32)),
+				returnsBool(true)},
+		},
+	},
+}
+
+// selector packs the call data of a function signature, the arguments
+// are left padded to a word
+func selector(signature string, args ...[]byte) []byte {
+	data := crypto.Keccak256([]byte(signature))[:4]
+	for _, arg := range args {
+		data = append(data, common.LeftPadBytes(arg, 32)...)
+	}
+	return data
+}
+
+// returnsWord accepts any output of at least a word
+func returnsWord(out []byte) bool {
+	return len(out) >= 32
+}
+
+// returnsBool accepts a single word boolean of the expected value
+func returnsBool(expected bool) func(out []byte) bool {
+	return func(out []byte) bool {
+		if len(out) != 32 {
+			return false
+		}
+		return (new(big.Int).SetBytes(out).Cmp(big.NewInt(1)) == 0) == expected
+	}
+}
+
+// DetectContractType finds which of the known contracts is deployed at the
+// address. The runtime bytecode is first compared against the bytecode of
+// each known contract. When none match the contract is probed for the
+// standards it implements, the known contracts implementing exactly those
+// standards whose function selectors are all found in the bytecode are
+// the candidates, the one with the most functions is selected.
+func DetectContractType(
+	ctx context.Context,
+	caller bind.ContractCaller,
+	address common.Address,
+	blockNumber *big.Int,
+	known map[string]*bind.MetaData,
+) (*Detection, error) {
+	code, err := caller.CodeAt(ctx, address, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, fmt.Errorf("error: no contract code at %s", address.Hex())
+	}
+	names := make([]string, 0, len(known))
+	for name := range known {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if MatchRuntimeCode(code, known[name].Bin) {
+			return &Detection{ContractType: name, ByBytecode: true,
+				Candidates: []string{name}}, nil
+		}
+	}
+
+	detection := &Detection{}
+	for _, std := range standards {
+		supported := true
+		for _, p := range std.probes {
+			out, err1 := caller.CallContract(ctx, ethereum.CallMsg{
+				To:   &address,
+				Data: p.data,
+			}, blockNumber)
+			if err1 != nil || !p.accept(out) {
+				supported = false
+				break
+			}
+		}
+		if supported {
+			detection.Standards = append(detection.Standards, std.name)
+		}
+	}
+	selectors := dispatchedSelectors(code)
+	best := 0
+	for _, name := range names {
+		parsed, err2 := known[name].GetAbi()
+		if err2 != nil {
+			return nil, err2
+		}
+		if len(parsed.Methods) == 0 {
+			// Contracts without functions are only detected by bytecode
+			continue
+		}
+		implemented := []string{}
+		for _, std := range standards {
+			ok := true
+			for _, method := range std.methods {
+				if _, found := parsed.Methods[method]; !found {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				implemented = append(implemented, std.name)
+			}
+		}
+		if fmt.Sprint(implemented) != fmt.Sprint(detection.Standards) {
+			continue
+		}
+		dispatched := true
+		for _, method := range parsed.Methods {
+			if !selectors[string(method.ID)] {
+				dispatched = false
+				break
+			}
+		}
+		if !dispatched {
+			continue
+		}
+		detection.Candidates = append(detection.Candidates, name)
+		if len(parsed.Methods) > best {
+			best = len(parsed.Methods)
+			detection.ContractType = name
+		} else if len(parsed.Methods) == best {
+			// Candidates with as many functions can't be told apart
+			detection.ContractType = ""
+		}
+	}
+	return detection, nil
+}
+
+// MatchRuntimeCode reports whether the deployed runtime code was created
+// by the given creation bytecode. The metadata hash appended by solc is
+// ignored, as are PUSH32 immediates left zeroed in the creation bytecode
+// since those are immutables filled in by the constructor.
+func MatchRuntimeCode(code []byte, creationBin string) bool {
+	creation := common.FromHex(creationBin)
+	body := stripMetadata(code)
+	if len(body) == 0 {
+		return false
+	}
+	prefix := body
+	if len(prefix) > 32 {
+		prefix = prefix[:32]
+	}
+	for offset := 0; offset+len(body) <= len(creation); offset++ {
+		index := bytes.Index(creation[offset:], prefix)
+		if index < 0 || offset+index+len(body) > len(creation) {
+			return false
+		}
+		offset += index
+		if matchTemplate(creation[offset:offset+len(body)], body) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchTemplate compares the code against the runtime template of the
+// creation bytecode instruction by instruction, zeroed PUSH32 immediates
+// of the template match any value
+func matchTemplate(template []byte, code []byte) bool {
+	for pc := 0; pc < len(template); pc++ {
+		op := template[pc]
+		if op != code[pc] {
+			return false
+		}
+		if op < 0x60 || op > 0x7f {
+			continue
+		}
+		size := int(op) - 0x5f
+		end := pc + 1 + size
+		if end > len(template) {
+			end = len(template)
+		}
+		immediate := template[pc+1 : end]
+		immutable := size == 32 && len(immediate) == 32 &&
+			bytes.Equal(immediate, make([]byte, 32))
+		if !immutable && !bytes.Equal(immediate, code[pc+1:end]) {
+			return false
+		}
+		pc = end - 1
+	}
+	return true
+}
+
+// stripMetadata removes the CBOR encoded metadata solc appends to the
+// runtime code, its length is stored in the last two bytes
+func stripMetadata(code []byte) []byte {
+	if len(code) < 2 {
+		return code
+	}
+	length := int(code[len(code)-2])<<8 | int(code[len(code)-1])
+	start := len(code) - 2 - length
+	if length == 0 || start < 0 {
+		return code
+	}
+	// The metadata is a CBOR map of at most a few entries
+	if code[start] < 0xa1 || code[start] > 0xa5 {
+		return code
+	}
+	return code[:start]
+}
+
+// dispatchedSelectors collects the PUSH4 immediates of the code, solidity
+// dispatchers compare the call selector against each function selector
+func dispatchedSelectors(code []byte) map[string]bool {
+	selectors := map[string]bool{}
+	for pc := 0; pc < len(code); pc++ {
+		op := code[pc]
+		if op < 0x60 || op > 0x7f {
+			continue
+		}
+		size := int(op) - 0x5f
+		if op == 0x63 && pc+5 <= len(code) {
+			selectors[string(code[pc+1:pc+5])] = true
+		}
+		pc += size
+	}
+	return selectors
+}
@@ -0,0 +1,176 @@
+package contracts_template_interface
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"strings"
+)
+
+// EventFilter holds the indexed address arguments used to filter contract
+// events, an empty slice matches any address. From filters the first
+// indexed address of the event (from, owner, account, previousOwner) and
+// To the second one (to, spender, approved, operator, newOwner). ERC1155
+// transfers are filtered on their from and to addresses, not on their
+// operator.
+type EventFilter struct {
+	From []common.Address
+	To   []common.Address
+}
+
+// EventArg is a single decoded argument of an event
+type EventArg struct {
+	Name  string
+	Value string
+}
+
+// EventRecord is a decoded contract event together with the position of
+// the log that emitted it
+type EventRecord struct {
+	Name        string
+	BlockNumber uint64
+	TxHash      common.Hash
+	LogIndex    uint
+	Args        []EventArg
+}
+
+// NewEventRecord creates an EventRecord from the raw log of an event
+// and its decoded arguments
+func NewEventRecord(name string, raw types.Log, args ...EventArg) EventRecord {
+	return EventRecord{
+		Name:        name,
+		BlockNumber: raw.BlockNumber,
+		TxHash:      raw.TxHash,
+		LogIndex:    raw.Index,
+		Args:        args,
+	}
+}
+
+// String formats the event on a single line
+func (e EventRecord) String() string {
+	args := make([]string, 0, len(e.Args))
+	for _, arg := range e.Args {
+		args = append(args, fmt.Sprintf("%s=%s", arg.Name, arg.Value))
+	}
+	return fmt.Sprintf("%s block=%d tx=%s logIndex=%d %s", e.Name,
+		e.BlockNumber, e.TxHash.Hex(), e.LogIndex, strings.Join(args, " "))
+}
+
+// rangeLimitErrors are fragments of the errors nodes return when a log
+// query spans too many blocks or matches too many logs
+var rangeLimitErrors = []string{
+	"query returned more than",
+	"block range",
+	"range too large",
+	"too many",
+	"limit exceeded",
+	"response size exceeded",
+}
+
+// isRangeLimitError reports whether the node refused a log query because
+// of its size, in which case a smaller range may succeed
+func isRangeLimitError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range rangeLimitErrors {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// QueryEvents retrieves the events of a contract between two blocks and
+// prints them in order
+func (i *Contract) QueryEvents(
+	ctx context.Context,
+	eventName string,
+	filter EventFilter,
+	fromBlock uint64,
+	toBlock uint64,
+	pageSize uint64,
+) ([]EventRecord, error) {
+	records, err := i.queryEventRange(ctx, eventName, filter, fromBlock,
+		toBlock, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		fmt.Println(record.String())
+	}
+	fmt.Printf("info: found %d %s events between blocks %d and %d\n",
+		len(records), eventName, fromBlock, toBlock)
+	return records, nil
+}
+
+// queryEventRange retrieves the events of a contract between two blocks
+// in pages of pageSize blocks
+func (i *Contract) queryEventRange(
+	ctx context.Context,
+	eventName string,
+	filter EventFilter,
+	fromBlock uint64,
+	toBlock uint64,
+	pageSize uint64,
+) ([]EventRecord, error) {
+	return QueryEventRange(ctx, i.IContract.QueryEvents, eventName, filter,
+		fromBlock, toBlock, pageSize, 0)
+}
+
+// EventQuery retrieves the events of the given name emitted within the
+// block range of the filter options
+type EventQuery func(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter EventFilter,
+) ([]EventRecord, error)
+
+// QueryEventRange retrieves the events of a contract between two blocks.
+// The range is split into pages of pageSize blocks, and a page is halved
+// whenever the node refuses it for being too large, so that node limits
+// on log queries are respected. A limit stops the scan after the page in
+// which at least that many events were found, zero scans the whole range.
+func QueryEventRange(
+	ctx context.Context,
+	query EventQuery,
+	eventName string,
+	filter EventFilter,
+	fromBlock uint64,
+	toBlock uint64,
+	pageSize uint64,
+	limit int,
+) ([]EventRecord, error) {
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("error: from block %d is after to block %d",
+			fromBlock, toBlock)
+	}
+	if pageSize == 0 {
+		pageSize = 1
+	}
+	var records []EventRecord
+	start := fromBlock
+	for start <= toBlock {
+		end := start + pageSize - 1
+		if end > toBlock || end < start {
+			end = toBlock
+		}
+		opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+		page, err := query(opts, eventName, filter)
+		if err != nil {
+			if isRangeLimitError(err) && end > start {
+				pageSize = (end - start + 1) / 2
+				fmt.Printf("warning: node refused blocks %d-%d, retrying "+
+					"with pages of %d blocks\n", start, end, pageSize)
+				continue
+			}
+			return nil, err
+		}
+		records = append(records, page...)
+		if end == toBlock || (limit > 0 && len(records) >= limit) {
+			break
+		}
+		start = end + 1
+	}
+	return records, nil
+}
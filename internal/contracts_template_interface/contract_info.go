@@ -0,0 +1,130 @@
+package contracts_template_interface
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// IInfoClient is the part of the blockchain client needed to summarize a
+// loaded contract beyond its own view functions
+type IInfoClient interface {
+	CodeAt(
+		ctx context.Context,
+		contract common.Address,
+		blockNumber *big.Int,
+	) ([]byte, error)
+	TransactionByHash(
+		ctx context.Context,
+		hash common.Hash,
+	) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(
+		ctx context.Context,
+		txHash common.Hash,
+	) (*types.Receipt, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// IDeployments finds the deployments recorded by the contract deployer
+type IDeployments interface {
+
+	// FindDeployment returns the hash of the transaction the contract at
+	// the address was deployed with, false when it wasn't recorded
+	FindDeployment(address common.Address) (common.Hash, bool, error)
+}
+
+// IDeployedContract is implemented by the contracts which show the
+// account and the transaction they were deployed with
+type IDeployedContract interface {
+
+	// SetDeployments sets the deployments recorded by the contract
+	// deployer, they are preferred over looking up the creation on chain
+	SetDeployments(deployments IDeployments)
+}
+
+// CreationInfo holds the account which deployed a contract and the
+// transaction it was deployed with
+type CreationInfo struct {
+	Deployer    common.Address
+	TxHash      common.Hash
+	BlockNumber uint64
+}
+
+// String formats the creation info on a single line
+func (c CreationInfo) String() string {
+	return fmt.Sprintf("%s in transaction %s at block %d", c.Deployer.Hex(),
+		c.TxHash.Hex(), c.BlockNumber)
+}
+
+// SetDeployments passes the recorded deployments to the contract when it
+// shows its deployer, other contracts are left untouched
+func (i *Contract) SetDeployments(deployments IDeployments) {
+	deployed, ok := i.IContract.(IDeployedContract)
+	if !ok {
+		return
+	}
+	deployed.SetDeployments(deployments)
+}
+
+// LookupCreation finds the deployer of a contract given an event emitted
+// by its constructor, the sender of the creation transaction is the
+// deployer
+func LookupCreation(
+	ctx context.Context,
+	client IInfoClient,
+	constructorEvent EventRecord,
+) (*CreationInfo, error) {
+	tx, _, err := client.TransactionByHash(ctx, constructorEvent.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	if tx.To() != nil {
+		return nil, fmt.Errorf("error: transaction %s didn't create the "+
+			"contract", constructorEvent.TxHash.Hex())
+	}
+	deployer, err1 := types.Sender(types.LatestSignerForChainID(tx.ChainId()),
+		tx)
+	if err1 != nil {
+		return nil, err1
+	}
+	return &CreationInfo{
+		Deployer:    deployer,
+		TxHash:      constructorEvent.TxHash,
+		BlockNumber: constructorEvent.BlockNumber,
+	}, nil
+}
+
+// LookupDeployment finds the deployer of a contract given the transaction
+// recorded when it was deployed, either a contract creation or a call to
+// a CREATE2 factory. The sender of the transaction is the deployer and
+// the block is read from its receipt.
+func LookupDeployment(
+	ctx context.Context,
+	client IInfoClient,
+	txHash common.Hash,
+) (*CreationInfo, error) {
+	tx, _, err := client.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err1 := client.TransactionReceipt(ctx, txHash)
+	if err1 != nil {
+		return nil, err1
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("error: deployment transaction %s failed",
+			txHash.Hex())
+	}
+	deployer, err2 := types.Sender(types.LatestSignerForChainID(tx.ChainId()),
+		tx)
+	if err2 != nil {
+		return nil, err2
+	}
+	return &CreationInfo{
+		Deployer:    deployer,
+		TxHash:      txHash,
+		BlockNumber: receipt.BlockNumber.Uint64(),
+	}, nil
+}
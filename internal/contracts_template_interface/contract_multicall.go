@@ -0,0 +1,67 @@
+package contracts_template_interface
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// ViewCall is a single view function call of a contract which is
+// aggregated with other calls
+type ViewCall struct {
+	Target common.Address
+	ABI    *abi.ABI
+	Method string
+	Args   []interface{}
+}
+
+// Multicaller aggregates the view calls of contracts into a single call
+// so that every output is read from the same state
+type Multicaller interface {
+
+	// Aggregate executes the calls at the block of the call options and
+	// returns the block number they were executed at together with the
+	// unpacked outputs of every call in the order of the calls
+	Aggregate(opts *bind.CallOpts, calls []ViewCall) (
+		*big.Int, [][]interface{}, error)
+}
+
+// IMulticallContract is implemented by the contracts whose queries can be
+// aggregated through a multicaller
+type IMulticallContract interface {
+
+	// QueryContractMulticall is used to retrieve the data of several
+	// query functions atomically through a single aggregated call
+	QueryContractMulticall(
+		opts *bind.CallOpts,
+		multicaller Multicaller,
+		funcNames []string,
+		funcArgs []string,
+	) error
+}
+
+// QueryContractMulticall accesses several view only functions of a
+// contract at once through the multicaller, based on the provided
+// function names and function arguments
+func (i *Contract) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	aggregated, ok := i.IContract.(IMulticallContract)
+	if !ok {
+		return errors.New("error: the queries of the contract can't be " +
+			"aggregated")
+	}
+	err := aggregated.QueryContractMulticall(opts, multicaller, funcNames,
+		funcArgs)
+	if err != nil {
+		return err
+	}
+	i.IContract.PrintLoadedContractData()
+	i.IContract.PrintContractDataAfterExecution()
+	return nil
+}
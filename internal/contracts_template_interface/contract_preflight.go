@@ -0,0 +1,88 @@
+package contracts_template_interface
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	utils "go-evm-client/internal/utils"
+	"math/big"
+	"strings"
+)
+
+// IBalanceClient is the part of the blockchain client needed to check that
+// the sender of a transaction can pay for its gas
+type IBalanceClient interface {
+	BalanceAt(
+		ctx context.Context,
+		account common.Address,
+		blockNumber *big.Int,
+	) (*big.Int, error)
+}
+
+// IPreflightContract is implemented by the contracts which check before
+// sending a transfer that it can succeed
+type IPreflightContract interface {
+
+	// SetSkipPreflight sends the transfers without first checking that
+	// the balance, the allowance and the gas funds cover them
+	SetSkipPreflight(skip bool)
+}
+
+// SetSkipPreflight passes whether the pre-flight checks are skipped to the
+// contract when it runs them, other contracts are left untouched
+func (i *Contract) SetSkipPreflight(skip bool) {
+	checker, ok := i.IContract.(IPreflightContract)
+	if !ok {
+		return
+	}
+	checker.SetSkipPreflight(skip)
+}
+
+// MaxTransactionFee returns the most a transaction sent with the options
+// can pay for its gas, the gas limit times the fee cap of dynamic fee
+// transactions or the gas price of legacy ones. It is zero when either is
+// left to be estimated.
+func MaxTransactionFee(auth *bind.TransactOpts) *big.Int {
+	price := auth.GasPrice
+	if auth.GasFeeCap != nil {
+		price = auth.GasFeeCap
+	}
+	if price == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(auth.GasLimit), price)
+}
+
+// CheckGasFunds returns the shortfall of the sender of a transaction when
+// its native balance doesn't cover the maximum fee, an empty string when it
+// does
+func CheckGasFunds(
+	ctx context.Context,
+	client IBalanceClient,
+	auth *bind.TransactOpts,
+) (string, error) {
+	fee := MaxTransactionFee(auth)
+	balance, err := client.BalanceAt(ctx, auth.From, nil)
+	if err != nil {
+		return "", fmt.Errorf("error: failed to read the native balance of "+
+			"%s: %v", auth.From.Hex(), err)
+	}
+	if balance.Cmp(fee) >= 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%s holds %s, %s short of the %s the gas may cost",
+		auth.From.Hex(), utils.FormatNativeAmount(balance),
+		utils.FormatNativeAmount(new(big.Int).Sub(fee, balance)),
+		utils.FormatNativeAmount(fee)), nil
+}
+
+// PreflightError reports the shortfalls found before sending a
+// transaction which would revert, nil when there are none
+func PreflightError(shortfalls []string) error {
+	if len(shortfalls) == 0 {
+		return nil
+	}
+	return fmt.Errorf("error: the transaction would fail, use --skip-preflight "+
+		"to send it anyway:\n  %s", strings.Join(shortfalls, "\n  "))
+}
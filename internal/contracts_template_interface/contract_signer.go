@@ -0,0 +1,29 @@
+package contracts_template_interface
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethacc "go-evm-client/pkg/eth_account"
+)
+
+// ISignerContract is implemented by the contracts whose functions sign
+// messages off chain, such as EIP-2612 permits, instead of sending a
+// transaction from the user account
+type ISignerContract interface {
+
+	// SetSigner sets the account signing the messages and the relayer
+	// transactor submitting them, a nil relayer only prints the signatures
+	SetSigner(account *ethacc.UserAccount, relayer *bind.TransactOpts)
+}
+
+// SetSigner passes the signing account and the relayer to the contract
+// when it signs messages, other contracts are left untouched
+func (i *Contract) SetSigner(
+	account *ethacc.UserAccount,
+	relayer *bind.TransactOpts,
+) {
+	signer, ok := i.IContract.(ISignerContract)
+	if !ok {
+		return
+	}
+	signer.SetSigner(account, relayer)
+}
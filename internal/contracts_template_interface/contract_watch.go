@@ -0,0 +1,196 @@
+package contracts_template_interface
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go-evm-client/pkg/eth_rpc_client"
+	"time"
+)
+
+// minResubscribeDelay and maxResubscribeDelay bound the exponential
+// backoff used while a dropped subscription is being re-established
+const (
+	minResubscribeDelay = time.Second
+	maxResubscribeDelay = 30 * time.Second
+)
+
+// eventWatcher keeps track of the events streamed so far so that the
+// gaps left by disconnects can be filled without printing an event twice
+type eventWatcher struct {
+	contract  *Contract
+	client    eth_rpc_client.IEthClient
+	eventName string
+	filter    EventFilter
+	pageSize  uint64
+	// nextBlock is the first block which hasn't been fully scanned yet
+	nextBlock uint64
+	// lastBlock and lastIndex are the position of the last printed event
+	lastBlock uint64
+	lastIndex uint
+}
+
+// WatchEvents streams the events of a contract as they are emitted until
+// the context is cancelled. When subscribe is set the node pushes the
+// events through a subscription, which is re-established after
+// disconnects and backfilled with the events missed in the meantime.
+// Endpoints which don't support subscriptions, such as HTTP ones, are
+// polled for new logs every pollInterval instead.
+func (i *Contract) WatchEvents(
+	ctx context.Context,
+	client eth_rpc_client.IEthClient,
+	eventName string,
+	filter EventFilter,
+	subscribe bool,
+	pollInterval time.Duration,
+	pageSize uint64,
+) error {
+	head, err := client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	w := &eventWatcher{
+		contract:  i,
+		client:    client,
+		eventName: eventName,
+		filter:    filter,
+		pageSize:  pageSize,
+		nextBlock: head + 1,
+		lastBlock: head,
+		lastIndex: ^uint(0),
+	}
+	fmt.Printf("info: watching %s events from block %d\n", eventName,
+		head+1)
+	if subscribe {
+		err1 := w.subscribe(ctx)
+		if err1 != rpc.ErrNotificationsUnsupported {
+			return err1
+		}
+		fmt.Println("warning: endpoint doesn't support subscriptions, " +
+			"falling back to polling")
+	}
+	return w.poll(ctx, pollInterval)
+}
+
+// subscribe streams the events through a subscription and resubscribes
+// with an exponential backoff whenever it is dropped
+func (w *eventWatcher) subscribe(ctx context.Context) error {
+	delay := minResubscribeDelay
+	for ctx.Err() == nil {
+		sink := make(chan EventRecord)
+		sub, err := w.contract.IContract.WatchEvents(
+			&bind.WatchOpts{Context: ctx}, w.eventName, w.filter, sink)
+		if err == rpc.ErrNotificationsUnsupported {
+			return err
+		}
+		if err == nil {
+			// Catch up with the events emitted while we weren't subscribed
+			err = w.backfill(ctx)
+			if err == nil {
+				delay = minResubscribeDelay
+				err = w.stream(ctx, sub, sink)
+			}
+			sub.Unsubscribe()
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		fmt.Printf("warning: %s subscription interrupted: %v, "+
+			"resubscribing in %s\n", w.eventName, err, delay)
+		if !sleepContext(ctx, delay) {
+			break
+		}
+		delay *= 2
+		if delay > maxResubscribeDelay {
+			delay = maxResubscribeDelay
+		}
+	}
+	return nil
+}
+
+// stream prints the events received from the subscription until it fails
+// or the context is cancelled
+func (w *eventWatcher) stream(
+	ctx context.Context,
+	sub event.Subscription,
+	sink <-chan EventRecord,
+) error {
+	for {
+		select {
+		case record := <-sink:
+			// Logs arrive in order so every earlier block is complete
+			if record.BlockNumber > w.nextBlock {
+				w.nextBlock = record.BlockNumber
+			}
+			w.emit(record)
+		case err := <-sub.Err():
+			if err == nil {
+				err = fmt.Errorf("error: subscription closed")
+			}
+			return err
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// poll looks for new events every interval, failed attempts are retried
+// on the next tick
+func (w *eventWatcher) poll(ctx context.Context, interval time.Duration) error {
+	for sleepContext(ctx, interval) {
+		err := w.backfill(ctx)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("warning: failed to poll %s events: %v\n",
+				w.eventName, err)
+		}
+	}
+	return nil
+}
+
+// backfill queries the logs of the blocks which haven't been scanned yet
+// up to the current head and prints the ones not seen before
+func (w *eventWatcher) backfill(ctx context.Context) error {
+	head, err := w.client.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if head < w.nextBlock {
+		return nil
+	}
+	records, err1 := w.contract.queryEventRange(ctx, w.eventName, w.filter,
+		w.nextBlock, head, w.pageSize)
+	if err1 != nil {
+		return err1
+	}
+	for _, record := range records {
+		w.emit(record)
+	}
+	w.nextBlock = head + 1
+	return nil
+}
+
+// emit prints an event unless it was already printed, which happens when
+// a backfilled range overlaps with the subscription
+func (w *eventWatcher) emit(record EventRecord) {
+	if record.BlockNumber < w.lastBlock ||
+		(record.BlockNumber == w.lastBlock && record.LogIndex <= w.lastIndex) {
+		return
+	}
+	w.lastBlock, w.lastIndex = record.BlockNumber, record.LogIndex
+	fmt.Println(record.String())
+}
+
+// sleepContext waits for the given duration and reports false if the
+// context was cancelled in the meantime
+func sleepContext(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
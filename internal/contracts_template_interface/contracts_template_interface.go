@@ -3,6 +3,8 @@ package contracts_template_interface
 import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	utils "go-evm-client/internal/utils"
 	"go-evm-client/pkg/eth_rpc_client"
 )
 
@@ -38,8 +40,13 @@ type iExecutorContract interface {
 	) error
 
 	// QueryContract is used to retrieve data from a contract without
-	// invoking a state change
-	QueryContract(funcName string, funcArgs []string) error
+	// invoking a state change, the call options select the block and
+	// the caller of the view calls
+	QueryContract(
+		opts *bind.CallOpts,
+		funcName string,
+		funcArgs []string,
+	) error
 
 	// PrintLoadedContractData is a generic way to output the result
 	// of the contract interaction
@@ -50,11 +57,38 @@ type iExecutorContract interface {
 	PrintContractDataAfterExecution()
 }
 
-// IContract interface contains both the deployer and executor
+// iEventContract interface contains functions that are needed to
+// read the events emitted by a contract
+type iEventContract interface {
+
+	// QueryEvents retrieves and decodes the events of the given name
+	// emitted within the block range of the filter options
+	QueryEvents(
+		opts *bind.FilterOpts,
+		eventName string,
+		filter EventFilter,
+	) ([]EventRecord, error)
+
+	// WatchEvents subscribes to the events of the given name and
+	// forwards them decoded to the sink until unsubscribed
+	WatchEvents(
+		opts *bind.WatchOpts,
+		eventName string,
+		filter EventFilter,
+		sink chan<- EventRecord,
+	) (event.Subscription, error)
+}
+
+// IContract interface contains the deployer, executor and event
 // interfaces
 type IContract interface {
 	iDeployContract
 	iExecutorContract
+	iEventContract
+
+	// SetArgParser sets the parser used to validate the constructor
+	// and function arguments before they are sent to the contract
+	SetArgParser(parser *utils.ArgParser)
 }
 
 // Contract is a way to hold and use various contracts that
@@ -99,11 +133,13 @@ func (i *Contract) LoadContract(
 
 // QueryContract accesses the view only functions of a contract
 // based on the provided function name and function arguments
+// at the block and for the caller set in the call options
 func (i *Contract) QueryContract(
+	opts *bind.CallOpts,
 	funcName string,
 	funcArgs []string,
 ) error {
-	err := i.IContract.QueryContract(funcName, funcArgs)
+	err := i.IContract.QueryContract(opts, funcName, funcArgs)
 	if err != nil {
 		return err
 	}
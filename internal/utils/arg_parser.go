@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// ArgParser converts the string arguments given on the command line into
+// typed contract arguments. It is shared by the CLIs and every contract
+// controller so that all addresses are validated the same way. A nil
+// ArgParser applies the strictest settings.
+type ArgParser struct {
+	// AllowZeroAddress lets the zero address be used as the recipient of
+	// transfers, which would otherwise burn the tokens
+	AllowZeroAddress bool
+	// WarnOnBadChecksum only prints a warning for addresses whose EIP-55
+	// checksum doesn't match instead of rejecting them
+	WarnOnBadChecksum bool
+	// RawAmounts takes the token amounts as plain integers of base units
+	// instead of scaling them by the token decimals
+	RawAmounts bool
+}
+
+// ParseAddress validates that the argument is a 20 byte hex address and
+// that its EIP-55 checksum matches when the address is given in mixed case.
+// All lowercase or all uppercase addresses carry no checksum and are
+// accepted as is.
+func (p *ArgParser) ParseAddress(arg string) (common.Address, error) {
+	trimmed := strings.TrimSpace(arg)
+	if !common.IsHexAddress(trimmed) {
+		return common.Address{}, fmt.Errorf("error: %q is not a valid hex "+
+			"address", arg)
+	}
+	address := common.HexToAddress(trimmed)
+	hexPart := strings.TrimPrefix(strings.TrimPrefix(trimmed, "0x"), "0X")
+	if hexPart == strings.ToLower(hexPart) || hexPart == strings.ToUpper(hexPart) {
+		return address, nil
+	}
+	if hexPart != address.Hex()[2:] {
+		if p != nil && p.WarnOnBadChecksum {
+			fmt.Printf("warning: address %s has an invalid EIP-55 checksum, "+
+				"expected %s\n", trimmed, address.Hex())
+			return address, nil
+		}
+		return common.Address{}, fmt.Errorf("error: address %s has an "+
+			"invalid EIP-55 checksum, expected %s", trimmed, address.Hex())
+	}
+	return address, nil
+}
+
+// ParseRecipient validates an address receiving tokens. On top of the
+// checks done by ParseAddress it refuses the zero address unless the
+// parser explicitly allows it.
+func (p *ArgParser) ParseRecipient(arg string) (common.Address, error) {
+	address, err := p.ParseAddress(arg)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if address == (common.Address{}) && (p == nil || !p.AllowZeroAddress) {
+		return common.Address{}, fmt.Errorf("error: refusing to use the zero " +
+			"address as recipient, use --force to override")
+	}
+	return address, nil
+}
+
+// ScalesAmounts reports whether the token amounts are scaled by the token
+// decimals, which then need to be known
+func (p *ArgParser) ScalesAmounts() bool {
+	return p == nil || !p.RawAmounts
+}
+
+// ParseAmount converts a token amount argument into base units, it is
+// scaled by the decimals of the token unless raw amounts are given
+func (p *ArgParser) ParseAmount(amount string, decimals uint8,
+	symbol string) (*big.Int, error) {
+	if !p.ScalesAmounts() {
+		return ParseBaseUnitAmount(amount)
+	}
+	return ParseTokenAmount(amount, decimals, symbol)
+}
+
+// ReadAddressFile parses a file holding one address per line. Blank lines
+// and lines starting with # are skipped, and only the first comma
+// separated field of a line is read so that CSV exports can be used as is.
+func (p *ArgParser) ReadAddressFile(path string) ([]common.Address, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to open address file: %v", err)
+	}
+	defer file.Close()
+	var addresses []common.Address
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		field := strings.TrimSpace(strings.SplitN(scanner.Text(), ",", 2)[0])
+		if len(field) == 0 || strings.HasPrefix(field, "#") {
+			continue
+		}
+		address, err1 := p.ParseAddress(field)
+		if err1 != nil {
+			return nil, fmt.Errorf("%v (%s line %d)", err1, path, line)
+		}
+		addresses = append(addresses, address)
+	}
+	if err2 := scanner.Err(); err2 != nil {
+		return nil, fmt.Errorf("error: failed to read address file: %v", err2)
+	}
+	return addresses, nil
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxAmountExponent bounds the exponent accepted in scientific notation so
+// that a typo such as "1e99999" can't make us build an enormous number
+const maxAmountExponent = 96
+
+// maxUint256 is the largest amount a token contract can accept
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256),
+	big.NewInt(1))
+
+// baseUnitAmountRegex matches amounts given as a plain integer, the only
+// form accepted for amounts in base units
+var baseUnitAmountRegex = regexp.MustCompile(`^[0-9]+$`)
+
+// tokenAmountRegex matches amounts given in decimal or scientific notation
+// with an optional unit suffix, e.g. "1.5", "1e6" or "250 MST"
+var tokenAmountRegex = regexp.MustCompile(
+	`^([0-9]*)(?:\.([0-9]*))?(?:[eE]([+-]?[0-9]+))?\s*([A-Za-z][A-Za-z0-9]*)?$`)
+
+// ParseBaseUnitAmount converts an amount given by the user as a plain
+// integer of base units, it isn't scaled by any decimals
+func ParseBaseUnitAmount(amount string) (*big.Int, error) {
+	trimmed := strings.TrimSpace(amount)
+	if strings.HasPrefix(trimmed, "-") {
+		return nil, fmt.Errorf("error: negative amount %q is not allowed",
+			amount)
+	}
+	if !baseUnitAmountRegex.MatchString(trimmed) {
+		return nil, fmt.Errorf("error: amount %q in base units must be a "+
+			"plain integer", amount)
+	}
+	value, _ := new(big.Int).SetString(trimmed, 10)
+	return checkAmountRange(value, amount)
+}
+
+// ParseNativeAmount converts an amount of native coins given by the user
+// in wei as a plain integer or in coins in decimal notation ("0.5")
+func ParseNativeAmount(amount string) (*big.Int, error) {
+	if baseUnitAmountRegex.MatchString(strings.TrimSpace(amount)) {
+		return ParseBaseUnitAmount(amount)
+	}
+	return ParseTokenAmount(amount, 18, "")
+}
+
+// ParseTokenAmount converts an amount given by the user into base units.
+// Integers ("250"), decimal notation ("1.5"), scientific notation ("1e6")
+// and amounts suffixed with the token symbol ("250 MST") are all scaled
+// by the token decimals. Negative, malformed or overly precise amounts
+// are rejected instead of being silently zeroed.
+func ParseTokenAmount(amount string, decimals uint8, symbol string) (
+	*big.Int, error) {
+	trimmed := strings.TrimSpace(amount)
+	if strings.HasPrefix(trimmed, "-") {
+		return nil, fmt.Errorf("error: negative amount %q is not allowed",
+			amount)
+	}
+	matches := tokenAmountRegex.FindStringSubmatch(trimmed)
+	if matches == nil || len(matches[1])+len(matches[2]) == 0 {
+		return nil, fmt.Errorf("error: unable to parse amount %q", amount)
+	}
+	intPart, fracPart, expPart, unit := matches[1], matches[2], matches[3],
+		matches[4]
+	if len(unit) != 0 && !strings.EqualFold(unit, symbol) {
+		return nil, fmt.Errorf("error: unit %q of amount %q does not match "+
+			"the token symbol %q", unit, amount, symbol)
+	}
+	exponent := 0
+	if len(expPart) != 0 {
+		parsed, err := strconv.Atoi(expPart)
+		if err != nil || parsed > maxAmountExponent ||
+			parsed < -maxAmountExponent {
+			return nil, fmt.Errorf("error: exponent of amount %q is out of "+
+				"range", amount)
+		}
+		exponent = parsed
+	}
+	digits, _ := new(big.Int).SetString(intPart+fracPart, 10)
+	// The amount equals digits * 10^(shift), where the shift accounts for
+	// the fraction digits, the exponent and the token decimals
+	shift := exponent - len(fracPart) + int(decimals)
+	if shift >= 0 {
+		digits.Mul(digits, pow10(shift))
+		return checkAmountRange(digits, amount)
+	}
+	value, remainder := new(big.Int).QuoRem(digits, pow10(-shift),
+		new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, fmt.Errorf("error: amount %q has more precision than "+
+			"the token's %d decimals", amount, decimals)
+	}
+	return checkAmountRange(value, amount)
+}
+
+// FormatTokenAmount scales an amount of base units down by the token
+// decimals, e.g. 1500000000000000000 with 18 decimals becomes "1.5"
+func FormatTokenAmount(amount *big.Int, decimals uint8) string {
+	if amount == nil {
+		return "0"
+	}
+	if decimals == 0 {
+		return amount.String()
+	}
+	abs := new(big.Int).Abs(amount)
+	intPart, fracPart := new(big.Int).QuoRem(abs, pow10(int(decimals)),
+		new(big.Int))
+	sign := ""
+	if amount.Sign() < 0 {
+		sign = "-"
+	}
+	if fracPart.Sign() == 0 {
+		return sign + intPart.String()
+	}
+	frac := fracPart.String()
+	frac = strings.Repeat("0", int(decimals)-len(frac)) + frac
+	return sign + intPart.String() + "." + strings.TrimRight(frac, "0")
+}
+
+// FormatNativeAmount shows an amount of native coins in wei followed by
+// the amount in coins, e.g. "1500000000000000000 wei (1.5 coins)"
+func FormatNativeAmount(amount *big.Int) string {
+	return fmt.Sprintf("%d wei (%s coins)", amount,
+		FormatTokenAmount(amount, 18))
+}
+
+// pow10 returns 10^n as a big integer
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// checkAmountRange makes sure the amount fits into a uint256
+func checkAmountRange(value *big.Int, amount string) (*big.Int, error) {
+	if value.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("error: amount %q exceeds the maximum uint256 "+
+			"value", amount)
+	}
+	return value, nil
+}
+
+// ParseTokenID converts the id of a non-fungible token given as a decimal
+// or 0x prefixed hex integer, ids are uint256 values so negative or larger
+// ids are rejected
+func ParseTokenID(arg string) (*big.Int, error) {
+	trimmed := strings.TrimSpace(arg)
+	digits, base := trimmed, 10
+	if strings.HasPrefix(trimmed, "0x") || strings.HasPrefix(trimmed, "0X") {
+		digits, base = trimmed[2:], 16
+	}
+	id, ok := new(big.Int).SetString(digits, base)
+	if !ok || strings.ContainsAny(digits, "+-_") {
+		return nil, fmt.Errorf("error: %q is not a valid token id", arg)
+	}
+	if id.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("error: token id %q exceeds the maximum "+
+			"uint256 value", arg)
+	}
+	return id, nil
+}
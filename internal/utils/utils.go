@@ -2,6 +2,9 @@ package utils
 
 import (
 	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"strings"
 )
 
 // Contains accepts a string and a slice of strings,
@@ -27,6 +30,33 @@ func ValidateLength(s *[]string, expected int) error {
 	return nil
 }
 
+// SplitList splits an argument holding a comma separated list, used by
+// the array arguments of contract functions. Spaces around the elements
+// are trimmed and empty elements are rejected.
+func SplitList(arg string) ([]string, error) {
+	elements := strings.Split(arg, ",")
+	for i, element := range elements {
+		elements[i] = strings.TrimSpace(element)
+		if len(elements[i]) == 0 {
+			return nil, fmt.Errorf("error: %q is not a valid comma "+
+				"separated list", arg)
+		}
+	}
+	return elements, nil
+}
+
+// ParseHash validates that the argument is a 32 byte hex hash such as a
+// transaction or block hash
+func ParseHash(arg string) (common.Hash, error) {
+	trimmed := strings.TrimSpace(arg)
+	bytes, err := hexutil.Decode(trimmed)
+	if err != nil || len(bytes) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("error: %q is not a valid hash, "+
+			"32 bytes in hex starting with 0x are expected", arg)
+	}
+	return common.BytesToHash(bytes), nil
+}
+
 // RequiredFlagVerification accepts a slice of strings and verifies that
 // each string is not empty
 func RequiredFlagVerification(flags *[]string) bool {
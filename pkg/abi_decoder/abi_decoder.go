@@ -0,0 +1,382 @@
+package abi_decoder
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Argument is a decoded argument of a function call or of an event
+type Argument struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Value   string `json:"value"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+// Call is a function call decoded from its calldata, Source names the ABI
+// the function was found in
+type Call struct {
+	Source    string     `json:"source"`
+	Signature string     `json:"signature"`
+	Selector  string     `json:"selector"`
+	Args      []Argument `json:"args"`
+}
+
+// Event is an event decoded from the topics and the data of a log, Source
+// names the ABI the event was found in
+type Event struct {
+	Source    string     `json:"source"`
+	Signature string     `json:"signature"`
+	Topic     string     `json:"topic"`
+	Args      []Argument `json:"args"`
+}
+
+// namedMethod is a function of a registered ABI together with the name
+// the ABI was registered with
+type namedMethod struct {
+	source string
+	method abi.Method
+}
+
+// namedEvent is an event of a registered ABI together with the name the
+// ABI was registered with
+type namedEvent struct {
+	source string
+	event  abi.Event
+}
+
+// Decoder identifies function calls by their 4 byte selector and events
+// by their first topic among the functions and events of the registered
+// ABIs. Functions and events shared by several contracts, such as the
+// ERC20 ones, are only kept once.
+type Decoder struct {
+	methods map[[4]byte][]namedMethod
+	events  map[common.Hash][]namedEvent
+	known   map[string]bool
+}
+
+// NewDecoder creates a decoder without any ABI
+func NewDecoder() *Decoder {
+	return &Decoder{
+		methods: map[[4]byte][]namedMethod{},
+		events:  map[common.Hash][]namedEvent{},
+		known:   map[string]bool{},
+	}
+}
+
+// AddABI registers the functions and events of an ABI under a name, such
+// as the name of its contract
+func (d *Decoder) AddABI(source string, parsed abi.ABI) {
+	// Sort the names so that the decoder doesn't depend on map ordering
+	methodNames := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		methodNames = append(methodNames, name)
+	}
+	sort.Strings(methodNames)
+	for _, name := range methodNames {
+		method := parsed.Methods[name]
+		key := "function " + method.Sig
+		if d.known[key] {
+			continue
+		}
+		d.known[key] = true
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		d.methods[selector] = append(d.methods[selector],
+			namedMethod{source, method})
+	}
+	eventNames := make([]string, 0, len(parsed.Events))
+	for name := range parsed.Events {
+		eventNames = append(eventNames, name)
+	}
+	sort.Strings(eventNames)
+	for _, name := range eventNames {
+		event := parsed.Events[name]
+		if event.Anonymous {
+			continue
+		}
+		// Events with the same signature differ by their indexed arguments,
+		// such as the ERC20 and ERC721 Transfer events
+		key := "event " + eventLayout(event)
+		if d.known[key] {
+			continue
+		}
+		d.known[key] = true
+		d.events[event.ID] = append(d.events[event.ID],
+			namedEvent{source, event})
+	}
+}
+
+// eventLayout is the signature of the event with its indexed arguments
+// marked
+func eventLayout(event abi.Event) string {
+	types := make([]string, len(event.Inputs))
+	for i, input := range event.Inputs {
+		types[i] = input.Type.String()
+		if input.Indexed {
+			types[i] += " indexed"
+		}
+	}
+	return fmt.Sprintf("%s(%s)", event.RawName, strings.Join(types, ","))
+}
+
+// DecodeCall identifies the function called by the calldata and decodes
+// its arguments. Every function sharing the selector is tried until one
+// of them decodes the arguments.
+func (d *Decoder) DecodeCall(data []byte) (*Call, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("error: calldata %s is shorter than a "+
+			"function selector", hexutil.Encode(data))
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	candidates := d.methods[selector]
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("error: no known function has the selector %s",
+			hexutil.Encode(selector[:]))
+	}
+	var lastErr error
+	for _, candidate := range candidates {
+		call, err := DecodeMethodCall(candidate.source, candidate.method, data)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return call, nil
+	}
+	return nil, lastErr
+}
+
+// DecodeMethodCall decodes the arguments of calldata calling a given
+// method, such as one parsed from a text signature with ParseSignature
+func DecodeMethodCall(source string, method abi.Method, data []byte) (*Call,
+	error) {
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return nil, fmt.Errorf("error: the calldata doesn't call %s",
+			method.Sig)
+	}
+	values, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to decode the arguments of %s: "+
+			"%v", method.Sig, err)
+	}
+	args := make([]Argument, len(values))
+	for i, value := range values {
+		args[i] = newArgument(i, method.Inputs[i], value)
+	}
+	return &Call{
+		Source:    source,
+		Signature: method.Sig,
+		Selector:  hexutil.Encode(method.ID),
+		Args:      args,
+	}, nil
+}
+
+// DecodeLog identifies the event emitted by a log from its first topic and
+// decodes its indexed arguments from the other topics and the remaining
+// ones from the data. Indexed strings, bytes and arrays are only known by
+// their hash.
+func (d *Decoder) DecodeLog(topics []common.Hash, data []byte) (*Event,
+	error) {
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("error: the log has no topic, anonymous " +
+			"events can't be identified")
+	}
+	candidates := d.events[topics[0]]
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("error: no known event has the topic %s",
+			topics[0].Hex())
+	}
+	var lastErr error
+	for _, candidate := range candidates {
+		args, err := decodeEventArgs(candidate.event, topics[1:], data)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		return &Event{
+			Source:    candidate.source,
+			Signature: candidate.event.Sig,
+			Topic:     topics[0].Hex(),
+			Args:      args,
+		}, nil
+	}
+	return nil, fmt.Errorf("error: failed to decode the arguments of %s: %v",
+		candidates[0].event.Sig, lastErr)
+}
+
+// decodeEventArgs decodes the arguments of an event in their declaration
+// order
+func decodeEventArgs(event abi.Event, topics []common.Hash,
+	data []byte) ([]Argument, error) {
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if len(indexed) != len(topics) {
+		return nil, fmt.Errorf("%d indexed arguments expected but the log "+
+			"has %d topics", len(indexed), len(topics)+1)
+	}
+	values, err := event.Inputs.UnpackValues(data)
+	if err != nil {
+		return nil, err
+	}
+	args := make([]Argument, 0, len(event.Inputs))
+	topic, value := 0, 0
+	for i, input := range event.Inputs {
+		if !input.Indexed {
+			args = append(args, newArgument(i, input, values[value]))
+			value++
+			continue
+		}
+		decoded, err1 := decodeTopic(input, topics[topic])
+		if err1 != nil {
+			return nil, err1
+		}
+		argument := newArgument(i, input, decoded)
+		argument.Indexed = true
+		args = append(args, argument)
+		topic++
+	}
+	return args, nil
+}
+
+// decodeTopic converts the topic of an indexed argument back into its
+// value, the hash itself is returned for dynamic types
+func decodeTopic(input abi.Argument, topic common.Hash) (interface{},
+	error) {
+	switch input.Type.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return topic, nil
+	}
+	out := map[string]interface{}{}
+	input.Name = "value"
+	err := abi.ParseTopicsIntoMap(out, abi.Arguments{input},
+		[]common.Hash{topic})
+	if err != nil {
+		return nil, err
+	}
+	return out["value"], nil
+}
+
+// newArgument formats a decoded value, unnamed arguments are named after
+// their position
+func newArgument(index int, input abi.Argument, value interface{}) Argument {
+	name := input.Name
+	if len(name) == 0 {
+		name = fmt.Sprintf("arg%d", index)
+	}
+	return Argument{
+		Name:  name,
+		Type:  input.Type.String(),
+		Value: FormatValue(value),
+	}
+}
+
+// FormatValue converts a decoded ABI value into a readable string:
+// addresses are checksummed, byte arrays and slices are in hex, integers
+// in base 10 and arrays and tuples are listed between brackets
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case string:
+		return v
+	case bool:
+		return fmt.Sprintf("%t", v)
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		// Fixed size byte arrays such as bytes32
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			bytes := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(bytes), rv)
+			return hexutil.Encode(bytes)
+		}
+		return formatList(rv)
+	case reflect.Slice:
+		return formatList(rv)
+	case reflect.Struct:
+		fields := make([]string, rv.NumField())
+		for i := range fields {
+			fields[i] = FormatValue(rv.Field(i).Interface())
+		}
+		return "(" + strings.Join(fields, ",") + ")"
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// formatList formats the elements of an array or a slice
+func formatList(rv reflect.Value) string {
+	elements := make([]string, rv.Len())
+	for i := range elements {
+		elements[i] = FormatValue(rv.Index(i).Interface())
+	}
+	return "[" + strings.Join(elements, ",") + "]"
+}
+
+// String formats the call on a single line
+func (c *Call) String() string {
+	return fmt.Sprintf("%s %s", c.Signature, formatArgs(c.Args))
+}
+
+// String formats the event on a single line
+func (e *Event) String() string {
+	return fmt.Sprintf("%s %s", e.Signature, formatArgs(e.Args))
+}
+
+// formatArgs formats the arguments as name=value pairs
+func formatArgs(args []Argument) string {
+	pairs := make([]string, len(args))
+	for i, arg := range args {
+		pairs[i] = fmt.Sprintf("%s=%s", arg.Name, arg.Value)
+	}
+	return strings.Join(pairs, " ")
+}
+
+// WriteArgs lists decoded arguments one per line with their type
+func WriteArgs(b *strings.Builder, indent string, args []Argument) {
+	for _, arg := range args {
+		kind := arg.Type
+		if arg.Indexed {
+			kind += " indexed"
+		}
+		fmt.Fprintf(b, "%s%s (%s): %s\n", indent, arg.Name, kind, arg.Value)
+	}
+}
+
+// Text formats the call with one argument per line
+func (c *Call) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Function:  %s\n", c.Signature)
+	fmt.Fprintf(&b, "Selector:  %s\n", c.Selector)
+	fmt.Fprintf(&b, "Source:    %s\n", c.Source)
+	WriteArgs(&b, "  ", c.Args)
+	return b.String()
+}
+
+// Text formats the event with one argument per line
+func (e *Event) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Event:     %s\n", e.Signature)
+	fmt.Fprintf(&b, "Topic:     %s\n", e.Topic)
+	fmt.Fprintf(&b, "Source:    %s\n", e.Source)
+	WriteArgs(&b, "  ", e.Args)
+	return b.String()
+}
@@ -0,0 +1,267 @@
+package abi_decoder
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+const erc20Abi = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"recipient","type":"address"},
+	           {"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"setNames","stateMutability":"nonpayable",
+	 "inputs":[{"name":"","type":"string[]"},{"name":"flag","type":"bool"}],
+	 "outputs":[]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},
+	           {"name":"to","type":"address","indexed":true},
+	           {"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Named","anonymous":false,
+	 "inputs":[{"name":"name","type":"string","indexed":true},
+	           {"name":"data","type":"bytes","indexed":false}]}
+]`
+
+const erc721Abi = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"to","type":"address"},
+	           {"name":"value","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},
+	           {"name":"to","type":"address","indexed":true},
+	           {"name":"tokenId","type":"uint256","indexed":true}]}
+]`
+
+var (
+	from = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	to   = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+)
+
+func newTestDecoder(t *testing.T) *Decoder {
+	decoder := NewDecoder()
+	for _, source := range []struct {
+		name string
+		abi  string
+	}{{"erc20", erc20Abi}, {"erc721", erc721Abi}} {
+		parsed, err := abi.JSON(strings.NewReader(source.abi))
+		assert.NoError(t, err)
+		decoder.AddABI(source.name, parsed)
+	}
+	return decoder
+}
+
+func TestDecoderDecodeCall(t *testing.T) {
+	parsed, _ := abi.JSON(strings.NewReader(erc20Abi))
+	transfer, _ := parsed.Pack("transfer", to, big.NewInt(1000))
+	setNames, _ := parsed.Pack("setNames", []string{"a", "b"}, true)
+	tests := []struct {
+		testName      string
+		data          []byte
+		expectedCall  *Call
+		expectedError string
+	}{
+		{
+			testName: "Shared function is decoded with the first ABI",
+			data:     transfer,
+			expectedCall: &Call{
+				Source:    "erc20",
+				Signature: "transfer(address,uint256)",
+				Selector:  "0xa9059cbb",
+				Args: []Argument{
+					{Name: "recipient", Type: "address", Value: to.Hex()},
+					{Name: "amount", Type: "uint256", Value: "1000"},
+				},
+			},
+		},
+		{
+			testName: "Unnamed arguments and lists",
+			data:     setNames,
+			expectedCall: &Call{
+				Source:    "erc20",
+				Signature: "setNames(string[],bool)",
+				Selector:  hexutil.Encode(setNames[:4]),
+				Args: []Argument{
+					{Name: "arg0", Type: "string[]", Value: "[a,b]"},
+					{Name: "flag", Type: "bool", Value: "true"},
+				},
+			},
+		},
+		{
+			testName:      "Unknown selector",
+			data:          common.FromHex("0x12345678"),
+			expectedError: "error: no known function has the selector 0x12345678",
+		},
+		{
+			testName: "Calldata shorter than a selector",
+			data:     common.FromHex("0x1234"),
+			expectedError: "error: calldata 0x1234 is shorter than a function " +
+				"selector",
+		},
+		{
+			testName: "Truncated arguments",
+			data:     transfer[:20],
+			expectedError: "error: failed to decode the arguments of " +
+				"transfer(address,uint256)",
+		},
+	}
+	decoder := newTestDecoder(t)
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			call, err := decoder.DecodeCall(tt.data)
+			if len(tt.expectedError) != 0 {
+				assert.Error(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), tt.expectedError),
+					err.Error())
+				assert.Nil(t, call)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCall, call)
+		})
+	}
+}
+
+func TestDecoderDecodeLog(t *testing.T) {
+	transferTopic := crypto.Keccak256Hash(
+		[]byte("Transfer(address,address,uint256)"))
+	namedTopic := crypto.Keccak256Hash([]byte("Named(string,bytes)"))
+	nameHash := crypto.Keccak256Hash([]byte("alice"))
+	amount := common.LeftPadBytes(big.NewInt(500).Bytes(), 32)
+	parsed, _ := abi.JSON(strings.NewReader(erc20Abi))
+	namedData, _ := parsed.Events["Named"].Inputs.NonIndexed().Pack(
+		[]byte{0xca, 0xfe})
+	tests := []struct {
+		testName      string
+		topics        []common.Hash
+		data          []byte
+		expectedEvent *Event
+		expectedError string
+	}{
+		{
+			testName: "ERC20 Transfer",
+			topics:   []common.Hash{transferTopic, from.Hash(), to.Hash()},
+			data:     amount,
+			expectedEvent: &Event{
+				Source:    "erc20",
+				Signature: "Transfer(address,address,uint256)",
+				Topic:     transferTopic.Hex(),
+				Args: []Argument{
+					{Name: "from", Type: "address", Value: from.Hex(),
+						Indexed: true},
+					{Name: "to", Type: "address", Value: to.Hex(), Indexed: true},
+					{Name: "value", Type: "uint256", Value: "500"},
+				},
+			},
+		},
+		{
+			testName: "ERC721 Transfer shares the topic of the ERC20 one",
+			topics: []common.Hash{transferTopic, from.Hash(), to.Hash(),
+				common.BigToHash(big.NewInt(7))},
+			expectedEvent: &Event{
+				Source:    "erc721",
+				Signature: "Transfer(address,address,uint256)",
+				Topic:     transferTopic.Hex(),
+				Args: []Argument{
+					{Name: "from", Type: "address", Value: from.Hex(),
+						Indexed: true},
+					{Name: "to", Type: "address", Value: to.Hex(), Indexed: true},
+					{Name: "tokenId", Type: "uint256", Value: "7",
+						Indexed: true},
+				},
+			},
+		},
+		{
+			testName: "Indexed string is only known by its hash",
+			topics:   []common.Hash{namedTopic, nameHash},
+			data:     namedData,
+			expectedEvent: &Event{
+				Source:    "erc20",
+				Signature: "Named(string,bytes)",
+				Topic:     namedTopic.Hex(),
+				Args: []Argument{
+					{Name: "name", Type: "string", Value: nameHash.Hex(),
+						Indexed: true},
+					{Name: "data", Type: "bytes", Value: "0xcafe"},
+				},
+			},
+		},
+		{
+			testName:      "Anonymous log",
+			expectedError: "error: the log has no topic",
+		},
+		{
+			testName: "Unknown topic",
+			topics:   []common.Hash{nameHash},
+			expectedError: "error: no known event has the topic " +
+				nameHash.Hex(),
+		},
+		{
+			testName: "Topics matching no layout",
+			topics:   []common.Hash{transferTopic, from.Hash()},
+			expectedError: "error: failed to decode the arguments of " +
+				"Transfer(address,address,uint256)",
+		},
+	}
+	decoder := newTestDecoder(t)
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			event, err := decoder.DecodeLog(tt.topics, tt.data)
+			if len(tt.expectedError) != 0 {
+				assert.Error(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), tt.expectedError),
+					err.Error())
+				assert.Nil(t, event)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedEvent, event)
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		testName string
+		value    interface{}
+		expected string
+	}{
+		{"Address", common.HexToAddress(strings.ToLower(to.Hex())), to.Hex()},
+		{"Big integer", big.NewInt(-42), "-42"},
+		{"Small integer", uint8(7), "7"},
+		{"Bytes", []byte{0x01, 0x02}, "0x0102"},
+		{"Fixed bytes", [4]byte{0xde, 0xad, 0xbe, 0xef}, "0xdeadbeef"},
+		{"Bool", false, "false"},
+		{"String", "hello", "hello"},
+		{"Array", [2]*big.Int{big.NewInt(1), big.NewInt(2)}, "[1,2]"},
+		{"Slice of addresses", []common.Address{from, to},
+			"[" + from.Hex() + "," + to.Hex() + "]"},
+		{"Tuple", struct {
+			Id     *big.Int
+			Owners []common.Address
+		}{big.NewInt(3), []common.Address{from}}, "(3,[" + from.Hex() + "])"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatValue(tt.value))
+		})
+	}
+}
+
+func TestCallString(t *testing.T) {
+	call := &Call{
+		Signature: "transfer(address,uint256)",
+		Args: []Argument{
+			{Name: "recipient", Type: "address", Value: to.Hex()},
+			{Name: "amount", Type: "uint256", Value: "1000"},
+		},
+	}
+	assert.Equal(t, "transfer(address,uint256) recipient="+to.Hex()+
+		" amount=1000", call.String())
+}
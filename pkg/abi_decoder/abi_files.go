@@ -0,0 +1,98 @@
+package abi_decoder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// AbiFileExtensions are the extensions of the files read by AddDir: the
+// .abi files written by solc and the .json artifacts of other toolchains
+var AbiFileExtensions = []string{".abi", ".json"}
+
+// ParseABIFile parses an ABI given either as the JSON list written by solc
+// or as a JSON artifact holding it in its "abi" field, as written by
+// hardhat and truffle
+func ParseABIFile(content []byte) (abi.ABI, error) {
+	trimmed := bytes.TrimSpace(content)
+	if len(trimmed) != 0 && trimmed[0] == '{' {
+		var artifact struct {
+			Abi json.RawMessage `json:"abi"`
+		}
+		err := json.Unmarshal(trimmed, &artifact)
+		if err != nil {
+			return abi.ABI{}, err
+		}
+		if len(artifact.Abi) == 0 {
+			return abi.ABI{}, fmt.Errorf("the JSON object has no abi field")
+		}
+		trimmed = artifact.Abi
+	}
+	return abi.JSON(bytes.NewReader(trimmed))
+}
+
+// NamedABI is a parsed ABI with the name it is registered with
+type NamedABI struct {
+	Name string
+	ABI  abi.ABI
+}
+
+// ReadDir parses every .abi and .json file of a directory in name order,
+// each ABI is named after its file without the extension
+func ReadDir(dir string) ([]NamedABI, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read the ABI directory %s: %v",
+			dir, err)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		for _, extension := range AbiFileExtensions {
+			if strings.EqualFold(filepath.Ext(entry.Name()), extension) {
+				names = append(names, entry.Name())
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	abis := make([]NamedABI, len(names))
+	for i, name := range names {
+		path := filepath.Join(dir, name)
+		content, err1 := ioutil.ReadFile(path)
+		if err1 != nil {
+			return nil, fmt.Errorf("error: failed to read the ABI file %s: %v",
+				path, err1)
+		}
+		parsed, err2 := ParseABIFile(content)
+		if err2 != nil {
+			return nil, fmt.Errorf("error: failed to parse the ABI file %s: %v",
+				path, err2)
+		}
+		abis[i] = NamedABI{
+			Name: strings.TrimSuffix(name, filepath.Ext(name)),
+			ABI:  parsed,
+		}
+	}
+	return abis, nil
+}
+
+// AddDir registers the ABIs of a directory read with ReadDir and returns
+// how many were registered
+func (d *Decoder) AddDir(dir string) (int, error) {
+	abis, err := ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	for _, named := range abis {
+		d.AddABI(named.Name, named.ABI)
+	}
+	return len(abis), nil
+}
@@ -0,0 +1,86 @@
+package abi_decoder
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDecoderAddDir(t *testing.T) {
+	erc721Artifact := `{"contractName":"TestNFT","abi":` + erc721Abi +
+		`,"bytecode":"0x"}`
+	tests := []struct {
+		testName            string
+		files               map[string]string
+		expectedCount       int
+		expectedCallSource  string
+		expectedEventSource string
+		expectedError       string
+	}{
+		{
+			testName: "ABI files and artifacts",
+			files: map[string]string{
+				"b_token.abi":     erc20Abi,
+				"a_nft.json":      erc721Artifact,
+				"README.md":       "not an ABI",
+				"sub/ignored.abi": "[]",
+			},
+			expectedCount: 2,
+			// The files are registered in name order, the transfer
+			// function shared by both ABIs comes from the first one
+			expectedCallSource:  "a_nft",
+			expectedEventSource: "b_token",
+		},
+		{
+			testName: "Invalid ABI file",
+			files: map[string]string{
+				"broken.abi": `[{"type":"function"`,
+			},
+			expectedError: "error: failed to parse the ABI file ",
+		},
+		{
+			testName: "Artifact without an ABI",
+			files: map[string]string{
+				"artifact.json": `{"bytecode":"0x"}`,
+			},
+			expectedError: "error: failed to parse the ABI file ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "abi")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+			}
+
+			decoder := NewDecoder()
+			count, err1 := decoder.AddDir(dir)
+			if len(tt.expectedError) != 0 {
+				assert.Error(t, err1)
+				assert.True(t, strings.HasPrefix(err1.Error(),
+					tt.expectedError), err1.Error())
+				return
+			}
+			assert.NoError(t, err1)
+			assert.Equal(t, tt.expectedCount, count)
+			call, err2 := decoder.DecodeCall(common.FromHex("0xa9059cbb" +
+				strings.Repeat("00", 64)))
+			assert.NoError(t, err2)
+			assert.Equal(t, tt.expectedCallSource, call.Source)
+			event, err3 := decoder.DecodeLog([]common.Hash{crypto.Keccak256Hash(
+				[]byte("Transfer(address,address,uint256)")), from.Hash(),
+				to.Hash()}, make([]byte, 32))
+			assert.NoError(t, err3)
+			assert.Equal(t, tt.expectedEventSource, event.Source)
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package abi_decoder
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// dataLocations are the Solidity keywords allowed between the type and the
+// name of a parameter, they don't change the encoding
+var dataLocations = map[string]bool{
+	"memory":   true,
+	"calldata": true,
+	"storage":  true,
+	"indexed":  true,
+}
+
+// ParseSignature parses a function signature such as
+// transfer(address,uint256) into a method whose inputs can be packed.
+// Parameter names, the function keyword and tuple types written between
+// parentheses, such as (address,uint256)[], are accepted.
+func ParseSignature(signature string) (abi.Method, error) {
+	trimmed := strings.TrimSpace(signature)
+	trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, "function "))
+	open := strings.Index(trimmed, "(")
+	if open <= 0 || !strings.HasSuffix(trimmed, ")") {
+		return abi.Method{}, fmt.Errorf("error: %q is not a valid function "+
+			"signature, expected name(type1,type2,...)", signature)
+	}
+	name := trimmed[:open]
+	if strings.ContainsAny(name, " \t,()[]") {
+		return abi.Method{}, fmt.Errorf("error: %q is not a valid function "+
+			"name", name)
+	}
+	params, err := splitTopLevel(trimmed[open+1 : len(trimmed)-1])
+	if err != nil {
+		return abi.Method{}, fmt.Errorf("error: %q is not a valid function "+
+			"signature: %v", signature, err)
+	}
+	inputs := make(abi.Arguments, len(params))
+	for i, param := range params {
+		argument, err1 := parseParameter(param)
+		if err1 != nil {
+			return abi.Method{}, fmt.Errorf("error: invalid parameter %q of "+
+				"%s: %v", param, name, err1)
+		}
+		inputs[i] = argument
+	}
+	return abi.NewMethod(name, name, abi.Function, "nonpayable", false,
+		false, inputs, nil), nil
+}
+
+// parseParameter parses a parameter made of a type, optionally followed by
+// a data location and a name
+func parseParameter(param string) (abi.Argument, error) {
+	typeName, name := splitParameter(param)
+	marshaling, err := typeMarshaling(typeName)
+	if err != nil {
+		return abi.Argument{}, err
+	}
+	parsed, err1 := abi.NewType(marshaling.Type, "", marshaling.Components)
+	if err1 != nil {
+		return abi.Argument{}, err1
+	}
+	return abi.Argument{Name: name, Type: parsed}, nil
+}
+
+// splitParameter separates the type of a parameter from its name. The
+// type of a tuple ends with its closing parenthesis and array suffixes, so
+// the name starts after the first space outside of any parenthesis.
+func splitParameter(param string) (string, string) {
+	depth := 0
+	for i, c := range param {
+		if c == '(' {
+			depth++
+		} else if c == ')' {
+			depth--
+		} else if (c == ' ' || c == '\t') && depth == 0 {
+			var name string
+			for _, word := range strings.Fields(param[i+1:]) {
+				if !dataLocations[word] {
+					name = word
+				}
+			}
+			return param[:i], name
+		}
+	}
+	return param, ""
+}
+
+// typeMarshaling converts a type written in a signature into its JSON ABI
+// form, which describes the components of tuples separately
+func typeMarshaling(typeName string) (abi.ArgumentMarshaling, error) {
+	if !strings.HasPrefix(typeName, "(") {
+		// uint and int are aliases of uint256 and int256 in Solidity
+		base := typeName
+		if bracket := strings.Index(typeName, "["); bracket != -1 {
+			base = typeName[:bracket]
+		}
+		if base == "uint" || base == "int" {
+			typeName = base + "256" + typeName[len(base):]
+		}
+		return abi.ArgumentMarshaling{Type: typeName}, nil
+	}
+	closing := strings.LastIndex(typeName, ")")
+	components, err := splitTopLevel(typeName[1:closing])
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+	marshaling := abi.ArgumentMarshaling{
+		Type:       "tuple" + typeName[closing+1:],
+		Components: make([]abi.ArgumentMarshaling, len(components)),
+	}
+	for i, component := range components {
+		componentType, name := splitParameter(component)
+		nested, err1 := typeMarshaling(componentType)
+		if err1 != nil {
+			return abi.ArgumentMarshaling{}, err1
+		}
+		// Components need a name to become the fields of a struct
+		nested.Name = name
+		if len(nested.Name) == 0 {
+			nested.Name = fmt.Sprintf("field%d", i)
+		}
+		marshaling.Components[i] = nested
+	}
+	return marshaling, nil
+}
+
+// splitTopLevel splits a comma separated list whose elements may hold
+// nested lists between brackets or parentheses, an empty list has no
+// element
+func splitTopLevel(list string) ([]string, error) {
+	if len(strings.TrimSpace(list)) == 0 {
+		return nil, nil
+	}
+	var elements []string
+	depth, start := 0, 0
+	for i, c := range list {
+		switch c {
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced %q", c)
+			}
+		case ',':
+			if depth == 0 {
+				elements = append(elements, strings.TrimSpace(list[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets or parentheses")
+	}
+	elements = append(elements, strings.TrimSpace(list[start:]))
+	for _, element := range elements {
+		if len(element) == 0 {
+			return nil, fmt.Errorf("empty element in %q", list)
+		}
+	}
+	return elements, nil
+}
+
+// Encode builds the calldata calling the method with the given arguments,
+// each converted from its string form with ParseValue
+func Encode(method abi.Method, args []string) ([]byte, error) {
+	if len(args) != len(method.Inputs) {
+		return nil, fmt.Errorf("error: %s expects %d arguments but %d were "+
+			"given", method.Sig, len(method.Inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range args {
+		value, err := ParseValue(method.Inputs[i].Type, arg)
+		if err != nil {
+			return nil, fmt.Errorf("error: invalid argument %d of %s: %v",
+				i, method.Sig, strings.TrimPrefix(err.Error(), "error: "))
+		}
+		values[i] = value
+	}
+	packed, err1 := method.Inputs.Pack(values...)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to encode the arguments of %s: "+
+			"%v", method.Sig, err1)
+	}
+	return append(append([]byte{}, method.ID...), packed...), nil
+}
+
+// ParseValue converts an argument given as a string into the Go value the
+// ABI packer expects for its type. Integers are in base 10 or in hex
+// starting with 0x, bytes in hex, and arrays and tuples list their
+// elements separated by commas between brackets and parentheses, such as
+// [1,2] or (0x...,5).
+func ParseValue(t abi.Type, arg string) (interface{}, error) {
+	// Strings are kept as given, spaces included
+	if t.T == abi.StringTy {
+		return arg, nil
+	}
+	arg = strings.TrimSpace(arg)
+	switch t.T {
+	case abi.AddressTy:
+		if !common.IsHexAddress(arg) {
+			return nil, fmt.Errorf("error: %q is not a valid address", arg)
+		}
+		return common.HexToAddress(arg), nil
+	case abi.BoolTy:
+		value, err := strconv.ParseBool(arg)
+		if err != nil {
+			return nil, fmt.Errorf("error: %q is not a valid bool", arg)
+		}
+		return value, nil
+	case abi.BytesTy:
+		value, err := hexutil.Decode(arg)
+		if err != nil {
+			return nil, fmt.Errorf("error: %q is not valid hex bytes: %v",
+				arg, err)
+		}
+		return value, nil
+	case abi.FixedBytesTy:
+		value, err := hexutil.Decode(arg)
+		if err != nil || len(value) != t.Size {
+			return nil, fmt.Errorf("error: %q is not a valid %s, %d bytes "+
+				"in hex are expected", arg, t.String(), t.Size)
+		}
+		array := reflect.New(t.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(value))
+		return array.Interface(), nil
+	case abi.IntTy, abi.UintTy:
+		return parseInteger(t, arg)
+	case abi.SliceTy, abi.ArrayTy:
+		return parseList(t, arg)
+	case abi.TupleTy:
+		return parseTuple(t, arg)
+	}
+	return nil, fmt.Errorf("error: arguments of type %s aren't supported",
+		t.String())
+}
+
+// parseInteger converts an integer and checks that it fits its type, the
+// packer expects native integers for the types of 64 bits and less
+func parseInteger(t abi.Type, arg string) (interface{}, error) {
+	value, ok := new(big.Int).SetString(arg, 0)
+	if !ok {
+		return nil, fmt.Errorf("error: %q is not a valid %s", arg, t.String())
+	}
+	min, max := new(big.Int), new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+	if t.T == abi.IntTy {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if value.Cmp(min) < 0 || value.Cmp(max) >= 0 {
+		return nil, fmt.Errorf("error: %s doesn't fit in a %s", arg,
+			t.String())
+	}
+	goType := t.GetType()
+	switch goType.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		integer := reflect.New(goType).Elem()
+		integer.SetUint(value.Uint64())
+		return integer.Interface(), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		integer := reflect.New(goType).Elem()
+		integer.SetInt(value.Int64())
+		return integer.Interface(), nil
+	}
+	return value, nil
+}
+
+// unwrap removes the brackets or parentheses around a list, a top level
+// list may also be given without them
+func unwrap(arg string, open, close string) string {
+	if strings.HasPrefix(arg, open) && strings.HasSuffix(arg, close) {
+		return arg[1 : len(arg)-1]
+	}
+	return arg
+}
+
+// parseList converts the elements of a fixed size array or of a slice
+func parseList(t abi.Type, arg string) (interface{}, error) {
+	elements, err := splitTopLevel(unwrap(arg, "[", "]"))
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not a valid %s: %v", arg,
+			t.String(), err)
+	}
+	var list reflect.Value
+	if t.T == abi.ArrayTy {
+		if len(elements) != t.Size {
+			return nil, fmt.Errorf("error: %q is not a valid %s, %d "+
+				"elements are expected", arg, t.String(), t.Size)
+		}
+		list = reflect.New(t.GetType()).Elem()
+	} else {
+		list = reflect.MakeSlice(t.GetType(), len(elements), len(elements))
+	}
+	for i, element := range elements {
+		value, err1 := ParseValue(*t.Elem, element)
+		if err1 != nil {
+			return nil, err1
+		}
+		list.Index(i).Set(reflect.ValueOf(value))
+	}
+	return list.Interface(), nil
+}
+
+// parseTuple converts the components of a tuple into the fields of the
+// struct generated for its type
+func parseTuple(t abi.Type, arg string) (interface{}, error) {
+	elements, err := splitTopLevel(unwrap(arg, "(", ")"))
+	if err != nil || len(elements) != len(t.TupleElems) {
+		return nil, fmt.Errorf("error: %q is not a valid %s, %d "+
+			"components are expected", arg, t.String(), len(t.TupleElems))
+	}
+	tuple := reflect.New(t.GetType()).Elem()
+	for i, element := range elements {
+		value, err1 := ParseValue(*t.TupleElems[i], element)
+		if err1 != nil {
+			return nil, err1
+		}
+		tuple.Field(i).Set(reflect.ValueOf(value))
+	}
+	return tuple.Interface(), nil
+}
@@ -0,0 +1,212 @@
+package abi_decoder
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseSignature(t *testing.T) {
+	tests := []struct {
+		testName         string
+		signature        string
+		expectedSig      string
+		expectedSelector string
+		expectedNames    []string
+		expectedError    string
+	}{
+		{
+			testName:         "Canonical signature",
+			signature:        "transfer(address,uint256)",
+			expectedSig:      "transfer(address,uint256)",
+			expectedSelector: "0xa9059cbb",
+			expectedNames:    []string{"", ""},
+		},
+		{
+			testName:         "Named parameters and aliases",
+			signature:        "function transfer(address to, uint amount)",
+			expectedSig:      "transfer(address,uint256)",
+			expectedSelector: "0xa9059cbb",
+			expectedNames:    []string{"to", "amount"},
+		},
+		{
+			testName:         "No parameter",
+			signature:        "totalSupply()",
+			expectedSig:      "totalSupply()",
+			expectedSelector: "0x18160ddd",
+			expectedNames:    []string{},
+		},
+		{
+			testName: "Tuples and arrays",
+			signature: "aggregate3((address target, bool allowFailure, " +
+				"bytes callData)[] calldata calls)",
+			expectedSig:      "aggregate3((address,bool,bytes)[])",
+			expectedSelector: "0x82ad56cb",
+			expectedNames:    []string{"calls"},
+		},
+		{
+			testName:  "Missing parentheses",
+			signature: "transfer",
+			expectedError: "error: \"transfer\" is not a valid function " +
+				"signature",
+		},
+		{
+			testName:  "Unbalanced parentheses",
+			signature: "f((address,uint256)",
+			expectedError: "error: \"f((address,uint256)\" is not a valid " +
+				"function signature",
+		},
+		{
+			testName:      "Unknown type",
+			signature:     "f(unit256)",
+			expectedError: "error: invalid parameter \"unit256\" of f",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			method, err := ParseSignature(tt.signature)
+			if len(tt.expectedError) != 0 {
+				assert.Error(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), tt.expectedError),
+					err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedSig, method.Sig)
+			assert.Equal(t, tt.expectedSelector, hexutil.Encode(method.ID))
+			names := make([]string, len(method.Inputs))
+			for i, input := range method.Inputs {
+				names[i] = input.Name
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		testName         string
+		signature        string
+		args             []string
+		expectedCalldata string
+		expectedArgs     []string
+		expectedError    string
+	}{
+		{
+			testName:  "Transfer",
+			signature: "transfer(address,uint256)",
+			args:      []string{to.Hex(), "1000"},
+			expectedCalldata: "0xa9059cbb" +
+				"0000000000000000000000002b5ad5c4795c026514f8317c7a215e218dccd6cf" +
+				"00000000000000000000000000000000000000000000000000000000000003e8",
+		},
+		{
+			testName:  "Small integers, fixed bytes and strings",
+			signature: "f(uint8,int16,bytes2,string,bool)",
+			args:      []string{"0xff", "-300", "0xcafe", " a b ", "true"},
+			expectedArgs: []string{"255", "-300", "0xcafe", " a b ",
+				"true"},
+		},
+		{
+			testName:  "Arrays and tuples",
+			signature: "f(uint256[2],(address,bytes)[],string[])",
+			args: []string{"[1, 2]", "[(" + from.Hex() + ",0x01),(" +
+				to.Hex() + ",0x)]", "a,b"},
+			expectedArgs: []string{"[1,2]", "[(" + from.Hex() + ",0x01),(" +
+				to.Hex() + ",0x)]", "[a,b]"},
+		},
+		{
+			testName:  "Wrong number of arguments",
+			signature: "transfer(address,uint256)",
+			args:      []string{to.Hex()},
+			expectedError: "error: transfer(address,uint256) expects 2 " +
+				"arguments but 1 were given",
+		},
+		{
+			testName:  "Invalid address",
+			signature: "transfer(address,uint256)",
+			args:      []string{"0x1234", "1"},
+			expectedError: "error: invalid argument 0 of " +
+				"transfer(address,uint256): \"0x1234\" is not a valid address",
+		},
+		{
+			testName:  "Integer overflow",
+			signature: "f(uint8)",
+			args:      []string{"256"},
+			expectedError: "error: invalid argument 0 of f(uint8): 256 " +
+				"doesn't fit in a uint8",
+		},
+		{
+			testName:  "Negative unsigned integer",
+			signature: "f(uint256)",
+			args:      []string{"-1"},
+			expectedError: "error: invalid argument 0 of f(uint256): -1 " +
+				"doesn't fit in a uint256",
+		},
+		{
+			testName:  "Signed integer underflow",
+			signature: "f(int8)",
+			args:      []string{"-129"},
+			expectedError: "error: invalid argument 0 of f(int8): -129 " +
+				"doesn't fit in a int8",
+		},
+		{
+			testName:  "Fixed bytes of the wrong size",
+			signature: "f(bytes32)",
+			args:      []string{"0x01"},
+			expectedError: "error: invalid argument 0 of f(bytes32): \"0x01\" " +
+				"is not a valid bytes32, 32 bytes in hex are expected",
+		},
+		{
+			testName:  "Array of the wrong size",
+			signature: "f(uint256[2])",
+			args:      []string{"[1]"},
+			expectedError: "error: invalid argument 0 of f(uint256[2]): " +
+				"\"[1]\" is not a valid uint256[2], 2 elements are expected",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			method, err := ParseSignature(tt.signature)
+			assert.NoError(t, err)
+			data, err1 := Encode(method, tt.args)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err1, tt.expectedError)
+				assert.Nil(t, data)
+				return
+			}
+			assert.NoError(t, err1)
+			if len(tt.expectedCalldata) != 0 {
+				assert.Equal(t, tt.expectedCalldata, hexutil.Encode(data))
+			}
+			// The calldata decodes back to the arguments given
+			values, err2 := method.Inputs.UnpackValues(data[4:])
+			assert.NoError(t, err2)
+			if tt.expectedArgs != nil {
+				formatted := make([]string, len(values))
+				for i, value := range values {
+					formatted[i] = FormatValue(value)
+				}
+				assert.Equal(t, tt.expectedArgs, formatted)
+			}
+		})
+	}
+}
+
+func TestParseValueBigIntegers(t *testing.T) {
+	method, _ := ParseSignature("f(uint256,int256)")
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256),
+		big.NewInt(1))
+	value, err := ParseValue(method.Inputs[0].Type, max.String())
+	assert.NoError(t, err)
+	assert.Equal(t, max, value)
+	value1, err1 := ParseValue(method.Inputs[1].Type, "-0x10")
+	assert.NoError(t, err1)
+	assert.Equal(t, big.NewInt(-16), value1)
+	_, err2 := ParseValue(method.Inputs[0].Type, "ten")
+	assert.EqualError(t, err2, "error: \"ten\" is not a valid uint256")
+	_, err3 := ParseValue(method.Inputs[0].Type, "0x")
+	assert.EqualError(t, err3, "error: \"0x\" is not a valid uint256")
+}
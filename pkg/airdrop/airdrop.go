@@ -0,0 +1,498 @@
+package airdrop
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go-evm-client/internal/utils"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMaxInFlight is the number of transfers waiting to be mined at the
+// same time when no limit is given
+const DefaultMaxInFlight = 10
+
+// DefaultReceiptTimeout is how long a transfer is waited for before it is
+// left as sent, a later run then picks it up again
+const DefaultReceiptTimeout = 5 * time.Minute
+
+// IToken is the part of an ERC20 binding needed to send the airdrop
+type IToken interface {
+	Transfer(
+		opts *bind.TransactOpts,
+		recipient common.Address,
+		amount *big.Int,
+	) (*types.Transaction, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+}
+
+// IChain is the part of the RPC client needed to manage the nonces and to
+// follow the transfers until they are mined
+type IChain interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	BalanceAt(
+		ctx context.Context,
+		account common.Address,
+		blockNumber *big.Int,
+	) (*big.Int, error)
+	TransactionByHash(
+		ctx context.Context,
+		hash common.Hash,
+	) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(
+		ctx context.Context,
+		txHash common.Hash,
+	) (*types.Receipt, error)
+}
+
+// Airdrop sends the transfers of a CSV file from a single account. The
+// nonces are assigned in order by a single sender while the receipts of
+// at most MaxInFlight transfers are awaited concurrently. Every change is
+// written to the state file so that an interrupted airdrop can be resumed.
+type Airdrop struct {
+	Token          IToken
+	Chain          IChain
+	Auth           *bind.TransactOpts
+	State          *State
+	StatePath      string
+	Decimals       uint8
+	Symbol         string
+	MaxInFlight    int
+	ReceiptTimeout time.Duration
+	PollInterval   time.Duration
+	mu             sync.Mutex
+}
+
+// NewAirdrop creates the airdrop of the transfers, resuming the one
+// recorded in the state file when it exists. The state file must have been
+// written for the same token, sender and transfers.
+func NewAirdrop(
+	token IToken,
+	chain IChain,
+	auth *bind.TransactOpts,
+	tokenAddress common.Address,
+	transfers []*Transfer,
+	statePath string,
+	maxInFlight int,
+) (*Airdrop, error) {
+	if maxInFlight <= 0 {
+		maxInFlight = DefaultMaxInFlight
+	}
+	state := &State{
+		Token:     tokenAddress,
+		Sender:    auth.From,
+		Transfers: transfers,
+	}
+	previous, err := LoadState(statePath)
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		err1 := previous.matches(state)
+		if err1 != nil {
+			return nil, fmt.Errorf("%v, remove %s or pass another state file "+
+				"to start a new airdrop", err1, statePath)
+		}
+		fmt.Printf("info: Resuming the airdrop recorded in %s, %d of %d "+
+			"transfers already succeeded\n", statePath,
+			previous.count(StatusSuccess), len(previous.Transfers))
+		state = previous
+	}
+	return &Airdrop{
+		Token:          token,
+		Chain:          chain,
+		Auth:           auth,
+		State:          state,
+		StatePath:      statePath,
+		MaxInFlight:    maxInFlight,
+		ReceiptTimeout: DefaultReceiptTimeout,
+		PollInterval:   time.Second,
+	}, nil
+}
+
+// ReadTransfers parses a CSV file holding one address,amount row per
+// transfer. Blank lines, lines starting with # and a leading header row
+// are skipped. Every row is validated before anything is sent, the errors
+// of all invalid rows are returned together.
+func ReadTransfers(
+	path string,
+	parseAddress func(string) (common.Address, error),
+	parseAmount func(string) (*big.Int, error),
+) ([]*Transfer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to open airdrop file: %v", err)
+	}
+	defer file.Close()
+	var transfers []*Transfer
+	var rowErrors []string
+	seen := map[common.Address]int{}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(transfers) == 0 && len(rowErrors) == 0 &&
+			strings.EqualFold(fields[0], "address") {
+			continue
+		}
+		if len(fields) != 2 {
+			rowErrors = append(rowErrors, fmt.Sprintf("error: expected "+
+				"address,amount but found %d fields (%s line %d)",
+				len(fields), path, line))
+			continue
+		}
+		address, err1 := parseAddress(fields[0])
+		if err1 != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("%v (%s line %d)", err1,
+				path, line))
+			continue
+		}
+		amount, err2 := parseAmount(fields[1])
+		if err2 == nil && amount.Sign() == 0 {
+			err2 = fmt.Errorf("error: amount %q must be positive", fields[1])
+		}
+		if err2 != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("%v (%s line %d)", err2,
+				path, line))
+			continue
+		}
+		if first, ok := seen[address]; ok {
+			fmt.Printf("warning: %s receives several transfers, on lines %d "+
+				"and %d of %s\n", address.Hex(), first, line, path)
+		} else {
+			seen[address] = line
+		}
+		transfers = append(transfers, &Transfer{
+			Line:      line,
+			Recipient: address,
+			Amount:    amount,
+			Status:    StatusPending,
+		})
+	}
+	if err3 := scanner.Err(); err3 != nil {
+		return nil, fmt.Errorf("error: failed to read airdrop file: %v", err3)
+	}
+	if len(rowErrors) != 0 {
+		return nil, fmt.Errorf("error: %d invalid rows, nothing was sent:\n%s",
+			len(rowErrors), strings.Join(rowErrors, "\n"))
+	}
+	if len(transfers) == 0 {
+		return nil, fmt.Errorf("error: no transfers found in %s", path)
+	}
+	return transfers, nil
+}
+
+// Validate checks that the sender holds enough tokens for every transfer
+// left to send and enough native coins to pay for their gas
+func (a *Airdrop) Validate(ctx context.Context) error {
+	remaining := a.remaining()
+	total := new(big.Int)
+	for _, transfer := range remaining {
+		total.Add(total, transfer.Amount)
+	}
+	balance, err := a.Token.BalanceOf(&bind.CallOpts{Context: ctx}, a.Auth.From)
+	if err != nil {
+		return fmt.Errorf("error: failed to read the token balance of the "+
+			"sender: %v", err)
+	}
+	if balance.Cmp(total) < 0 {
+		return fmt.Errorf("error: the %d transfers left need %s but the "+
+			"sender %s only holds %s", len(remaining), a.formatAmount(total),
+			a.Auth.From.Hex(), a.formatAmount(balance))
+	}
+	gasCost := new(big.Int).SetUint64(a.Auth.GasLimit)
+	gasCost.Mul(gasCost, a.Auth.GasPrice)
+	gasCost.Mul(gasCost, big.NewInt(int64(len(remaining))))
+	nativeBalance, err1 := a.Chain.BalanceAt(ctx, a.Auth.From, nil)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to read the native balance of the "+
+			"sender: %v", err1)
+	}
+	if nativeBalance.Cmp(gasCost) < 0 {
+		return fmt.Errorf("error: the %d transfers left may cost up to %d wei "+
+			"of gas but the sender %s only holds %d wei", len(remaining),
+			gasCost, a.Auth.From.Hex(), nativeBalance)
+	}
+	fmt.Printf("info: %d transfers left sending %s, the sender holds %s\n",
+		len(remaining), a.formatAmount(total), a.formatAmount(balance))
+	return nil
+}
+
+// Run sends every transfer which didn't succeed yet and waits for them to
+// be mined. The hash and the nonce of a transfer are saved before it is
+// broadcast, so transfers signed by a previous run are looked up first:
+// those known by the node are followed, those it doesn't know are sent
+// again once their nonce is still free. It fails when some transfers
+// didn't succeed, running it again retries them.
+func (a *Airdrop) Run(ctx context.Context) error {
+	var wg sync.WaitGroup
+	inFlight := make(chan struct{}, a.MaxInFlight)
+	var toSend []*Transfer
+	var pendingNonce *uint64
+	for _, transfer := range a.remaining() {
+		if transfer.TxHash == nil || (transfer.Status != StatusSent &&
+			transfer.Status != StatusError) {
+			toSend = append(toSend, transfer)
+			continue
+		}
+		_, _, err := a.Chain.TransactionByHash(ctx, *transfer.TxHash)
+		if err == nil {
+			inFlight <- struct{}{}
+			wg.Add(1)
+			go a.follow(ctx, transfer, inFlight, &wg)
+			continue
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			fmt.Printf("warning: Transfer to %s (line %d) can't be checked, "+
+				"it is left for the next run: %v\n", transfer.Recipient.Hex(),
+				transfer.Line, err)
+			continue
+		}
+		if pendingNonce == nil {
+			nonce, err1 := a.Chain.PendingNonceAt(ctx, a.Auth.From)
+			if err1 != nil {
+				wg.Wait()
+				return fmt.Errorf("error: failed to retrieve the sender "+
+					"nonce: %v", err1)
+			}
+			pendingNonce = &nonce
+		}
+		if *pendingNonce > transfer.Nonce {
+			// The transfer may have been replaced, sending it again could
+			// pay the recipient twice
+			fmt.Printf("warning: Transfer to %s (line %d) is unknown to the "+
+				"node but its nonce %d was used by another transaction, it "+
+				"isn't sent again\n", transfer.Recipient.Hex(), transfer.Line,
+				transfer.Nonce)
+			a.update(transfer, StatusError, transfer.TxHash, transfer.Nonce,
+				fmt.Sprintf("nonce %d was used by another transaction, check "+
+					"whether the recipient was paid before removing the "+
+					"transfer hash from the state file", transfer.Nonce))
+			continue
+		}
+		fmt.Printf("info: Transfer to %s (line %d) never reached the node or "+
+			"was dropped, sending it again\n", transfer.Recipient.Hex(),
+			transfer.Line)
+		toSend = append(toSend, transfer)
+	}
+
+	nonce, err1 := a.Chain.PendingNonceAt(ctx, a.Auth.From)
+	if err1 != nil {
+		wg.Wait()
+		return fmt.Errorf("error: failed to retrieve the sender nonce: %v",
+			err1)
+	}
+	for _, transfer := range toSend {
+		inFlight <- struct{}{}
+		var signedHash *common.Hash
+		opts := *a.Auth
+		opts.Context = ctx
+		opts.Nonce = new(big.Int).SetUint64(nonce)
+		opts.Signer = a.saveSigned(transfer, nonce, &signedHash)
+		tx, err2 := a.Token.Transfer(&opts, transfer.Recipient, transfer.Amount)
+		if err2 != nil && signedHash != nil {
+			// The transfer may have reached the node despite the error
+			_, _, err3 := a.Chain.TransactionByHash(ctx, *signedHash)
+			if err3 == nil {
+				fmt.Printf("info: Transfer to %s (line %d) reached the node "+
+					"despite error: %v\n", transfer.Recipient.Hex(),
+					transfer.Line, err2)
+				err2 = nil
+			} else if !errors.Is(err3, ethereum.NotFound) {
+				<-inFlight
+				a.update(transfer, StatusError, signedHash, nonce,
+					err2.Error())
+				wg.Wait()
+				return fmt.Errorf("error: the transfer to %s (line %d) "+
+					"failed and its status is unknown, the airdrop stopped "+
+					"so that it isn't paid twice, run it again with the "+
+					"same state file to check it: %v",
+					transfer.Recipient.Hex(), transfer.Line, err2)
+			}
+		}
+		if err2 != nil {
+			<-inFlight
+			fmt.Printf("warning: Transfer to %s (line %d) failed: %v\n",
+				transfer.Recipient.Hex(), transfer.Line, err2)
+			a.update(transfer, StatusError, nil, 0, err2.Error())
+			// The node may or may not have taken the nonce
+			nonce, err1 = a.Chain.PendingNonceAt(ctx, a.Auth.From)
+			if err1 != nil {
+				wg.Wait()
+				return fmt.Errorf("error: failed to retrieve the sender "+
+					"nonce: %v", err1)
+			}
+			continue
+		}
+		var hash common.Hash
+		if tx != nil {
+			hash = tx.Hash()
+		} else {
+			hash = *signedHash
+		}
+		a.update(transfer, StatusSent, &hash, nonce, "")
+		fmt.Printf("info: Sent %s to %s (line %d) with nonce %d, tx %s\n",
+			a.formatAmount(transfer.Amount), transfer.Recipient.Hex(),
+			transfer.Line, nonce, hash.Hex())
+		nonce++
+		wg.Add(1)
+		go a.follow(ctx, transfer, inFlight, &wg)
+	}
+	wg.Wait()
+
+	succeeded := a.State.count(StatusSuccess)
+	total := len(a.State.Transfers)
+	fmt.Printf("info: %d of %d transfers succeeded\n", succeeded, total)
+	if succeeded != total {
+		return fmt.Errorf("error: %d of %d transfers didn't succeed, run the "+
+			"airdrop again with the same state file to retry them",
+			total-succeeded, total)
+	}
+	return nil
+}
+
+// saveSigned returns a signer which saves the hash and the nonce of the
+// signed transfer as sent before the binding broadcasts it. A transfer
+// interrupted between the two is then looked up instead of being sent
+// again by the next run.
+func (a *Airdrop) saveSigned(
+	transfer *Transfer,
+	nonce uint64,
+	signedHash **common.Hash,
+) bind.SignerFn {
+	signer := a.Auth.Signer
+	return func(from common.Address, tx *types.Transaction) (
+		*types.Transaction, error) {
+		signedTx, err := signer(from, tx)
+		if err != nil {
+			return nil, err
+		}
+		hash := signedTx.Hash()
+		err1 := a.record(transfer, StatusSent, &hash, nonce, "")
+		if err1 != nil {
+			return nil, fmt.Errorf("%v, the transfer wasn't sent", err1)
+		}
+		*signedHash = &hash
+		return signedTx, nil
+	}
+}
+
+// follow waits for the transfer to be mined and records its outcome,
+// releasing its in flight slot once done
+func (a *Airdrop) follow(
+	ctx context.Context,
+	transfer *Transfer,
+	inFlight chan struct{},
+	wg *sync.WaitGroup,
+) {
+	defer wg.Done()
+	defer func() { <-inFlight }()
+	hash := transfer.TxHash
+	receipt, err := a.waitReceipt(ctx, *hash)
+	if err != nil {
+		fmt.Printf("warning: Transfer to %s (line %d) is still pending: %v\n",
+			transfer.Recipient.Hex(), transfer.Line, err)
+		a.update(transfer, StatusSent, hash, transfer.Nonce, err.Error())
+		return
+	}
+	a.mu.Lock()
+	transfer.Block = receipt.BlockNumber.Uint64()
+	a.mu.Unlock()
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		fmt.Printf("warning: Transfer to %s (line %d) reverted in block %d\n",
+			transfer.Recipient.Hex(), transfer.Line, receipt.BlockNumber)
+		a.update(transfer, StatusReverted, hash, transfer.Nonce,
+			"transaction reverted")
+		return
+	}
+	a.update(transfer, StatusSuccess, hash, transfer.Nonce, "")
+}
+
+// waitReceipt polls the receipt of the transaction until it is mined or
+// the receipt timeout expires
+func (a *Airdrop) waitReceipt(ctx context.Context, hash common.Hash) (
+	*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.ReceiptTimeout)
+	defer cancel()
+	ticker := time.NewTicker(a.PollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := a.Chain.TransactionReceipt(ctx, hash)
+		if err == nil && receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("not mined after %s", a.ReceiptTimeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// update changes the status of the transfer and saves the state, a failed
+// save is only reported since the transfer itself went through
+func (a *Airdrop) update(
+	transfer *Transfer,
+	status string,
+	hash *common.Hash,
+	nonce uint64,
+	message string,
+) {
+	err := a.record(transfer, status, hash, nonce, message)
+	if err != nil {
+		fmt.Printf("warning: %v\n", err)
+	}
+}
+
+// record changes the status of the transfer and saves the state
+func (a *Airdrop) record(
+	transfer *Transfer,
+	status string,
+	hash *common.Hash,
+	nonce uint64,
+	message string,
+) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	transfer.Status = status
+	transfer.TxHash = hash
+	transfer.Nonce = nonce
+	transfer.Error = message
+	if status != StatusSuccess && status != StatusReverted {
+		transfer.Block = 0
+	}
+	return a.State.Save(a.StatePath)
+}
+
+// remaining returns the transfers which didn't succeed yet
+func (a *Airdrop) remaining() []*Transfer {
+	var remaining []*Transfer
+	for _, transfer := range a.State.Transfers {
+		if transfer.Status != StatusSuccess {
+			remaining = append(remaining, transfer)
+		}
+	}
+	return remaining
+}
+
+// formatAmount shows an amount scaled by the token decimals followed by
+// the token symbol
+func (a *Airdrop) formatAmount(amount *big.Int) string {
+	return fmt.Sprintf("%s %s", utils.FormatTokenAmount(amount, a.Decimals),
+		a.Symbol)
+}
@@ -0,0 +1,476 @@
+package airdrop
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-evm-client/internal/utils"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type MockToken struct {
+	mock.Mock
+}
+
+func (m *MockToken) Transfer(
+	opts *bind.TransactOpts,
+	recipient common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	// The binding signs the transfer before it broadcasts it
+	_, err := opts.Signer(opts.From, newTx(opts.Nonce.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	args := m.Called(opts, recipient, amount)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockToken) BalanceOf(
+	_ *bind.CallOpts,
+	account common.Address,
+) (*big.Int, error) {
+	args := m.Called(nil, account)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+type MockChain struct {
+	mock.Mock
+}
+
+func (m *MockChain) PendingNonceAt(
+	_ context.Context,
+	account common.Address,
+) (uint64, error) {
+	args := m.Called(account)
+	return (args.Get(0)).(uint64), args.Error(1)
+}
+
+func (m *MockChain) BalanceAt(
+	_ context.Context,
+	account common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	args := m.Called(account, blockNumber)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockChain) TransactionByHash(
+	_ context.Context,
+	hash common.Hash,
+) (*types.Transaction, bool, error) {
+	args := m.Called(hash)
+	return (args.Get(0)).(*types.Transaction), args.Bool(1), args.Error(2)
+}
+
+func (m *MockChain) TransactionReceipt(
+	_ context.Context,
+	txHash common.Hash,
+) (*types.Receipt, error) {
+	args := m.Called(txHash)
+	return (args.Get(0)).(*types.Receipt), args.Error(1)
+}
+
+var (
+	sender     = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	token      = common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	recipient1 = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	recipient2 = common.HexToAddress("0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69")
+	recipient3 = common.HexToAddress("0x1efF47bc3a10a45D4B230B5d10E37751FE6AA718")
+)
+
+// writeFile writes the content to a file of the test directory
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+// newTransfers creates pending transfers of 1, 2 and 3 tokens
+func newTransfers() []*Transfer {
+	transfers := []*Transfer{}
+	for i, recipient := range []common.Address{recipient1, recipient2,
+		recipient3} {
+		transfers = append(transfers, &Transfer{
+			Line:      i + 1,
+			Recipient: recipient,
+			Amount:    big.NewInt(int64(i + 1)),
+			Status:    StatusPending,
+		})
+	}
+	return transfers
+}
+
+// newTestAirdrop creates an airdrop of the transfers whose state is
+// written to the test directory
+func newTestAirdrop(
+	t *testing.T,
+	statePath string,
+	transfers []*Transfer,
+) (*Airdrop, *MockToken, *MockChain) {
+	mToken, mChain := new(MockToken), new(MockChain)
+	auth := &bind.TransactOpts{
+		From:     sender,
+		GasLimit: 100000,
+		GasPrice: big.NewInt(10),
+		Signer: func(_ common.Address, tx *types.Transaction) (
+			*types.Transaction, error) {
+			return tx, nil
+		},
+	}
+	drop, err := NewAirdrop(mToken, mChain, auth, token, transfers,
+		statePath, 2)
+	assert.NoError(t, err)
+	drop.PollInterval = time.Millisecond
+	drop.ReceiptTimeout = 50 * time.Millisecond
+	return drop, mToken, mChain
+}
+
+// withNonce matches the transact options sending the given nonce
+func withNonce(nonce uint64) interface{} {
+	return mock.MatchedBy(func(opts *bind.TransactOpts) bool {
+		return opts.Nonce.Uint64() == nonce
+	})
+}
+
+// newTx creates a transaction whose hash depends on the nonce
+func newTx(nonce uint64) *types.Transaction {
+	return types.NewTransaction(nonce, token, big.NewInt(0), 100000,
+		big.NewInt(10), nil)
+}
+
+func TestReadTransfers(t *testing.T) {
+	parseAmount := func(amount string) (*big.Int, error) {
+		return utils.ParseTokenAmount(amount, 18, "MST")
+	}
+	argParser := &utils.ArgParser{}
+	tests := []struct {
+		testName          string
+		content           string
+		expectedTransfers []*Transfer
+		expectedError     error
+	}{
+		{
+			testName: "ReadTransfers successful with header and comments.",
+			content: "address,amount\n" +
+				"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF, 1.5\n" +
+				"\n# team\n" +
+				"0x6813eb9362372eef6200f3b1dbc3f819671cba69,250 MST\n",
+			expectedTransfers: []*Transfer{
+				{
+					Line:      2,
+					Recipient: recipient1,
+					Amount:    big.NewInt(1500000000000000000),
+					Status:    StatusPending,
+				},
+				{
+					Line:      5,
+					Recipient: recipient2,
+					Amount: new(big.Int).Mul(big.NewInt(250),
+						big.NewInt(1000000000000000000)),
+					Status: StatusPending,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName: "ReadTransfers fail reports every invalid row.",
+			content: "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF,1\n" +
+				"0x2b5AD5c4795c026514f8317c7a215E218DcCD6cF,1\n" +
+				"0x0000000000000000000000000000000000000000,1\n" +
+				"0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69,0\n" +
+				"0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69\n",
+			expectedError: errors.New("error: 4 invalid rows, nothing was " +
+				"sent:\nerror: address 0x2b5AD5c4795c026514f8317c7a215E218DcCD6cF " +
+				"has an invalid EIP-55 checksum, expected " +
+				"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF (FILE line 2)\n" +
+				"error: refusing to use the zero address as recipient, use " +
+				"--force to override (FILE line 3)\n" +
+				"error: amount \"0\" must be positive (FILE line 4)\n" +
+				"error: expected address,amount but found 1 fields (FILE line 5)"),
+		},
+		{
+			testName:      "ReadTransfers fail without transfers.",
+			content:       "address,amount\n# nothing yet\n",
+			expectedError: errors.New("error: no transfers found in FILE"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			path := writeFile(t, t.TempDir(), "airdrop.csv", tt.content)
+			transfers, err := ReadTransfers(path, argParser.ParseRecipient,
+				parseAmount)
+			if tt.expectedError != nil {
+				expected := strings.ReplaceAll(tt.expectedError.Error(), "FILE",
+					path)
+				assert.Equal(t, err.Error(), expected)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, transfers, tt.expectedTransfers)
+		})
+	}
+}
+
+func TestAirdropValidate(t *testing.T) {
+	tests := []struct {
+		testName      string
+		tokenBalance  *big.Int
+		nativeBalance *big.Int
+		expectedError error
+	}{
+		{
+			testName:      "Validate successful.",
+			tokenBalance:  big.NewInt(6),
+			nativeBalance: big.NewInt(3000000),
+			expectedError: nil,
+		},
+		{
+			testName:      "Validate fail not enough tokens.",
+			tokenBalance:  big.NewInt(5),
+			nativeBalance: big.NewInt(3000000),
+			expectedError: errors.New("error: the 3 transfers left need " +
+				"0.000000000000000006 MST but the sender " +
+				"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf only holds " +
+				"0.000000000000000005 MST"),
+		},
+		{
+			testName:      "Validate fail not enough gas.",
+			tokenBalance:  big.NewInt(6),
+			nativeBalance: big.NewInt(2999999),
+			expectedError: errors.New("error: the 3 transfers left may cost " +
+				"up to 3000000 wei of gas but the sender " +
+				"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf only holds " +
+				"2999999 wei"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			statePath := filepath.Join(t.TempDir(), "state.json")
+			drop, mToken, mChain := newTestAirdrop(t, statePath, newTransfers())
+			drop.Decimals, drop.Symbol = 18, "MST"
+			mToken.On("BalanceOf", nil, sender).Return(tt.tokenBalance, nil)
+			mChain.On("BalanceAt", sender, (*big.Int)(nil)).Return(
+				tt.nativeBalance, nil)
+			err := drop.Validate(context.Background())
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAirdropRun(t *testing.T) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.json")
+	drop, mToken, mChain := newTestAirdrop(t, statePath, newTransfers())
+	mChain.On("PendingNonceAt", sender).Return(uint64(7), nil).Once()
+	mChain.On("PendingNonceAt", sender).Return(uint64(8), nil)
+	mToken.On("Transfer", withNonce(7), recipient1, big.NewInt(1)).Return(
+		newTx(7), nil)
+	// The second transfer is refused by the node, its nonce is reused
+	mToken.On("Transfer", withNonce(8), recipient2, big.NewInt(2)).Return(
+		(*types.Transaction)(nil), errors.New("insufficient funds")).Once()
+	mChain.On("TransactionByHash", newTx(8).Hash()).Return(
+		(*types.Transaction)(nil), false, ethereum.NotFound).Once()
+	mToken.On("Transfer", withNonce(8), recipient3, big.NewInt(3)).Return(
+		newTx(8), nil)
+	mChain.On("TransactionReceipt", newTx(7).Hash()).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(20),
+	}, nil)
+	mChain.On("TransactionReceipt", newTx(8).Hash()).Return(&types.Receipt{
+		Status:      types.ReceiptStatusFailed,
+		BlockNumber: big.NewInt(21),
+	}, nil)
+
+	err := drop.Run(context.Background())
+	assert.Equal(t, err.Error(), "error: 2 of 3 transfers didn't succeed, "+
+		"run the airdrop again with the same state file to retry them")
+	mChain.AssertNumberOfCalls(t, "PendingNonceAt", 2)
+
+	state, err1 := LoadState(statePath)
+	assert.NoError(t, err1)
+	hash7, hash8 := newTx(7).Hash(), newTx(8).Hash()
+	assert.Equal(t, state.Transfers, []*Transfer{
+		{Line: 1, Recipient: recipient1, Amount: big.NewInt(1),
+			Status: StatusSuccess, TxHash: &hash7, Nonce: 7, Block: 20},
+		{Line: 2, Recipient: recipient2, Amount: big.NewInt(2),
+			Status: StatusError, Error: "insufficient funds"},
+		{Line: 3, Recipient: recipient3, Amount: big.NewInt(3),
+			Status: StatusReverted, TxHash: &hash8, Nonce: 8, Block: 21,
+			Error: "transaction reverted"},
+	})
+
+	resultPath := filepath.Join(dir, "result.csv")
+	assert.NoError(t, state.WriteResults(resultPath))
+	results, err2 := ioutil.ReadFile(resultPath)
+	assert.NoError(t, err2)
+	assert.Equal(t, string(results), "line,address,amount,status,tx_hash,"+
+		"block,error\n"+
+		"1,0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF,1,success,"+hash7.Hex()+
+		",20,\n"+
+		"2,0x6813Eb9362372EEF6200f3b1dbC3f819671cBA69,2,error,,,"+
+		"insufficient funds\n"+
+		"3,0x1efF47bc3a10a45D4B230B5d10E37751FE6AA718,3,reverted,"+hash8.Hex()+
+		",21,transaction reverted\n")
+}
+
+func TestAirdropResume(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	hash1, hash2, hash3 := newTx(1).Hash(), newTx(2).Hash(), newTx(3).Hash()
+	previous := &State{Token: token, Sender: sender, Transfers: newTransfers()}
+	previous.Transfers[0].Status, previous.Transfers[0].TxHash =
+		StatusSuccess, &hash1
+	previous.Transfers[1].Status, previous.Transfers[1].TxHash =
+		StatusSent, &hash2
+	previous.Transfers[2].Status, previous.Transfers[2].TxHash =
+		StatusSent, &hash3
+	previous.Transfers[1].Nonce, previous.Transfers[2].Nonce = 2, 3
+	assert.NoError(t, previous.Save(statePath))
+
+	drop, mToken, mChain := newTestAirdrop(t, statePath, newTransfers())
+	// The second transfer is still known by the node and gets mined while
+	// the third one was dropped and is sent again
+	mChain.On("TransactionByHash", hash2).Return(newTx(2), true, nil)
+	mChain.On("TransactionByHash", hash3).Return((*types.Transaction)(nil),
+		false, ethereum.NotFound)
+	mChain.On("PendingNonceAt", sender).Return(uint64(3), nil)
+	mToken.On("Transfer", withNonce(3), recipient3, big.NewInt(3)).Return(
+		newTx(4), nil)
+	mChain.On("TransactionReceipt", hash2).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(30),
+	}, nil)
+	mChain.On("TransactionReceipt", newTx(4).Hash()).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(31),
+	}, nil)
+
+	err := drop.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, drop.State.count(StatusSuccess), 3)
+	mToken.AssertNumberOfCalls(t, "Transfer", 1)
+	assert.Equal(t, *drop.State.Transfers[2].TxHash, newTx(4).Hash())
+}
+
+func TestAirdropRunSendError(t *testing.T) {
+	tests := []struct {
+		testName       string
+		lookupTx       *types.Transaction
+		lookupError    error
+		expectedError  string
+		expectedStatus string
+	}{
+		{
+			testName:       "Run transfer reached the node despite the error.",
+			lookupTx:       newTx(7),
+			expectedStatus: StatusSuccess,
+		},
+		{
+			testName:    "Run stops when the transfer status is unknown.",
+			lookupError: errors.New("connection refused"),
+			expectedError: "error: the transfer to " + recipient1.Hex() +
+				" (line 1) failed and its status is unknown, the airdrop " +
+				"stopped so that it isn't paid twice, run it again with " +
+				"the same state file to check it: connection reset",
+			expectedStatus: StatusError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			statePath := filepath.Join(t.TempDir(), "state.json")
+			drop, mToken, mChain := newTestAirdrop(t, statePath,
+				newTransfers()[:1])
+			mChain.On("PendingNonceAt", sender).Return(uint64(7), nil)
+			mToken.On("Transfer", withNonce(7), recipient1,
+				big.NewInt(1)).Return((*types.Transaction)(nil),
+				errors.New("connection reset"))
+			mChain.On("TransactionByHash", newTx(7).Hash()).Return(
+				tt.lookupTx, true, tt.lookupError)
+			mChain.On("TransactionReceipt", newTx(7).Hash()).Return(
+				&types.Receipt{Status: types.ReceiptStatusSuccessful,
+					BlockNumber: big.NewInt(20)}, nil)
+
+			err := drop.Run(context.Background())
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			mToken.AssertNumberOfCalls(t, "Transfer", 1)
+			state, err1 := LoadState(statePath)
+			assert.NoError(t, err1)
+			// The hash saved before the broadcast is kept
+			hash7 := newTx(7).Hash()
+			assert.Equal(t, tt.expectedStatus, state.Transfers[0].Status)
+			assert.Equal(t, &hash7, state.Transfers[0].TxHash)
+			assert.Equal(t, uint64(7), state.Transfers[0].Nonce)
+		})
+	}
+}
+
+func TestAirdropResumeUsedNonce(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	hash1, hash2 := newTx(1).Hash(), newTx(2).Hash()
+	previous := &State{Token: token, Sender: sender, Transfers: newTransfers()}
+	// The first transfer failed with an unknown status and the second one
+	// was interrupted after it was signed
+	previous.Transfers[0].Status, previous.Transfers[0].TxHash,
+		previous.Transfers[0].Nonce = StatusError, &hash1, 1
+	previous.Transfers[1].Status, previous.Transfers[1].TxHash,
+		previous.Transfers[1].Nonce = StatusSent, &hash2, 2
+	previous.Transfers[2].Status = StatusSuccess
+	assert.NoError(t, previous.Save(statePath))
+
+	drop, mToken, mChain := newTestAirdrop(t, statePath, newTransfers())
+	// The first transfer did reach the node while the nonce of the second
+	// one was used by another transaction
+	mChain.On("TransactionByHash", hash1).Return(newTx(1), false, nil)
+	mChain.On("TransactionByHash", hash2).Return((*types.Transaction)(nil),
+		false, ethereum.NotFound)
+	mChain.On("PendingNonceAt", sender).Return(uint64(5), nil)
+	mChain.On("TransactionReceipt", hash1).Return(&types.Receipt{
+		Status:      types.ReceiptStatusSuccessful,
+		BlockNumber: big.NewInt(30),
+	}, nil)
+
+	err := drop.Run(context.Background())
+	assert.EqualError(t, err, "error: 1 of 3 transfers didn't succeed, run "+
+		"the airdrop again with the same state file to retry them")
+	mToken.AssertNotCalled(t, "Transfer", mock.Anything, mock.Anything,
+		mock.Anything)
+	assert.Equal(t, StatusSuccess, drop.State.Transfers[0].Status)
+	assert.Equal(t, StatusError, drop.State.Transfers[1].Status)
+	assert.Equal(t, &hash2, drop.State.Transfers[1].TxHash)
+	assert.Equal(t, "nonce 2 was used by another transaction, check whether "+
+		"the recipient was paid before removing the transfer hash from the "+
+		"state file", drop.State.Transfers[1].Error)
+}
+
+func TestNewAirdropStateMismatch(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	previous := &State{Token: token, Sender: sender, Transfers: newTransfers()}
+	previous.Transfers[1].Amount = big.NewInt(5)
+	assert.NoError(t, previous.Save(statePath))
+	_, err := NewAirdrop(new(MockToken), new(MockChain),
+		&bind.TransactOpts{From: sender}, token, newTransfers(), statePath, 0)
+	assert.Equal(t, err.Error(), "error: line 2 of the airdrop file changed "+
+		"since the state file was written, remove "+statePath+" or pass "+
+		"another state file to start a new airdrop")
+	_, err1 := os.Stat(statePath + ".tmp")
+	assert.True(t, os.IsNotExist(err1))
+}
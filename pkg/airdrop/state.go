@@ -0,0 +1,148 @@
+package airdrop
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+)
+
+// Status of a transfer in the state and result files
+const (
+	StatusPending  = "pending"
+	StatusSent     = "sent"
+	StatusSuccess  = "success"
+	StatusReverted = "reverted"
+	StatusError    = "error"
+)
+
+// Transfer is a row of the airdrop file together with the progress of its
+// transaction
+type Transfer struct {
+	Line      int            `json:"line"`
+	Recipient common.Address `json:"recipient"`
+	Amount    *big.Int       `json:"amount"`
+	Status    string         `json:"status"`
+	TxHash    *common.Hash   `json:"txHash,omitempty"`
+	Nonce     uint64         `json:"nonce,omitempty"`
+	Block     uint64         `json:"block,omitempty"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// State is the progress of an airdrop written to the state file, it is
+// read back to resume the airdrop without sending a transfer twice
+type State struct {
+	Token     common.Address `json:"token"`
+	Sender    common.Address `json:"sender"`
+	Transfers []*Transfer    `json:"transfers"`
+}
+
+// LoadState reads the state file, a missing file returns a nil state
+func LoadState(path string) (*State, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read state file: %v", err)
+	}
+	state := &State{}
+	err1 := json.Unmarshal(data, state)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: state file %s is corrupted: %v", path,
+			err1)
+	}
+	return state, nil
+}
+
+// Save writes the state to a temporary file which then replaces the state
+// file, so that an interruption never leaves a partially written state
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error: failed to encode the airdrop state: %v", err)
+	}
+	tmpPath := path + ".tmp"
+	err1 := ioutil.WriteFile(tmpPath, data, 0644)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to write state file: %v", err1)
+	}
+	err2 := os.Rename(tmpPath, path)
+	if err2 != nil {
+		return fmt.Errorf("error: failed to write state file: %v", err2)
+	}
+	return nil
+}
+
+// WriteResults writes one row per transfer with its transaction hash and
+// status to a CSV file
+func (s *State) WriteResults(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error: failed to create result file: %v", err)
+	}
+	defer file.Close()
+	rows := [][]string{{"line", "address", "amount", "status", "tx_hash",
+		"block", "error"}}
+	for _, transfer := range s.Transfers {
+		hash, block := "", ""
+		if transfer.TxHash != nil {
+			hash = transfer.TxHash.Hex()
+		}
+		if transfer.Block != 0 {
+			block = strconv.FormatUint(transfer.Block, 10)
+		}
+		rows = append(rows, []string{
+			strconv.Itoa(transfer.Line),
+			transfer.Recipient.Hex(),
+			transfer.Amount.String(),
+			transfer.Status,
+			hash,
+			block,
+			transfer.Error,
+		})
+	}
+	err1 := csv.NewWriter(file).WriteAll(rows)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to write results: %v", err1)
+	}
+	return nil
+}
+
+// matches checks that the state was recorded for the same airdrop, the
+// transfers are compared by line, recipient and amount
+func (s *State) matches(other *State) error {
+	if s.Token != other.Token || s.Sender != other.Sender {
+		return fmt.Errorf("error: the state file was written for the token "+
+			"%s sent by %s", s.Token.Hex(), s.Sender.Hex())
+	}
+	if len(s.Transfers) != len(other.Transfers) {
+		return fmt.Errorf("error: the state file holds %d transfers instead "+
+			"of %d", len(s.Transfers), len(other.Transfers))
+	}
+	for i, transfer := range s.Transfers {
+		expected := other.Transfers[i]
+		if transfer.Line != expected.Line ||
+			transfer.Recipient != expected.Recipient ||
+			transfer.Amount.Cmp(expected.Amount) != 0 {
+			return fmt.Errorf("error: line %d of the airdrop file changed "+
+				"since the state file was written", expected.Line)
+		}
+	}
+	return nil
+}
+
+// count returns the number of transfers with the given status
+func (s *State) count(status string) int {
+	count := 0
+	for _, transfer := range s.Transfers {
+		if transfer.Status == status {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,223 @@
+package audit_log
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	abidec "go-evm-client/pkg/abi_decoder"
+	ci "go-evm-client/pkg/chain_inspector"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Events recorded in the audit log
+const (
+	SignedEvent  = "signed"
+	ReceiptEvent = "receipt"
+)
+
+// Status of a transaction in the history
+const (
+	StatusPending  = ci.StatusPending
+	StatusSuccess  = ci.StatusSuccess
+	StatusReverted = ci.StatusReverted
+)
+
+// Record is a line of the audit log. A signed record is written when a
+// transaction is signed, a receipt record repeats it with the outcome of
+// the transaction once it is mined. Time is always the time of signing.
+// Amounts are in wei.
+type Record struct {
+	Event                string            `json:"event"`
+	Time                 time.Time         `json:"time"`
+	Network              string            `json:"network"`
+	ChainId              uint64            `json:"chainId"`
+	From                 common.Address    `json:"from"`
+	Nonce                uint64            `json:"nonce"`
+	To                   *common.Address   `json:"to"`
+	Function             string            `json:"function,omitempty"`
+	Args                 []abidec.Argument `json:"args,omitempty"`
+	Input                string            `json:"input,omitempty"`
+	Value                string            `json:"value"`
+	GasLimit             uint64            `json:"gasLimit"`
+	GasPrice             string            `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string            `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string            `json:"maxPriorityFeePerGas,omitempty"`
+	TxHash               common.Hash       `json:"txHash"`
+	Status               string            `json:"status,omitempty"`
+	BlockNumber          uint64            `json:"blockNumber,omitempty"`
+	GasUsed              uint64            `json:"gasUsed,omitempty"`
+	Fee                  string            `json:"fee,omitempty"`
+	ContractAddress      *common.Address   `json:"contractAddress,omitempty"`
+	ReceiptTime          *time.Time        `json:"receiptTime,omitempty"`
+}
+
+// NewRecord describes a signed transaction of the account. The function
+// and its arguments are decoded with the decoder, the raw input is kept
+// for unknown functions.
+func NewRecord(
+	tx *types.Transaction,
+	from common.Address,
+	network string,
+	decoder *abidec.Decoder,
+	now time.Time,
+) *Record {
+	record := &Record{
+		Event:    SignedEvent,
+		Time:     now.UTC(),
+		Network:  network,
+		ChainId:  tx.ChainId().Uint64(),
+		From:     from,
+		Nonce:    tx.Nonce(),
+		To:       tx.To(),
+		Value:    tx.Value().String(),
+		GasLimit: tx.Gas(),
+		TxHash:   tx.Hash(),
+		Status:   StatusPending,
+	}
+	if tx.Type() == types.DynamicFeeTxType {
+		record.MaxFeePerGas = tx.GasFeeCap().String()
+		record.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	} else {
+		record.GasPrice = tx.GasPrice().String()
+	}
+	if tx.To() == nil || len(tx.Data()) == 0 {
+		return record
+	}
+	call, err := decoder.DecodeCall(tx.Data())
+	if err != nil {
+		record.Input = hexutil.Encode(tx.Data())
+		return record
+	}
+	record.Function = call.Signature
+	record.Args = call.Args
+	return record
+}
+
+// WithReceipt returns a receipt record repeating the signed record with
+// the outcome of the mined transaction described by the report
+func (r *Record) WithReceipt(
+	report *ci.TransactionReport,
+	now time.Time,
+) *Record {
+	outcome := *r
+	receiptTime := now.UTC()
+	outcome.Event = ReceiptEvent
+	outcome.ReceiptTime = &receiptTime
+	outcome.Status = report.Status
+	if report.BlockNumber != nil {
+		outcome.BlockNumber = *report.BlockNumber
+	}
+	if report.GasUsed != nil {
+		outcome.GasUsed = *report.GasUsed
+	}
+	outcome.Fee = report.Fee
+	if len(report.ContractAddress) != 0 {
+		address := common.HexToAddress(report.ContractAddress)
+		outcome.ContractAddress = &address
+	}
+	return &outcome
+}
+
+// AuditLog appends records to a JSON lines file, records are only ever
+// appended so that the file keeps every transaction sent
+type AuditLog struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewAuditLog creates the log appending to the file at the path, the file
+// is created with the first record
+func NewAuditLog(path string) *AuditLog {
+	return &AuditLog{path: path}
+}
+
+// Path returns the path of the log file
+func (l *AuditLog) Path() string {
+	return l.path
+}
+
+// Append writes a record as a single line at the end of the file
+func (l *AuditLog) Append(record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error: failed to encode the audit record: %v", err)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	file, err1 := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0644)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to open the audit log: %v", err1)
+	}
+	defer file.Close()
+	_, err2 := file.Write(append(line, '\n'))
+	if err2 != nil {
+		return fmt.Errorf("error: failed to write the audit log: %v", err2)
+	}
+	return nil
+}
+
+// ReadRecords reads every record of the log in the order they were
+// written, a missing file has no record
+func ReadRecords(path string) ([]*Record, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to open the audit log: %v", err)
+	}
+	defer file.Close()
+	return readRecords(file, path)
+}
+
+// readRecords decodes one record per line, empty lines are skipped
+func readRecords(reader io.Reader, path string) ([]*Record, error) {
+	var records []*Record
+	scanner := bufio.NewScanner(reader)
+	// Records holding long calldata exceed the default line limit
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &Record{}
+		err := json.Unmarshal(scanner.Bytes(), record)
+		if err != nil {
+			return nil, fmt.Errorf("error: line %d of the audit log %s is "+
+				"corrupted: %v", line, path, err)
+		}
+		records = append(records, record)
+	}
+	err1 := scanner.Err()
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to read the audit log: %v", err1)
+	}
+	return records, nil
+}
+
+// History merges the records into one entry per transaction in the order
+// they were signed, the latest receipt record of a transaction replaces
+// its signed record
+func History(records []*Record) []*Record {
+	var history []*Record
+	positions := map[common.Hash]int{}
+	for _, record := range records {
+		position, ok := positions[record.TxHash]
+		if !ok {
+			positions[record.TxHash] = len(history)
+			history = append(history, record)
+			continue
+		}
+		if record.Event == ReceiptEvent {
+			history[position] = record
+		}
+	}
+	return history
+}
@@ -0,0 +1,225 @@
+package audit_log
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	abidec "go-evm-client/pkg/abi_decoder"
+	ci "go-evm-client/pkg/chain_inspector"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	sender   = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	friend   = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	token    = common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	chainId  = big.NewInt(1337)
+	signTime = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+)
+
+const transferABI = `[{"type":"function","name":"transfer","inputs":[
+	{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+	"outputs":[{"name":"","type":"bool"}]}]`
+
+// newTestDecoder creates a decoder knowing the ERC20 transfer function
+func newTestDecoder(t *testing.T) *abidec.Decoder {
+	parsed, err := abi.JSON(strings.NewReader(transferABI))
+	assert.NoError(t, err)
+	decoder := abidec.NewDecoder()
+	decoder.AddABI("ERC20", parsed)
+	return decoder
+}
+
+// transferTx builds a dynamic fee transfer of the test token
+func transferTx(nonce uint64, amount int64) *types.Transaction {
+	parsed, _ := abi.JSON(strings.NewReader(transferABI))
+	data, _ := parsed.Pack("transfer", friend, big.NewInt(amount))
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainId,
+		Nonce:     nonce,
+		To:        &token,
+		Gas:       60000,
+		GasFeeCap: big.NewInt(2000000000),
+		GasTipCap: big.NewInt(1000000000),
+		Value:     new(big.Int),
+		Data:      data,
+	})
+}
+
+// tempLog creates an audit log in a temporary directory
+func tempLog(t *testing.T) (*AuditLog, func()) {
+	dir, err := ioutil.TempDir("", "audit")
+	assert.NoError(t, err)
+	return NewAuditLog(filepath.Join(dir, "audit_log.jsonl")),
+		func() { os.RemoveAll(dir) }
+}
+
+func TestNewRecord(t *testing.T) {
+	decoder := newTestDecoder(t)
+	record := NewRecord(transferTx(7, 500), sender, "http://127.0.0.1:8545",
+		decoder, signTime)
+	assert.Equal(t, SignedEvent, record.Event)
+	assert.Equal(t, uint64(1337), record.ChainId)
+	assert.Equal(t, uint64(7), record.Nonce)
+	assert.Equal(t, "transfer(address,uint256)", record.Function)
+	assert.Equal(t, []abidec.Argument{
+		{Name: "recipient", Type: "address", Value: friend.Hex()},
+		{Name: "amount", Type: "uint256", Value: "500"},
+	}, record.Args)
+	assert.Equal(t, "2000000000", record.MaxFeePerGas)
+	assert.Equal(t, "1000000000", record.MaxPriorityFeePerGas)
+	assert.Empty(t, record.GasPrice)
+	assert.Equal(t, StatusPending, record.Status)
+
+	// Unknown functions keep their raw calldata
+	unknown := types.NewTx(&types.LegacyTx{To: &token, Gas: 50000,
+		GasPrice: big.NewInt(1), Value: new(big.Int),
+		Data: []byte{1, 2, 3, 4}})
+	record1 := NewRecord(unknown, sender, "", decoder, signTime)
+	assert.Empty(t, record1.Function)
+	assert.Equal(t, "0x01020304", record1.Input)
+	assert.Equal(t, "1", record1.GasPrice)
+}
+
+func TestHistory(t *testing.T) {
+	log, cleanup := tempLog(t)
+	defer cleanup()
+	recorder := NewRecorder(log, "http://127.0.0.1:8545", newTestDecoder(t))
+	recorder.Now = func() time.Time { return signTime }
+	signer := recorder.WrapSigner(func(from common.Address,
+		tx *types.Transaction) (*types.Transaction, error) {
+		if tx.Nonce() == 2 {
+			return nil, fmt.Errorf("error: signing refused")
+		}
+		return tx, nil
+	})
+	first, second := transferTx(0, 100), transferTx(1, 200)
+	_, err := signer(sender, first)
+	assert.NoError(t, err)
+	_, err1 := signer(sender, second)
+	assert.NoError(t, err1)
+	// Transactions which fail to be signed aren't recorded
+	_, err2 := signer(sender, transferTx(2, 300))
+	assert.EqualError(t, err2, "error: signing refused")
+
+	records, err3 := ReadRecords(log.Path())
+	assert.NoError(t, err3)
+	assert.Len(t, records, 2)
+	blockNumber, gasUsed := uint64(12), uint64(35000)
+	receipt := records[0].WithReceipt(&ci.TransactionReport{
+		Status:      ci.StatusReverted,
+		BlockNumber: &blockNumber,
+		GasUsed:     &gasUsed,
+		Fee:         "52500000000000",
+	}, signTime.Add(time.Minute))
+	assert.NoError(t, log.Append(receipt))
+
+	records1, err4 := ReadRecords(log.Path())
+	assert.NoError(t, err4)
+	assert.Len(t, records1, 3)
+	history := History(records1)
+	assert.Len(t, history, 2)
+	assert.Equal(t, first.Hash(), history[0].TxHash)
+	assert.Equal(t, StatusReverted, history[0].Status)
+	assert.Equal(t, uint64(12), history[0].BlockNumber)
+	assert.Equal(t, "52500000000000", history[0].Fee)
+	assert.Equal(t, signTime, history[0].Time)
+	assert.Equal(t, signTime.Add(time.Minute), *history[0].ReceiptTime)
+	assert.Equal(t, second.Hash(), history[1].TxHash)
+	assert.Equal(t, StatusPending, history[1].Status)
+}
+
+func TestReadRecordsCorrupted(t *testing.T) {
+	log, cleanup := tempLog(t)
+	defer cleanup()
+	records, err := ReadRecords(log.Path())
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+
+	assert.NoError(t, log.Append(NewRecord(transferTx(0, 1), sender, "",
+		newTestDecoder(t), signTime)))
+	file, err1 := os.OpenFile(log.Path(), os.O_APPEND|os.O_WRONLY, 0644)
+	assert.NoError(t, err1)
+	_, err2 := file.WriteString("\n{\"event\": \n")
+	assert.NoError(t, err2)
+	file.Close()
+	_, err3 := ReadRecords(log.Path())
+	assert.EqualError(t, err3, "error: line 3 of the audit log "+log.Path()+
+		" is corrupted: unexpected end of JSON input")
+}
+
+func TestFilter(t *testing.T) {
+	decoder := newTestDecoder(t)
+	transfer := NewRecord(transferTx(0, 100), sender, "", decoder, signTime)
+	mined := transfer.WithReceipt(&ci.TransactionReport{
+		Status: ci.StatusSuccess}, signTime)
+	native := NewRecord(types.NewTx(&types.LegacyTx{Nonce: 1, To: &friend,
+		Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(5)}), friend, "",
+		decoder, signTime.Add(48*time.Hour))
+	history := []*Record{mined, native}
+
+	tests := []struct {
+		testName string
+		filter   Filter
+		expected []*Record
+	}{
+		{
+			testName: "No filter",
+			expected: []*Record{mined, native},
+		},
+		{
+			testName: "Sender",
+			filter:   Filter{From: &sender},
+			expected: []*Record{mined},
+		},
+		{
+			testName: "Recipient",
+			filter:   Filter{To: &friend},
+			expected: []*Record{native},
+		},
+		{
+			testName: "Function name in any case",
+			filter:   Filter{Function: "Transfer"},
+			expected: []*Record{mined},
+		},
+		{
+			testName: "Function signature",
+			filter:   Filter{Function: "transfer(address,uint256)"},
+			expected: []*Record{mined},
+		},
+		{
+			testName: "Status",
+			filter:   Filter{Status: StatusPending},
+			expected: []*Record{native},
+		},
+		{
+			testName: "Other chain",
+			filter:   Filter{ChainId: 1},
+			expected: []*Record{},
+		},
+		{
+			testName: "Time range",
+			filter: Filter{Since: signTime.Add(time.Hour),
+				Until: signTime.Add(72 * time.Hour)},
+			expected: []*Record{native},
+		},
+		{
+			testName: "Latest entries",
+			filter:   Filter{Limit: 1},
+			expected: []*Record{native},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.filter.Apply(history))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package audit_log
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	abidec "go-evm-client/pkg/abi_decoder"
+	"strings"
+	"time"
+)
+
+// Filter selects the entries of the history, zero fields match any entry
+type Filter struct {
+	From    *common.Address
+	To      *common.Address
+	ChainId uint64
+	// Function matches the name or the full signature of the function
+	// called, case insensitively
+	Function string
+	Status   string
+	Since    time.Time
+	Until    time.Time
+	// Limit keeps only the latest entries
+	Limit int
+}
+
+// Matches tells whether an entry of the history is selected
+func (f *Filter) Matches(record *Record) bool {
+	if f.From != nil && record.From != *f.From {
+		return false
+	}
+	if f.To != nil && (record.To == nil || *record.To != *f.To) {
+		return false
+	}
+	if f.ChainId != 0 && record.ChainId != f.ChainId {
+		return false
+	}
+	if len(f.Function) != 0 {
+		name := strings.SplitN(record.Function, "(", 2)[0]
+		if !strings.EqualFold(f.Function, name) &&
+			!strings.EqualFold(f.Function, record.Function) {
+			return false
+		}
+	}
+	if len(f.Status) != 0 && record.Status != f.Status {
+		return false
+	}
+	if !f.Since.IsZero() && record.Time.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && record.Time.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the entries of the history selected by the filter
+func (f *Filter) Apply(history []*Record) []*Record {
+	selected := []*Record{}
+	for _, record := range history {
+		if f.Matches(record) {
+			selected = append(selected, record)
+		}
+	}
+	if f.Limit > 0 && len(selected) > f.Limit {
+		selected = selected[len(selected)-f.Limit:]
+	}
+	return selected
+}
+
+// Text formats an entry of the history for the terminal
+func (r *Record) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Transaction %s\n", r.TxHash.Hex())
+	fmt.Fprintf(&b, "  Signed:               %s\n", r.Time.Format(time.RFC3339))
+	fmt.Fprintf(&b, "  Network:              %s (chain %d)\n", r.Network,
+		r.ChainId)
+	fmt.Fprintf(&b, "  Status:               %s\n", r.Status)
+	if r.Event == ReceiptEvent {
+		fmt.Fprintf(&b, "  Block:                %d\n", r.BlockNumber)
+	}
+	fmt.Fprintf(&b, "  From:                 %s\n", r.From.Hex())
+	if r.To != nil {
+		fmt.Fprintf(&b, "  To:                   %s\n", r.To.Hex())
+	}
+	if r.ContractAddress != nil {
+		fmt.Fprintf(&b, "  Contract created:     %s\n",
+			r.ContractAddress.Hex())
+	}
+	fmt.Fprintf(&b, "  Nonce:                %d\n", r.Nonce)
+	fmt.Fprintf(&b, "  Value:                %s wei\n", r.Value)
+	fmt.Fprintf(&b, "  Gas limit:            %d\n", r.GasLimit)
+	if len(r.GasPrice) != 0 {
+		fmt.Fprintf(&b, "  Gas price:            %s wei\n", r.GasPrice)
+	}
+	if len(r.MaxFeePerGas) != 0 {
+		fmt.Fprintf(&b, "  Max fee per gas:      %s wei\n", r.MaxFeePerGas)
+		fmt.Fprintf(&b, "  Max priority fee:     %s wei\n",
+			r.MaxPriorityFeePerGas)
+	}
+	if r.Event == ReceiptEvent {
+		fmt.Fprintf(&b, "  Gas used:             %d\n", r.GasUsed)
+	}
+	if len(r.Fee) != 0 {
+		fmt.Fprintf(&b, "  Fee:                  %s wei\n", r.Fee)
+	}
+	switch {
+	case len(r.Function) != 0:
+		fmt.Fprintf(&b, "  Call:                 %s\n", r.Function)
+		abidec.WriteArgs(&b, "    ", r.Args)
+	case len(r.Input) != 0:
+		fmt.Fprintf(&b, "  Call:                 unknown\n")
+		fmt.Fprintf(&b, "  Input:                %s\n", r.Input)
+	case r.To == nil:
+		fmt.Fprintf(&b, "  Call:                 contract creation\n")
+	default:
+		fmt.Fprintf(&b, "  Call:                 native transfer\n")
+	}
+	return b.String()
+}
+
+// HistoryText formats the entries of the history for the terminal
+func HistoryText(history []*Record) string {
+	if len(history) == 0 {
+		return "No transaction in the history\n"
+	}
+	texts := make([]string, len(history))
+	for i, record := range history {
+		texts[i] = record.Text()
+	}
+	return strings.Join(texts, "\n")
+}
@@ -0,0 +1,56 @@
+package audit_log
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	abidec "go-evm-client/pkg/abi_decoder"
+	"time"
+)
+
+// Recorder writes the transactions signed on a network to the audit log
+type Recorder struct {
+	log     *AuditLog
+	network string
+	decoder *abidec.Decoder
+	// Now returns the current time, records are timestamped in UTC
+	Now func() time.Time
+}
+
+// NewRecorder creates the recorder of the transactions sent to the
+// network, their calldata is decoded with the decoder
+func NewRecorder(
+	log *AuditLog,
+	network string,
+	decoder *abidec.Decoder,
+) *Recorder {
+	return &Recorder{
+		log:     log,
+		network: network,
+		decoder: decoder,
+		Now:     time.Now,
+	}
+}
+
+// Record appends the signed transaction of the account to the log
+func (r *Recorder) Record(from common.Address, tx *types.Transaction) error {
+	return r.log.Append(NewRecord(tx, from, r.network, r.decoder, r.Now()))
+}
+
+// WrapSigner returns a signer which records every transaction signed by
+// the given signer. A transaction which can't be recorded isn't returned,
+// so that no transaction is sent without being in the log.
+func (r *Recorder) WrapSigner(signer bind.SignerFn) bind.SignerFn {
+	return func(from common.Address, tx *types.Transaction) (
+		*types.Transaction, error) {
+		signedTx, err := signer(from, tx)
+		if err != nil {
+			return nil, err
+		}
+		err1 := r.Record(from, signedTx)
+		if err1 != nil {
+			return nil, err1
+		}
+		return signedTx, nil
+	}
+}
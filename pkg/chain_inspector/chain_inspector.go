@@ -0,0 +1,353 @@
+package chain_inspector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	abidec "go-evm-client/pkg/abi_decoder"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"os"
+)
+
+// Statuses of an inspected transaction
+const (
+	StatusPending  = "pending"
+	StatusSuccess  = "success"
+	StatusReverted = "reverted"
+)
+
+// IClient is what the inspector needs from the RPC client
+type IClient interface {
+	GetTransaction(
+		ctx context.Context,
+		hash common.Hash,
+	) (*ethrpc.RpcTransaction, error)
+	GetReceipt(ctx context.Context, hash common.Hash) (*ethrpc.RpcReceipt,
+		error)
+	GetBlock(
+		ctx context.Context,
+		block string,
+		fullTransactions bool,
+	) (*ethrpc.RpcBlock, error)
+	BatchTransactionReceipt(
+		ctx context.Context,
+		hashes []common.Hash,
+		batchSize int,
+	) ([]*types.Receipt, []error, error)
+}
+
+// LogReport is a log emitted by a transaction, decoded when its event is
+// known
+type LogReport struct {
+	Index      uint          `json:"logIndex"`
+	Address    string        `json:"address"`
+	Topics     []string      `json:"topics"`
+	Data       string        `json:"data"`
+	Event      *abidec.Event `json:"event,omitempty"`
+	EventError string        `json:"eventError,omitempty"`
+}
+
+// TransactionReport describes a transaction, its decoded calldata and,
+// once it is mined, its receipt and decoded logs. Amounts are in wei.
+type TransactionReport struct {
+	Hash                 string       `json:"hash"`
+	Status               string       `json:"status"`
+	BlockNumber          *uint64      `json:"blockNumber,omitempty"`
+	BlockHash            string       `json:"blockHash,omitempty"`
+	TransactionIndex     *uint64      `json:"transactionIndex,omitempty"`
+	Timestamp            *uint64      `json:"timestamp,omitempty"`
+	From                 string       `json:"from"`
+	To                   string       `json:"to,omitempty"`
+	ContractAddress      string       `json:"contractAddress,omitempty"`
+	Nonce                uint64       `json:"nonce"`
+	Value                string       `json:"value"`
+	Type                 uint64       `json:"type"`
+	GasLimit             uint64       `json:"gasLimit"`
+	GasPrice             string       `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string       `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string       `json:"maxPriorityFeePerGas,omitempty"`
+	EffectiveGasPrice    string       `json:"effectiveGasPrice,omitempty"`
+	GasUsed              *uint64      `json:"gasUsed,omitempty"`
+	Fee                  string       `json:"fee,omitempty"`
+	Input                string       `json:"input"`
+	Call                 *abidec.Call `json:"call,omitempty"`
+	CallError            string       `json:"callError,omitempty"`
+	Logs                 []LogReport  `json:"logs"`
+}
+
+// BlockTransaction summarizes a transaction of a block
+type BlockTransaction struct {
+	Index    int     `json:"index"`
+	Hash     string  `json:"hash"`
+	From     string  `json:"from"`
+	To       string  `json:"to,omitempty"`
+	Nonce    uint64  `json:"nonce"`
+	Value    string  `json:"value"`
+	GasLimit uint64  `json:"gasLimit"`
+	GasUsed  *uint64 `json:"gasUsed,omitempty"`
+	Status   string  `json:"status,omitempty"`
+	Function string  `json:"function"`
+}
+
+// BlockReport describes the header fields, the gas usage and the
+// transactions of a block
+type BlockReport struct {
+	Number         uint64             `json:"number"`
+	Hash           string             `json:"hash"`
+	ParentHash     string             `json:"parentHash"`
+	Timestamp      uint64             `json:"timestamp"`
+	Miner          string             `json:"miner"`
+	GasLimit       uint64             `json:"gasLimit"`
+	GasUsed        uint64             `json:"gasUsed"`
+	GasUsedPercent float64            `json:"gasUsedPercent"`
+	BaseFee        string             `json:"baseFeePerGas,omitempty"`
+	Size           uint64             `json:"size"`
+	Transactions   []BlockTransaction `json:"transactions"`
+}
+
+// Inspector builds the reports of transactions and blocks, decoding the
+// calldata and logs with the ABIs registered in its decoder
+type Inspector struct {
+	client  IClient
+	decoder *abidec.Decoder
+}
+
+// NewInspector creates an inspector reading from the client
+func NewInspector(client IClient, decoder *abidec.Decoder) *Inspector {
+	return &Inspector{client: client, decoder: decoder}
+}
+
+// Transaction builds the report of the transaction of the given hash
+func (i *Inspector) Transaction(
+	ctx context.Context,
+	hash common.Hash,
+) (*TransactionReport, error) {
+	tx, err := i.client.GetTransaction(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, fmt.Errorf("error: transaction %s is unknown to the "+
+				"node", hash.Hex())
+		}
+		return nil, fmt.Errorf("error: failed to retrieve transaction %s: "+
+			"%v", hash.Hex(), err)
+	}
+	report := &TransactionReport{
+		Hash:     tx.Hash.Hex(),
+		Status:   StatusPending,
+		From:     tx.From.Hex(),
+		Nonce:    uint64(tx.Nonce),
+		Value:    bigString(tx.Value),
+		Type:     uint64(tx.Type),
+		GasLimit: uint64(tx.Gas),
+		Input:    hexutil.Encode(tx.Input),
+		Logs:     []LogReport{},
+	}
+	if tx.To != nil {
+		report.To = tx.To.Hex()
+	}
+	if tx.Type == types.DynamicFeeTxType {
+		report.MaxFeePerGas = bigString(tx.GasFeeCap)
+		report.MaxPriorityFeePerGas = bigString(tx.GasTipCap)
+	} else {
+		report.GasPrice = bigString(tx.GasPrice)
+	}
+	if tx.To != nil && len(tx.Input) != 0 {
+		call, err1 := i.decoder.DecodeCall(tx.Input)
+		if err1 != nil {
+			report.CallError = err1.Error()
+		} else {
+			report.Call = call
+		}
+	}
+	if tx.BlockHash == nil {
+		return report, nil
+	}
+
+	receipt, err2 := i.client.GetReceipt(ctx, hash)
+	if err2 != nil {
+		return nil, fmt.Errorf("error: failed to retrieve the receipt of "+
+			"%s: %v", hash.Hex(), err2)
+	}
+	block, err3 := i.client.GetBlock(ctx, receipt.BlockHash.Hex(), false)
+	if err3 != nil {
+		return nil, err3
+	}
+	report.Status = StatusSuccess
+	if receipt.Status != hexutil.Uint64(types.ReceiptStatusSuccessful) {
+		report.Status = StatusReverted
+	}
+	blockNumber := receipt.BlockNumber.ToInt().Uint64()
+	index := uint64(receipt.TransactionIndex)
+	timestamp := uint64(block.Timestamp)
+	gasUsed := uint64(receipt.GasUsed)
+	report.BlockNumber = &blockNumber
+	report.BlockHash = receipt.BlockHash.Hex()
+	report.TransactionIndex = &index
+	report.Timestamp = &timestamp
+	report.GasUsed = &gasUsed
+	if tx.To == nil && receipt.ContractAddress != nil {
+		report.ContractAddress = receipt.ContractAddress.Hex()
+	}
+	effectiveGasPrice := effectiveGasPrice(tx, receipt, block)
+	if effectiveGasPrice != nil {
+		report.EffectiveGasPrice = effectiveGasPrice.String()
+		report.Fee = new(big.Int).Mul(effectiveGasPrice,
+			new(big.Int).SetUint64(gasUsed)).String()
+	}
+	for _, log := range receipt.Logs {
+		report.Logs = append(report.Logs, i.logReport(log))
+	}
+	return report, nil
+}
+
+// effectiveGasPrice is the price paid per gas by a mined transaction, it is
+// computed from the base fee of the block when the node doesn't return it
+func effectiveGasPrice(
+	tx *ethrpc.RpcTransaction,
+	receipt *ethrpc.RpcReceipt,
+	block *ethrpc.RpcBlock,
+) *big.Int {
+	if receipt.EffectiveGasPrice != nil {
+		return receipt.EffectiveGasPrice.ToInt()
+	}
+	if tx.Type == types.DynamicFeeTxType && block.BaseFee != nil &&
+		tx.GasFeeCap != nil && tx.GasTipCap != nil {
+		price := new(big.Int).Add(block.BaseFee.ToInt(), tx.GasTipCap.ToInt())
+		if price.Cmp(tx.GasFeeCap.ToInt()) > 0 {
+			price = tx.GasFeeCap.ToInt()
+		}
+		return price
+	}
+	if tx.GasPrice != nil {
+		return tx.GasPrice.ToInt()
+	}
+	return nil
+}
+
+// logReport decodes a log with the known events
+func (i *Inspector) logReport(log *types.Log) LogReport {
+	report := LogReport{
+		Index:   log.Index,
+		Address: log.Address.Hex(),
+		Topics:  make([]string, len(log.Topics)),
+		Data:    hexutil.Encode(log.Data),
+	}
+	for j, topic := range log.Topics {
+		report.Topics[j] = topic.Hex()
+	}
+	event, err := i.decoder.DecodeLog(log.Topics, log.Data)
+	if err != nil {
+		report.EventError = err.Error()
+	} else {
+		report.Event = event
+	}
+	return report
+}
+
+// Block builds the report of a block given as a number, a hash or a tag.
+// The gas used by every transaction is read from the receipts, which are
+// retrieved in batches of batchSize.
+func (i *Inspector) Block(
+	ctx context.Context,
+	block string,
+	batchSize int,
+) (*BlockReport, error) {
+	rpcBlock, err := i.client.GetBlock(ctx, block, true)
+	if err != nil {
+		return nil, err
+	}
+	if rpcBlock.Number == nil {
+		return nil, fmt.Errorf("error: block %s isn't sealed yet", block)
+	}
+	report := &BlockReport{
+		Number:       rpcBlock.Number.ToInt().Uint64(),
+		Hash:         rpcBlock.Hash.Hex(),
+		ParentHash:   rpcBlock.ParentHash.Hex(),
+		Timestamp:    uint64(rpcBlock.Timestamp),
+		Miner:        rpcBlock.Miner.Hex(),
+		GasLimit:     uint64(rpcBlock.GasLimit),
+		GasUsed:      uint64(rpcBlock.GasUsed),
+		Size:         uint64(rpcBlock.Size),
+		Transactions: make([]BlockTransaction, len(rpcBlock.Transactions)),
+	}
+	if report.GasLimit != 0 {
+		report.GasUsedPercent = float64(report.GasUsed) * 100 /
+			float64(report.GasLimit)
+	}
+	if rpcBlock.BaseFee != nil {
+		report.BaseFee = rpcBlock.BaseFee.ToInt().String()
+	}
+	hashes := make([]common.Hash, len(rpcBlock.Transactions))
+	for j, tx := range rpcBlock.Transactions {
+		hashes[j] = tx.Hash
+		report.Transactions[j] = BlockTransaction{
+			Index:    j,
+			Hash:     tx.Hash.Hex(),
+			From:     tx.From.Hex(),
+			Nonce:    uint64(tx.Nonce),
+			Value:    bigString(tx.Value),
+			GasLimit: uint64(tx.Gas),
+			Function: i.function(tx),
+		}
+		if tx.To != nil {
+			report.Transactions[j].To = tx.To.Hex()
+		}
+	}
+	if len(hashes) == 0 {
+		return report, nil
+	}
+	receipts, errs, err1 := i.client.BatchTransactionReceipt(ctx, hashes,
+		batchSize)
+	if err1 != nil {
+		// The block is still worth showing without the receipts, the
+		// warning goes to stderr to keep the JSON output parsable
+		_, _ = fmt.Fprintf(os.Stderr, "warning: failed to retrieve the "+
+			"receipts of block %d: %v\n", report.Number, err1)
+		return report, nil
+	}
+	for j, receipt := range receipts {
+		if errs[j] != nil || receipt == nil {
+			continue
+		}
+		gasUsed := receipt.GasUsed
+		report.Transactions[j].GasUsed = &gasUsed
+		report.Transactions[j].Status = StatusSuccess
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			report.Transactions[j].Status = StatusReverted
+		}
+	}
+	return report, nil
+}
+
+// function names what a transaction does: a contract creation, a native
+// transfer or the signature of the function called, its selector when the
+// function isn't known
+func (i *Inspector) function(tx ethrpc.RpcTransaction) string {
+	if tx.To == nil {
+		return "contract creation"
+	}
+	if len(tx.Input) == 0 {
+		return "native transfer"
+	}
+	call, err := i.decoder.DecodeCall(tx.Input)
+	if err != nil {
+		if len(tx.Input) < 4 {
+			return hexutil.Encode(tx.Input)
+		}
+		return hexutil.Encode(tx.Input[:4])
+	}
+	return call.Signature
+}
+
+// bigString formats an optional amount in base 10
+func bigString(value *hexutil.Big) string {
+	if value == nil {
+		return "0"
+	}
+	return value.ToInt().String()
+}
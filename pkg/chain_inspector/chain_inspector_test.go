@@ -0,0 +1,429 @@
+package chain_inspector
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	abidec "go-evm-client/pkg/abi_decoder"
+	ethrpc "go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+const tokenAbi = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"recipient","type":"address"},
+	           {"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},
+	           {"name":"to","type":"address","indexed":true},
+	           {"name":"value","type":"uint256","indexed":false}]}
+]`
+
+type MockClient struct {
+	mock.Mock
+}
+
+func (m *MockClient) GetTransaction(
+	_ context.Context,
+	hash common.Hash,
+) (*ethrpc.RpcTransaction, error) {
+	args := m.Called(hash)
+	return (args.Get(0)).(*ethrpc.RpcTransaction), args.Error(1)
+}
+
+func (m *MockClient) GetReceipt(
+	_ context.Context,
+	hash common.Hash,
+) (*ethrpc.RpcReceipt, error) {
+	args := m.Called(hash)
+	return (args.Get(0)).(*ethrpc.RpcReceipt), args.Error(1)
+}
+
+func (m *MockClient) GetBlock(
+	_ context.Context,
+	block string,
+	fullTransactions bool,
+) (*ethrpc.RpcBlock, error) {
+	args := m.Called(block, fullTransactions)
+	return (args.Get(0)).(*ethrpc.RpcBlock), args.Error(1)
+}
+
+func (m *MockClient) BatchTransactionReceipt(
+	_ context.Context,
+	hashes []common.Hash,
+	batchSize int,
+) ([]*types.Receipt, []error, error) {
+	args := m.Called(hashes, batchSize)
+	return (args.Get(0)).([]*types.Receipt), (args.Get(1)).([]error),
+		args.Error(2)
+}
+
+var (
+	sender    = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	recipient = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	token     = common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	txHash    = common.HexToHash("0x01")
+	blockHash = common.HexToHash("0x02")
+)
+
+func newTestInspector(t *testing.T, client IClient) *Inspector {
+	parsed, err := abi.JSON(strings.NewReader(tokenAbi))
+	assert.NoError(t, err)
+	decoder := abidec.NewDecoder()
+	decoder.AddABI("token", parsed)
+	return NewInspector(client, decoder)
+}
+
+func hexBig(value int64) *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(value))
+}
+
+func uint64Ptr(value uint64) *uint64 {
+	return &value
+}
+
+func TestInspectorTransaction(t *testing.T) {
+	parsed, _ := abi.JSON(strings.NewReader(tokenAbi))
+	transferData, _ := parsed.Pack("transfer", recipient, big.NewInt(1000))
+	index := hexutil.Uint64(0)
+	pendingTx := &ethrpc.RpcTransaction{Hash: txHash, From: sender,
+		To: &token, Nonce: 4, Value: hexBig(0), Gas: 60000,
+		GasFeeCap: hexBig(300), GasTipCap: hexBig(20),
+		Type: types.DynamicFeeTxType, Input: transferData}
+	minedTx := *pendingTx
+	minedTx.BlockHash = &blockHash
+	minedTx.BlockNumber = hexBig(9)
+	minedTx.TransactionIndex = &index
+	creationTx := &ethrpc.RpcTransaction{Hash: txHash, From: sender,
+		BlockHash: &blockHash, Nonce: 0, Value: hexBig(0), Gas: 900000,
+		GasPrice: hexBig(50), Input: []byte{0x60, 0x80}}
+	transferLog := &types.Log{Address: token, Index: 2,
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			sender.Hash(), recipient.Hash()},
+		Data: common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)}
+	unknownLog := &types.Log{Address: token, Index: 3,
+		Topics: []common.Hash{common.HexToHash("0x03")}, Data: []byte{1}}
+	tests := []struct {
+		testName       string
+		tx             *ethrpc.RpcTransaction
+		txError        error
+		receipt        *ethrpc.RpcReceipt
+		block          *ethrpc.RpcBlock
+		expectedReport *TransactionReport
+		expectedError  string
+	}{
+		{
+			testName: "Pending transaction with a decoded call",
+			tx:       pendingTx,
+			expectedReport: &TransactionReport{
+				Hash:                 txHash.Hex(),
+				Status:               StatusPending,
+				From:                 sender.Hex(),
+				To:                   token.Hex(),
+				Nonce:                4,
+				Value:                "0",
+				Type:                 types.DynamicFeeTxType,
+				GasLimit:             60000,
+				MaxFeePerGas:         "300",
+				MaxPriorityFeePerGas: "20",
+				Input:                hexutil.Encode(transferData),
+				Call: &abidec.Call{
+					Source:    "token",
+					Signature: "transfer(address,uint256)",
+					Selector:  "0xa9059cbb",
+					Args: []abidec.Argument{
+						{Name: "recipient", Type: "address",
+							Value: recipient.Hex()},
+						{Name: "amount", Type: "uint256", Value: "1000"},
+					},
+				},
+				Logs: []LogReport{},
+			},
+		},
+		{
+			testName: "Mined transaction with its fee computed from the base fee",
+			tx:       &minedTx,
+			receipt: &ethrpc.RpcReceipt{TransactionHash: txHash,
+				BlockHash: blockHash, BlockNumber: hexBig(9), Status: 1,
+				GasUsed: 50000, ContractAddress: &common.Address{},
+				Logs: []*types.Log{transferLog, unknownLog}},
+			block: &ethrpc.RpcBlock{Number: hexBig(9), Hash: blockHash,
+				Timestamp: 1700000000, BaseFee: hexBig(100)},
+			expectedReport: &TransactionReport{
+				Hash:                 txHash.Hex(),
+				Status:               StatusSuccess,
+				BlockNumber:          uint64Ptr(9),
+				BlockHash:            blockHash.Hex(),
+				TransactionIndex:     uint64Ptr(0),
+				Timestamp:            uint64Ptr(1700000000),
+				From:                 sender.Hex(),
+				To:                   token.Hex(),
+				Nonce:                4,
+				Value:                "0",
+				Type:                 types.DynamicFeeTxType,
+				GasLimit:             60000,
+				MaxFeePerGas:         "300",
+				MaxPriorityFeePerGas: "20",
+				EffectiveGasPrice:    "120",
+				GasUsed:              uint64Ptr(50000),
+				Fee:                  "6000000",
+				Input:                hexutil.Encode(transferData),
+				Call: &abidec.Call{
+					Source:    "token",
+					Signature: "transfer(address,uint256)",
+					Selector:  "0xa9059cbb",
+					Args: []abidec.Argument{
+						{Name: "recipient", Type: "address",
+							Value: recipient.Hex()},
+						{Name: "amount", Type: "uint256", Value: "1000"},
+					},
+				},
+				Logs: []LogReport{
+					{
+						Index:   2,
+						Address: token.Hex(),
+						Topics: []string{transferLog.Topics[0].Hex(),
+							transferLog.Topics[1].Hex(),
+							transferLog.Topics[2].Hex()},
+						Data: hexutil.Encode(transferLog.Data),
+						Event: &abidec.Event{
+							Source:    "token",
+							Signature: "Transfer(address,address,uint256)",
+							Topic:     transferLog.Topics[0].Hex(),
+							Args: []abidec.Argument{
+								{Name: "from", Type: "address",
+									Value: sender.Hex(), Indexed: true},
+								{Name: "to", Type: "address",
+									Value: recipient.Hex(), Indexed: true},
+								{Name: "value", Type: "uint256", Value: "1000"},
+							},
+						},
+					},
+					{
+						Index:   3,
+						Address: token.Hex(),
+						Topics:  []string{unknownLog.Topics[0].Hex()},
+						Data:    "0x01",
+						EventError: "error: no known event has the topic " +
+							unknownLog.Topics[0].Hex(),
+					},
+				},
+			},
+		},
+		{
+			testName: "Reverted contract creation",
+			tx:       creationTx,
+			receipt: &ethrpc.RpcReceipt{TransactionHash: txHash,
+				BlockHash: blockHash, BlockNumber: hexBig(9), Status: 0,
+				GasUsed: 800000, EffectiveGasPrice: hexBig(50),
+				ContractAddress: &recipient},
+			block: &ethrpc.RpcBlock{Number: hexBig(9), Hash: blockHash,
+				Timestamp: 1700000000},
+			expectedReport: &TransactionReport{
+				Hash:              txHash.Hex(),
+				Status:            StatusReverted,
+				BlockNumber:       uint64Ptr(9),
+				BlockHash:         blockHash.Hex(),
+				TransactionIndex:  uint64Ptr(0),
+				Timestamp:         uint64Ptr(1700000000),
+				From:              sender.Hex(),
+				ContractAddress:   recipient.Hex(),
+				Value:             "0",
+				GasLimit:          900000,
+				GasPrice:          "50",
+				EffectiveGasPrice: "50",
+				GasUsed:           uint64Ptr(800000),
+				Fee:               "40000000",
+				Input:             "0x6080",
+				Logs:              []LogReport{},
+			},
+		},
+		{
+			testName: "Unknown transaction",
+			txError:  ethereum.NotFound,
+			expectedError: "error: transaction " + txHash.Hex() +
+				" is unknown to the node",
+		},
+		{
+			testName: "Failed lookup",
+			txError:  errors.New("connection refused"),
+			expectedError: "error: failed to retrieve transaction " +
+				txHash.Hex() + ": connection refused",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			client := new(MockClient)
+			client.On("GetTransaction", txHash).Return(tt.tx, tt.txError)
+			client.On("GetReceipt", txHash).Return(tt.receipt, nil)
+			client.On("GetBlock", blockHash.Hex(), false).Return(tt.block, nil)
+
+			report, err := newTestInspector(t, client).Transaction(
+				context.Background(), txHash)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, report)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
+
+func TestInspectorBlock(t *testing.T) {
+	parsed, _ := abi.JSON(strings.NewReader(tokenAbi))
+	transferData, _ := parsed.Pack("transfer", recipient, big.NewInt(1000))
+	hashes := []common.Hash{common.HexToHash("0x11"),
+		common.HexToHash("0x12"), common.HexToHash("0x13"),
+		common.HexToHash("0x14")}
+	block := &ethrpc.RpcBlock{Number: hexBig(9), Hash: blockHash,
+		ParentHash: common.HexToHash("0x01"), Timestamp: 1700000000,
+		Miner: recipient, GasLimit: 1000000, GasUsed: 250000,
+		BaseFee: hexBig(7), Size: 900,
+		Transactions: []ethrpc.RpcTransaction{
+			{Hash: hashes[0], From: sender, Nonce: 1, Value: hexBig(0),
+				Gas: 500000, Input: []byte{0x60}},
+			{Hash: hashes[1], From: sender, To: &recipient, Nonce: 2,
+				Value: hexBig(5), Gas: 21000},
+			{Hash: hashes[2], From: sender, To: &token, Nonce: 3,
+				Gas: 60000, Input: transferData},
+			{Hash: hashes[3], From: sender, To: &token, Nonce: 4,
+				Gas: 60000, Input: []byte{1, 2, 3, 4, 5}},
+		}}
+	emptyBlock := *block
+	emptyBlock.Transactions = nil
+	receipts := []*types.Receipt{
+		{Status: types.ReceiptStatusSuccessful, GasUsed: 150000},
+		{Status: types.ReceiptStatusSuccessful, GasUsed: 21000},
+		{Status: types.ReceiptStatusFailed, GasUsed: 30000},
+		nil,
+	}
+	transactions := []BlockTransaction{
+		{Index: 0, Hash: hashes[0].Hex(), From: sender.Hex(), Nonce: 1,
+			Value: "0", GasLimit: 500000, Function: "contract creation"},
+		{Index: 1, Hash: hashes[1].Hex(), From: sender.Hex(),
+			To: recipient.Hex(), Nonce: 2, Value: "5", GasLimit: 21000,
+			Function: "native transfer"},
+		{Index: 2, Hash: hashes[2].Hex(), From: sender.Hex(),
+			To: token.Hex(), Nonce: 3, Value: "0", GasLimit: 60000,
+			Function: "transfer(address,uint256)"},
+		{Index: 3, Hash: hashes[3].Hex(), From: sender.Hex(),
+			To: token.Hex(), Nonce: 4, Value: "0", GasLimit: 60000,
+			Function: "0x01020304"},
+	}
+	withReceipts := make([]BlockTransaction, len(transactions))
+	copy(withReceipts, transactions)
+	withReceipts[0].GasUsed, withReceipts[0].Status = uint64Ptr(150000),
+		StatusSuccess
+	withReceipts[1].GasUsed, withReceipts[1].Status = uint64Ptr(21000),
+		StatusSuccess
+	withReceipts[2].GasUsed, withReceipts[2].Status = uint64Ptr(30000),
+		StatusReverted
+	tests := []struct {
+		testName             string
+		block                *ethrpc.RpcBlock
+		blockError           error
+		receiptsError        error
+		expectedTransactions []BlockTransaction
+		expectedError        string
+	}{
+		{
+			testName:             "Block with receipts",
+			block:                block,
+			expectedTransactions: withReceipts,
+		},
+		{
+			testName:             "Block without receipts",
+			block:                block,
+			receiptsError:        errors.New("batch too large"),
+			expectedTransactions: transactions,
+		},
+		{
+			testName:             "Empty block",
+			block:                &emptyBlock,
+			expectedTransactions: []BlockTransaction{},
+		},
+		{
+			testName:      "Pending block",
+			block:         &ethrpc.RpcBlock{Hash: blockHash},
+			expectedError: "error: block 9 isn't sealed yet",
+		},
+		{
+			testName:      "Missing block",
+			blockError:    errors.New("error: block 9 doesn't exist"),
+			expectedError: "error: block 9 doesn't exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			client := new(MockClient)
+			client.On("GetBlock", "9", true).Return(tt.block, tt.blockError)
+			client.On("BatchTransactionReceipt", hashes, 10).Return(receipts,
+				make([]error, len(receipts)), tt.receiptsError)
+
+			report, err := newTestInspector(t, client).Block(
+				context.Background(), "9", 10)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, report)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &BlockReport{
+				Number:         9,
+				Hash:           blockHash.Hex(),
+				ParentHash:     common.HexToHash("0x01").Hex(),
+				Timestamp:      1700000000,
+				Miner:          recipient.Hex(),
+				GasLimit:       1000000,
+				GasUsed:        250000,
+				GasUsedPercent: 25,
+				BaseFee:        "7",
+				Size:           900,
+				Transactions:   tt.expectedTransactions,
+			}, report)
+		})
+	}
+}
+
+func TestTransactionReportText(t *testing.T) {
+	report := &TransactionReport{
+		Hash:     txHash.Hex(),
+		Status:   StatusPending,
+		From:     sender.Hex(),
+		To:       recipient.Hex(),
+		Nonce:    1,
+		Value:    "5",
+		GasLimit: 21000,
+		GasPrice: "50",
+		Input:    "0x",
+		Logs:     []LogReport{},
+	}
+	assert.Equal(t, "Transaction "+txHash.Hex()+"\n"+
+		"  Status:               pending\n"+
+		"  From:                 "+sender.Hex()+"\n"+
+		"  To:                   "+recipient.Hex()+"\n"+
+		"  Nonce:                1\n"+
+		"  Value:                5 wei\n"+
+		"  Type:                 0\n"+
+		"  Gas limit:            21000\n"+
+		"  Gas price:            50 wei\n"+
+		"  Call:                 native transfer\n", report.Text())
+
+	output, err := JSON(report)
+	assert.NoError(t, err)
+	assert.True(t, strings.Contains(output, `"status": "pending"`))
+	assert.False(t, strings.Contains(output, "blockNumber"))
+}
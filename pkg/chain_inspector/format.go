@@ -0,0 +1,132 @@
+package chain_inspector
+
+import (
+	"encoding/json"
+	"fmt"
+	abidec "go-evm-client/pkg/abi_decoder"
+	"strings"
+	"time"
+)
+
+// JSON formats a report as indented JSON
+func JSON(report interface{}) (string, error) {
+	output, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("error: failed to format the report as "+
+			"JSON: %v", err)
+	}
+	return string(output) + "\n", nil
+}
+
+// formatTime shows a unix timestamp in UTC
+func formatTime(timestamp uint64) string {
+	return time.Unix(int64(timestamp), 0).UTC().Format(time.RFC3339)
+}
+
+// Text formats the transaction report for the terminal
+func (r *TransactionReport) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Transaction %s\n", r.Hash)
+	fmt.Fprintf(&b, "  Status:               %s\n", r.Status)
+	if r.BlockNumber != nil {
+		fmt.Fprintf(&b, "  Block:                %d (%s) index %d\n",
+			*r.BlockNumber, r.BlockHash, *r.TransactionIndex)
+		fmt.Fprintf(&b, "  Time:                 %s\n",
+			formatTime(*r.Timestamp))
+	}
+	fmt.Fprintf(&b, "  From:                 %s\n", r.From)
+	if len(r.To) != 0 {
+		fmt.Fprintf(&b, "  To:                   %s\n", r.To)
+	}
+	if len(r.ContractAddress) != 0 {
+		fmt.Fprintf(&b, "  Contract created:     %s\n", r.ContractAddress)
+	}
+	fmt.Fprintf(&b, "  Nonce:                %d\n", r.Nonce)
+	fmt.Fprintf(&b, "  Value:                %s wei\n", r.Value)
+	fmt.Fprintf(&b, "  Type:                 %d\n", r.Type)
+	fmt.Fprintf(&b, "  Gas limit:            %d\n", r.GasLimit)
+	if len(r.GasPrice) != 0 {
+		fmt.Fprintf(&b, "  Gas price:            %s wei\n", r.GasPrice)
+	}
+	if len(r.MaxFeePerGas) != 0 {
+		fmt.Fprintf(&b, "  Max fee per gas:      %s wei\n", r.MaxFeePerGas)
+		fmt.Fprintf(&b, "  Max priority fee:     %s wei\n",
+			r.MaxPriorityFeePerGas)
+	}
+	if r.GasUsed != nil {
+		fmt.Fprintf(&b, "  Gas used:             %d\n", *r.GasUsed)
+	}
+	if len(r.EffectiveGasPrice) != 0 {
+		fmt.Fprintf(&b, "  Effective gas price:  %s wei\n",
+			r.EffectiveGasPrice)
+		fmt.Fprintf(&b, "  Fee:                  %s wei\n", r.Fee)
+	}
+	switch {
+	case r.Call != nil:
+		fmt.Fprintf(&b, "  Call:                 %s\n", r.Call.Signature)
+		abidec.WriteArgs(&b, "    ", r.Call.Args)
+	case len(r.CallError) != 0:
+		fmt.Fprintf(&b, "  Call:                 unknown, %s\n",
+			strings.TrimPrefix(r.CallError, "error: "))
+		fmt.Fprintf(&b, "  Input:                %s\n", r.Input)
+	case len(r.To) == 0:
+		fmt.Fprintf(&b, "  Call:                 contract creation\n")
+	default:
+		fmt.Fprintf(&b, "  Call:                 native transfer\n")
+	}
+	if r.BlockNumber != nil {
+		fmt.Fprintf(&b, "  Logs:                 %d\n", len(r.Logs))
+	}
+	for _, log := range r.Logs {
+		if log.Event != nil {
+			fmt.Fprintf(&b, "    [%d] %s %s\n", log.Index, log.Address,
+				log.Event.Signature)
+			abidec.WriteArgs(&b, "        ", log.Event.Args)
+			continue
+		}
+		fmt.Fprintf(&b, "    [%d] %s unknown event\n", log.Index,
+			log.Address)
+		for j, topic := range log.Topics {
+			fmt.Fprintf(&b, "        topic%d: %s\n", j, topic)
+		}
+		fmt.Fprintf(&b, "        data: %s\n", log.Data)
+	}
+	return b.String()
+}
+
+// Text formats the block report for the terminal
+func (r *BlockReport) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Block %d\n", r.Number)
+	fmt.Fprintf(&b, "  Hash:          %s\n", r.Hash)
+	fmt.Fprintf(&b, "  Parent hash:   %s\n", r.ParentHash)
+	fmt.Fprintf(&b, "  Time:          %s (%d)\n", formatTime(r.Timestamp),
+		r.Timestamp)
+	fmt.Fprintf(&b, "  Miner:         %s\n", r.Miner)
+	fmt.Fprintf(&b, "  Gas used:      %d of %d (%.2f%%)\n", r.GasUsed,
+		r.GasLimit, r.GasUsedPercent)
+	if len(r.BaseFee) != 0 {
+		fmt.Fprintf(&b, "  Base fee:      %s wei\n", r.BaseFee)
+	}
+	fmt.Fprintf(&b, "  Size:          %d bytes\n", r.Size)
+	fmt.Fprintf(&b, "  Transactions:  %d\n", len(r.Transactions))
+	for _, tx := range r.Transactions {
+		to := tx.To
+		if len(to) == 0 {
+			to = "-"
+		}
+		gasUsed := "?"
+		if tx.GasUsed != nil {
+			gasUsed = fmt.Sprintf("%d", *tx.GasUsed)
+		}
+		status := tx.Status
+		if len(status) == 0 {
+			status = "unknown status"
+		}
+		fmt.Fprintf(&b, "    [%d] %s %s %s\n", tx.Index, tx.Hash, status,
+			tx.Function)
+		fmt.Fprintf(&b, "        %s -> %s nonce %d value %s wei gas %s of "+
+			"%d\n", tx.From, to, tx.Nonce, tx.Value, gasUsed, tx.GasLimit)
+	}
+	return b.String()
+}
@@ -5,40 +5,20 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	cc "go-evm-client/internal/contracts_template_interface"
 	utils "go-evm-client/internal/utils"
+	erc20 "go-evm-client/pkg/contracts/erc20_token"
 	"go-evm-client/pkg/eth_rpc_client"
 	"math/big"
 	"reflect"
+	"strconv"
 )
 
+// IInstance is the interface needed for these contract functions, the
+// mint and burn functions come on top of the shared ERC20 ones
 type IInstance interface {
-	Transfer(
-		opts *bind.TransactOpts,
-		recipient common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	Approve(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	TransferFrom(
-		opts *bind.TransactOpts,
-		sender common.Address,
-		recipient common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	IncreaseAllowance(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		addedValue *big.Int,
-	) (*types.Transaction, error)
-	DecreaseAllowance(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		subtractedValue *big.Int,
-	) (*types.Transaction, error)
+	erc20.IInstance
 	Mint(opts *bind.TransactOpts,
 		to common.Address,
 		amount *big.Int,
@@ -47,72 +27,81 @@ type IInstance interface {
 		from common.Address,
 		amount *big.Int,
 	) (*types.Transaction, error)
-	Name(opts *bind.CallOpts) (string, error)
-	Symbol(opts *bind.CallOpts) (string, error)
-	Decimals(opts *bind.CallOpts) (uint8, error)
-	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
-	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
-	Allowance(
-		opts *bind.CallOpts,
-		owner common.Address,
-		spender common.Address,
-	) (*big.Int, error)
 }
 
 // DetailedTestTokenContract contains all the data needed to
 // deploy and interact with the DetailedTestToken contract
 type DetailedTestTokenContract struct {
 	cc.Contract
+	erc20.Token
 	ConstructorArgs contractConstructorArgs
-	detailedQueriableContractData
-	Address  common.Address
 	LastTx   *types.Transaction
-	Instance IInstance
-	StrToPrint 		string
 }
 
 // contractConstructorArgs are the details needed to deploy
 // the DetailedTestToken. These details are passed onto the
 // contract's constructor
 type contractConstructorArgs struct {
-	name   string
-	symbol string
+	name     string
+	symbol   string
+	decimals uint8
+	// amount is scaled by the decimals unless raw amounts are given
 	amount *big.Int
-}
-
-// detailedQueriableContractData is a struct that holds all the data
-// that can be queried from the contract
-type detailedQueriableContractData struct {
-	Name        string
-	Symbol      string
-	Decimals    uint8
-	TotalSupply *big.Int
-	BalanceOf   map[common.Address]*big.Int
-	Allowance   map[common.Address]map[common.Address]*big.Int
+	// owner receives the amount and the ownership, the zero address
+	// stands for the deployer
+	owner common.Address
 }
 
 // ParseConstructorArguments is used to parse the slice of strings
 // into the specific constructor arguments needed for contract deployment
-// these are then stored in contractConstructorArgs
+// these are then stored in contractConstructorArgs. The owner is the
+// optional last argument.
 func (d *DetailedTestTokenContract) ParseConstructorArguments(
 	contractArgs []string) error {
 	neededArgs := reflect.TypeOf(contractConstructorArgs{}).NumField()
 	recArgs := len(contractArgs)
-	if recArgs != neededArgs {
+	if recArgs != neededArgs && recArgs != neededArgs-1 {
 		return fmt.Errorf("error: incorrect amount of arguments, args " +
-			"needed : %d != args received %d", neededArgs, recArgs)
+			"needed : %d or %d != args received %d", neededArgs-1, neededArgs,
+			recArgs)
+	}
+	decimals, err := strconv.ParseUint(contractArgs[2], 10, 8)
+	if err != nil {
+		return fmt.Errorf("error: decimals %q must be an integer between 0 "+
+			"and 255", contractArgs[2])
+	}
+	amount, err1 := d.ArgParser.ParseAmount(contractArgs[3], uint8(decimals),
+		contractArgs[1])
+	if err1 != nil {
+		return err1
 	}
-	amount := new(big.Int)
-	amount.SetString(contractArgs[2], 10)
 	ccArgs := contractConstructorArgs{
-		name:   contractArgs[0],
-		symbol: contractArgs[1],
-		amount: amount,
+		name:     contractArgs[0],
+		symbol:   contractArgs[1],
+		decimals: uint8(decimals),
+		amount:   amount,
+	}
+	if recArgs == neededArgs {
+		owner, err2 := d.ArgParser.ParseRecipient(contractArgs[4])
+		if err2 != nil {
+			return err2
+		}
+		ccArgs.owner = owner
 	}
 	d.ConstructorArgs = ccArgs
 	return nil
 }
 
+// owner returns the owner the token is deployed for, the deployer unless
+// it is given as a constructor argument
+func (d *DetailedTestTokenContract) owner(
+	auth *bind.TransactOpts) common.Address {
+	if d.ConstructorArgs.owner == (common.Address{}) {
+		return auth.From
+	}
+	return d.ConstructorArgs.owner
+}
+
 // DeployContract deploys the detailed test token contract and saves
 // its instance, tx of deployment and contract address
 func (d *DetailedTestTokenContract) DeployContract(
@@ -124,10 +113,71 @@ func (d *DetailedTestTokenContract) DeployContract(
 		client,
 		d.ConstructorArgs.name,
 		d.ConstructorArgs.symbol,
-		d.ConstructorArgs.amount)
+		d.ConstructorArgs.decimals,
+		d.ConstructorArgs.amount,
+		d.owner(auth))
+	if err != nil {
+		return err
+	}
+	d.Address = address
+	d.LastTx = tx
+	d.Instance = instance
+	return nil
+}
+
+// DeploymentCode returns the creation bytecode of the detailed test
+// token contract followed by the packed constructor arguments. The owner
+// must be given since the deployer isn't known.
+func (d *DetailedTestTokenContract) DeploymentCode() ([]byte, error) {
+	err := checkOwner(d.ConstructorArgs.owner, "DetailedTestToken")
+	if err != nil {
+		return nil, err
+	}
+	parsed, err1 := DetailedTestTokenMetaData.GetAbi()
+	if err1 != nil {
+		return nil, err1
+	}
+	args, err2 := parsed.Pack("", d.ConstructorArgs.name,
+		d.ConstructorArgs.symbol, d.ConstructorArgs.decimals,
+		d.ConstructorArgs.amount, d.ConstructorArgs.owner)
+	if err2 != nil {
+		return nil, err2
+	}
+	return append(common.FromHex(DetailedTestTokenMetaData.Bin), args...), nil
+}
+
+// checkOwner checks that the owner of a token deployed from its init code
+// is given, the init code doesn't depend on the deployer so the zero
+// address can't stand for it
+func checkOwner(owner common.Address, contractName string) error {
+	if owner == (common.Address{}) {
+		return fmt.Errorf("error: the owner of %s must be passed as its " +
+			"last constructor argument to deploy it through a CREATE2 " +
+			"factory, the factory would own it otherwise", contractName)
+	}
+	return nil
+}
+
+// DeployContractCreate2 deploys the detailed test token contract through
+// the CREATE2 factory and saves its instance, tx of deployment and
+// contract address
+func (d *DetailedTestTokenContract) DeployContractCreate2(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer cc.Create2Deployer,
+) error {
+	initCode, err := d.DeploymentCode()
 	if err != nil {
 		return err
 	}
+	address, tx, err1 := deployer.Deploy(auth, initCode)
+	if err1 != nil {
+		return err1
+	}
+	instance, err2 := NewDetailedTestToken(address, client)
+	if err2 != nil {
+		return err2
+	}
 	d.Address = address
 	d.LastTx = tx
 	d.Instance = instance
@@ -144,12 +194,13 @@ func (d *DetailedTestTokenContract) LoadContract(
 	if err != nil {
 		return err
 	}
-	d.Instance = instance
-	d.Address = *address
-	// Instantiate empty maps
-	d.BalanceOf = map[common.Address]*big.Int{}
-	d.Allowance = map[common.Address]map[common.Address]*big.Int{}
-	return nil
+	return d.Load("DetailedTestToken", *address, instance, client, client,
+		client)
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (d *DetailedTestTokenContract) SetArgParser(parser *utils.ArgParser) {
+	d.ArgParser = parser
 }
 
 // PrintDeploymentData outputs to the terminal the address and
@@ -186,13 +237,22 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		recipient := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.Transfer(auth, recipient, amount)
+		recipient, err1 := d.ArgParser.ParseRecipient(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		err3 := d.CheckTransfer(auth, auth.From, amount, false)
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := d.Instance.Transfer(auth, recipient, amount)
+		if err4 != nil {
+			return err4
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Transferred %d tokens at " +
 			"DetailedTestToken (%s) to address %s\n", amount, d.Address, recipient)
@@ -201,13 +261,18 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		recipient := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.Approve(auth, recipient, amount)
+		recipient, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := d.Instance.Approve(auth, recipient, amount)
+		if err3 != nil {
+			return err3
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Approved %d tokens at " +
 			"DetailedTestToken (%s) to address %s\n", amount, d.Address, recipient)
@@ -216,14 +281,26 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		sender := common.HexToAddress(funcArgs[0])
-		recipient := common.HexToAddress(funcArgs[1])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[2], 10)
-		tx, err1 := d.Instance.TransferFrom(auth, sender, recipient, amount)
+		sender, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		recipient, err2 := d.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		amount, err3 := d.ParseAmount(funcArgs[2])
+		if err3 != nil {
+			return err3
+		}
+		err4 := d.CheckTransfer(auth, sender, amount, true)
+		if err4 != nil {
+			return err4
+		}
+		tx, err5 := d.Instance.TransferFrom(auth, sender, recipient, amount)
+		if err5 != nil {
+			return err5
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Transferred From %s %d tokens at " +
 			"DetailedTestToken (%s) to address %s\n", sender, amount, d.Address,
@@ -233,13 +310,18 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		spender := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.IncreaseAllowance(auth, spender, amount)
+		spender, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := d.Instance.IncreaseAllowance(auth, spender, amount)
+		if err3 != nil {
+			return err3
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Increased Allowance by %d tokens at " +
 			"DetailedTestToken (%s) to address %s\n", amount, d.Address, spender)
@@ -248,13 +330,18 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		spender := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.DecreaseAllowance(auth, spender, amount)
+		spender, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := d.Instance.DecreaseAllowance(auth, spender, amount)
+		if err3 != nil {
+			return err3
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Decreased Allowance by %d tokens at " +
 			"DetailedTestToken (%s) from address %s\n", amount, d.Address, spender)
@@ -263,13 +350,22 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		to := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.Mint(auth, to, amount)
+		to, err1 := d.ArgParser.ParseRecipient(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		instance, err3 := d.mintable()
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := instance.Mint(auth, to, amount)
+		if err4 != nil {
+			return err4
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Minted %d tokens to %s at " +
 			"DetailedTestToken (%s)\n", amount, to, d.Address)
@@ -278,13 +374,22 @@ func (d *DetailedTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		from := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := d.Instance.Burn(auth, from, amount)
+		from, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := d.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		instance, err3 := d.mintable()
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := instance.Burn(auth, from, amount)
+		if err4 != nil {
+			return err4
+		}
 		d.LastTx = tx
 		d.StrToPrint = fmt.Sprintf("info: Burned %d tokens from %s at " +
 			"DetailedTestToken (%s)\n", amount, from, d.Address)
@@ -294,12 +399,25 @@ func (d *DetailedTestTokenContract) WriteContract(
 	return nil
 }
 
+// mintable returns the loaded instance together with its mint and burn
+// functions
+func (d *DetailedTestTokenContract) mintable() (IInstance, error) {
+	instance, ok := d.Instance.(IInstance)
+	if !ok {
+		return nil, fmt.Errorf("error: the DetailedTestToken (%s) instance "+
+			"can't mint or burn", d.Address)
+	}
+	return instance, nil
+}
+
 // QueryContract executes query functions which do not invoke a state
 // change in the DetailedTestToken contract, this execution is based on
 // function name and arguments. Function arguments are verified for
 // length and then converted to fit the appropriate types.
-// Data retrieved is then stored in detailedQueriableContractData
+// The call options select the block and caller the view calls are
+// executed with. Data retrieved is then stored in detailedQueriableContractData
 func (d *DetailedTestTokenContract) QueryContract(
+	opts *bind.CallOpts,
 	funcName string,
 	funcArgs []string,
 ) error {
@@ -309,7 +427,7 @@ func (d *DetailedTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		name, err1 := d.Instance.Name(nil)
+		name, err1 := d.Instance.Name(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -321,7 +439,7 @@ func (d *DetailedTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		symbol, err1 := d.Instance.Symbol(nil)
+		symbol, err1 := d.Instance.Symbol(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -333,7 +451,7 @@ func (d *DetailedTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		decimals, err1 := d.Instance.Decimals(nil)
+		decimals, err1 := d.Instance.Decimals(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -345,37 +463,58 @@ func (d *DetailedTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		totalSupply, err1 := d.Instance.TotalSupply(nil)
+		totalSupply, err1 := d.Instance.TotalSupply(opts)
 		if err1 != nil {
 			return err1
 		}
+		err2 := d.LoadDenomination(opts)
+		if err2 != nil {
+			return err2
+		}
 		d.TotalSupply = totalSupply
-		d.StrToPrint = fmt.Sprintf("info: Token TotalSupply: %d for DetailedTestToken (%s)" +
-			"\n", totalSupply, d.Address)
+		d.StrToPrint = fmt.Sprintf("info: Token TotalSupply: %s for DetailedTestToken (%s)" +
+			"\n", d.FormatAmount(totalSupply), d.Address)
 	case "balanceof":
 		err := utils.ValidateLength(&funcArgs, 1)
 		if err != nil {
 			return err
 		}
-		account := common.HexToAddress(funcArgs[0])
-		balOfAccount, err1 := d.Instance.BalanceOf(nil, account)
+		account, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		balOfAccount, err2 := d.Instance.BalanceOf(opts, account)
+		if err2 != nil {
+			return err2
+		}
+		err3 := d.LoadDenomination(opts)
+		if err3 != nil {
+			return err3
+		}
 		d.BalanceOf[account] = balOfAccount
-		d.StrToPrint = fmt.Sprintf("info: Token Balance of %s : %d for DetailedTestToken (%s)" +
-			"\n", account, balOfAccount, d.Address)
+		d.StrToPrint = fmt.Sprintf("info: Token Balance of %s : %s for DetailedTestToken (%s)" +
+			"\n", account, d.FormatAmount(balOfAccount), d.Address)
 	case "allowance":
 		err := utils.ValidateLength(&funcArgs, 2)
 		if err != nil {
 			return err
 		}
-		owner := common.HexToAddress(funcArgs[0])
-		spender := common.HexToAddress(funcArgs[1])
-		alwOfAccounts, err1 := d.Instance.Allowance(nil, owner, spender)
+		owner, err1 := d.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		spender, err2 := d.ArgParser.ParseAddress(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		alwOfAccounts, err3 := d.Instance.Allowance(opts, owner, spender)
+		if err3 != nil {
+			return err3
+		}
+		err4 := d.LoadDenomination(opts)
+		if err4 != nil {
+			return err4
+		}
 		// Since this is a nested map of data we must check if `owner`
 		// has a map instantiated towards them, if not create one
 		if _, ok := d.Allowance[owner]; !ok {
@@ -383,10 +522,56 @@ func (d *DetailedTestTokenContract) QueryContract(
 		}
 		d.Allowance[owner][spender] = alwOfAccounts
 		d.StrToPrint = fmt.Sprintf("info: Token Allowance of spender %s " +
-			"from owner %s is %d for DetailedTestToken (%s)" +
-			"\n", spender, owner, alwOfAccounts, d.Address)
+			"from owner %s is %s for DetailedTestToken (%s)" +
+			"\n", spender, owner, d.FormatAmount(alwOfAccounts), d.Address)
+	case "info":
+		err := d.QuerySummary(opts, funcArgs)
+		if err != nil {
+			return err
+		}
 	default:
 		return nil
 	}
 	return nil
 }
+
+// QueryContractMulticall executes several query functions of the
+// DetailedTestToken contract atomically through the multicaller
+func (d *DetailedTestTokenContract) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller cc.Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	return d.Token.QueryContractMulticall(opts, multicaller, funcNames,
+		funcArgs)
+}
+
+// QueryEvents retrieves the Transfer, Approval and OwnershipTransferred
+// events of the DetailedTestToken contract
+func (d *DetailedTestTokenContract) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	return d.Token.QueryEvents(opts, eventName, filter)
+}
+
+// WatchEvents subscribes to the Transfer, Approval or OwnershipTransferred
+// events of the DetailedTestToken contract
+func (d *DetailedTestTokenContract) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	return d.Token.WatchEvents(opts, eventName, filter, sink)
+}
+
+// SetDeployments sets the deployments recorded by the contract deployer,
+// the DetailedTestToken summary reads its deployer from them when present
+func (d *DetailedTestTokenContract) SetDeployments(
+	deployments cc.IDeployments,
+) {
+	d.Deployments = deployments
+}
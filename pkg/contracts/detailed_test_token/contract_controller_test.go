@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	utils "go-evm-client/internal/utils"
 	"math/big"
 	"testing"
 )
@@ -78,7 +79,6 @@ func (m *MockContractInstance) Burn(opts *bind.TransactOpts,
 	return (args.Get(0)).(*types.Transaction), args.Error(1)
 }
 
-
 func (m *MockContractInstance) Name(_ *bind.CallOpts) (string, error) {
 	args := m.Called(nil)
 	return (args.Get(0)).(string), args.Error(1)
@@ -113,6 +113,11 @@ func (m *MockContractInstance) 	Allowance(
 	return (args.Get(0)).(*big.Int), args.Error(1)
 }
 
+func (m *MockContractInstance) Owner(_ *bind.CallOpts) (common.Address, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
 func TestQueryContractNameMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -156,7 +161,7 @@ func TestQueryContractNameMethod(t *testing.T) {
 				tt.expectedError)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -209,7 +214,7 @@ func TestQueryContractSymbolMethod(t *testing.T) {
 				tt.expectedError)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -262,7 +267,7 @@ func TestQueryContractDecimalsMethod(t *testing.T) {
 				tt.expectedError)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -285,7 +290,7 @@ func TestQueryContractTotalSupplyMethod(t *testing.T) {
 			testName: "QueryContract func TotalSupply successful all data returned.",
 			funcName: "totalsupply",
 			funcArgs: []string{},
-			strToPrint: "info: Token TotalSupply: 100000000000 for DetailedTestToken " +
+			strToPrint: "info: Token TotalSupply: 100000000000 (0.0000001 DTT) for DetailedTestToken " +
 				"(0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
@@ -313,9 +318,11 @@ func TestQueryContractTotalSupplyMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("TotalSupply", nil).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("DTT", nil)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -339,7 +346,7 @@ func TestQueryContractBalanceOfMethod(t *testing.T) {
 			funcName: "balanceof",
 			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"},
 			strToPrint: "info: Token Balance of 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df : " +
-				"100000000000 for DetailedTestToken (0x0000000000000000000000000000000000000000)\n",
+				"100000000000 (0.0000001 DTT) for DetailedTestToken (0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
 		},
@@ -367,11 +374,13 @@ func TestQueryContractBalanceOfMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("BalanceOf", nil, account).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("DTT", nil)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
 			// Need to set the empty map
 			dttc.BalanceOf = map[common.Address]*big.Int{}
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -396,7 +405,7 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df",
 				"0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"},
 			strToPrint: "info: Token Allowance of spender 0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 " +
-				"from owner 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df is 100000000000 for DetailedTestToken " +
+				"from owner 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df is 100000000000 (0.0000001 DTT) for DetailedTestToken " +
 				"(0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
@@ -428,10 +437,12 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Allowance", nil, owner, spender).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("DTT", nil)
 			dttc := DetailedTestTokenContract{}
 			dttc.Instance = mInstance
 			dttc.Allowance = map[common.Address]map[common.Address]*big.Int{}
-			err := dttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := dttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -441,7 +452,6 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 	}
 }
 
-
 func TestWriteContractTransferMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -487,6 +497,10 @@ func TestWriteContractTransferMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Transfer", auth, recipient, amount).Return(
@@ -548,6 +562,10 @@ func TestWriteContractApproveMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Approve", auth, recipient, amount).Return(
@@ -612,6 +630,10 @@ func TestWriteContractTransferFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[2], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("TransferFrom", auth, sender, recipient,
@@ -672,6 +694,10 @@ func TestWriteContractIncreaseAllowanceFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("IncreaseAllowance", auth, spender,
@@ -688,7 +714,6 @@ func TestWriteContractIncreaseAllowanceFromMethod(t *testing.T) {
 	}
 }
 
-
 func TestWriteContractDecreaseAllowanceFromMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -733,6 +758,10 @@ func TestWriteContractDecreaseAllowanceFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("DecreaseAllowance", auth, spender,
@@ -794,6 +823,10 @@ func TestWriteContractMintMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Mint", auth, to, amount).Return(
@@ -855,6 +888,10 @@ func TestWriteContractBurnMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Burn", auth, to, amount).Return(
@@ -869,4 +906,142 @@ func TestWriteContractBurnMethod(t *testing.T) {
 			assert.Equal(t, dttc.StrToPrint, tt.strToPrint)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestWriteContractTransferAddressValidation(t *testing.T) {
+	tests := []struct {
+		testName string
+		funcArgs []string
+		argParser *utils.ArgParser
+		expectedError error
+	}{
+		{
+			testName: "WriteContract func Transfer lowercase address accepted.",
+			funcArgs: []string{"0x86be6fc9b05b55cbd04f3161f9b481f27f90a8df", "1"},
+			argParser: nil,
+			expectedError: nil,
+		},
+		{
+			testName: "WriteContract func Transfer malformed address rejected.",
+			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: \"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8\" " +
+				"is not a valid hex address"),
+		},
+		{
+			testName: "WriteContract func Transfer bad checksum rejected.",
+			funcArgs: []string{"0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: address 0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"has an invalid EIP-55 checksum, expected " +
+				"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"),
+		},
+		{
+			testName: "WriteContract func Transfer bad checksum only warned.",
+			funcArgs: []string{"0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df", "1"},
+			argParser: &utils.ArgParser{WarnOnBadChecksum: true},
+			expectedError: nil,
+		},
+		{
+			testName: "WriteContract func Transfer zero address refused.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: refusing to use the zero address as " +
+				"recipient, use --force to override"),
+		},
+		{
+			testName: "WriteContract func Transfer zero address forced.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000", "1"},
+			argParser: &utils.ArgParser{AllowZeroAddress: true},
+			expectedError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			recipient := common.HexToAddress(tt.funcArgs[0])
+			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			auth :=  &bind.TransactOpts{}
+			mInstance.On("Transfer", auth, recipient, big.NewInt(1)).Return(
+				&types.Transaction{}, nil)
+			dttc := DetailedTestTokenContract{}
+			dttc.Instance = mInstance
+			dttc.SetArgParser(tt.argParser)
+			err := dttc.WriteContract(auth, "transfer", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				mInstance.AssertNotCalled(t, "Transfer", auth, recipient,
+					big.NewInt(1))
+				return
+			}
+			assert.NoError(t, err)
+			mInstance.AssertCalled(t, "Transfer", auth, recipient, big.NewInt(1))
+		})
+	}
+}
+
+func TestDeploymentCodeOwner(t *testing.T) {
+	owner := "0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"
+	tests := []struct {
+		testName      string
+		contractArgs  []string
+		expectedOwner common.Address
+		expectedError error
+	}{
+		{
+			testName: "DeploymentCode with the owner.",
+			contractArgs: []string{"Detailed Test Token", "DTT", "18", "1000",
+				owner},
+			expectedOwner: common.HexToAddress(owner),
+		},
+		{
+			testName:     "DeploymentCode fail without the owner.",
+			contractArgs: []string{"Detailed Test Token", "DTT", "18", "1000"},
+			expectedError: errors.New("error: the owner of DetailedTestToken " +
+				"must be passed as its last constructor argument to deploy it " +
+				"through a CREATE2 factory, the factory would own it otherwise"),
+		},
+		{
+			testName: "DeploymentCode fail zero owner.",
+			contractArgs: []string{"Detailed Test Token", "DTT", "18", "1000",
+				"0x0000000000000000000000000000000000000000"},
+			expectedError: errors.New("error: refusing to use the zero " +
+				"address as recipient, use --force to override"),
+		},
+		{
+			testName: "DeploymentCode fail invalid decimals.",
+			contractArgs: []string{"Detailed Test Token", "DTT", "256", "1000",
+				owner},
+			expectedError: errors.New("error: decimals \"256\" must be an " +
+				"integer between 0 and 255"),
+		},
+		{
+			testName:     "DeploymentCode fail missing amount.",
+			contractArgs: []string{"Detailed Test Token", "DTT", "18"},
+			expectedError: errors.New("error: incorrect amount of arguments, " +
+				"args needed : 4 or 5 != args received 3"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			dttc := DetailedTestTokenContract{}
+			err := dttc.ParseConstructorArguments(tt.contractArgs)
+			var code []byte
+			if err == nil {
+				code, err = dttc.DeploymentCode()
+			}
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			// The owner is the fifth head word, followed by the two
+			// encoded strings
+			assert.Equal(t, common.BytesToAddress(
+				code[len(code)-160:len(code)-128]), tt.expectedOwner)
+		})
+	}
+}
@@ -0,0 +1,1152 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package detailed_test_token
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// DetailedPermitTokenMetaData contains all meta data concerning the DetailedPermitToken contract.
+var DetailedPermitTokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name_\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol_\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"decimals_\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"DOMAIN_SEPARATOR\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_from\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"nonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"deadline\",\"type\":\"uint256\"},{\"internalType\":\"uint8\",\"name\":\"v\",\"type\":\"uint8\"},{\"internalType\":\"bytes32\",\"name\":\"r\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"permit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b506040516200370938038062003709833981810160405281019062000037919062000642565b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1603620000a9576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620000a0906200078f565b60405180910390fd5b8460039081620000ba9190620009f2565b508360049081620000cc9190620009f2565b5082600560006101000a81548160ff021916908360ff16021790555080600560016101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055508073ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3620001968183620001a160201b60201c565b505050505062000c55565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff160362000213576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016200020a9062000b29565b60405180910390fd5b60008160025462000225919062000b7a565b9050818110156200026d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620002649062000c05565b60405180910390fd5b80600281905550620002868383620002f360201b60201c565b8273ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef84604051620002e6919062000c38565b60405180910390a3505050565b6000816000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205462000341919062000b7a565b90508181101562000389576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401620003809062000c05565b60405180910390fd5b806000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550505050565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b6200043a82620003ef565b810181811067ffffffffffffffff821117156200045c576200045b62000400565b5b80604052505050565b600062000471620003d1565b90506200047f82826200042f565b919050565b600067ffffffffffffffff821115620004a257620004a162000400565b5b620004ad82620003ef565b9050602081019050919050565b60005b83811015620004da578082015181840152602081019050620004bd565b60008484015250505050565b6000620004fd620004f78462000484565b62000465565b9050828152602081018484840111156200051c576200051b620003ea565b5b62000529848285620004ba565b509392505050565b600082601f830112620005495762000548620003e5565b5b81516200055b848260208601620004e6565b91505092915050565b600060ff82169050919050565b6200057c8162000564565b81146200058857600080fd5b50565b6000815190506200059c8162000571565b92915050565b6000819050919050565b620005b781620005a2565b8114620005c357600080fd5b50565b600081519050620005d781620005ac565b92915050565b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b60006200060a82620005dd565b9050919050565b6200061c81620005fd565b81146200062857600080fd5b50565b6000815190506200063c8162000611565b92915050565b600080600080600060a08688031215620006615762000660620003db565b5b600086015167ffffffffffffffff811115620006825762000681620003e0565b5b620006908882890162000531565b955050602086015167ffffffffffffffff811115620006b457620006b3620003e0565b5b620006c28882890162000531565b9450506040620006d5888289016200058b565b9350506060620006e888828901620005c6565b9250506080620006fb888289016200062b565b9150509295509295909350565b600082825260208201905092915050565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160008201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b60006200077760268362000708565b9150620007848262000719565b604082019050919050565b60006020820190508181036000830152620007aa8162000768565b9050919050565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200080457607f821691505b6020821081036200081a5762000819620007bc565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620008847fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000845565b62000890868362000845565b95508019841693508086168417925050509392505050565b6000819050919050565b6000620008d3620008cd620008c784620005a2565b620008a8565b620005a2565b9050919050565b6000819050919050565b620008ef83620008b2565b62000907620008fe82620008da565b84845462000852565b825550505050565b600090565b6200091e6200090f565b6200092b818484620008e4565b505050565b5b8181101562000953576200094760008262000914565b60018101905062000931565b5050565b601f821115620009a2576200096c8162000820565b620009778462000835565b8101602085101562000987578190505b6200099f620009968562000835565b83018262000930565b50505b505050565b600082821c905092915050565b6000620009c760001984600802620009a7565b1980831691505092915050565b6000620009e28383620009b4565b9150826002028217905092915050565b620009fd82620007b1565b67ffffffffffffffff81111562000a195762000a1862000400565b5b62000a258254620007eb565b62000a3282828562000957565b600060209050601f83116001811462000a6a576000841562000a55578287015190505b62000a618582620009d4565b86555062000ad1565b601f19841662000a7a8662000820565b60005b8281101562000aa45784890151825560018201915060208501945060208101905062000a7d565b8683101562000ac4578489015162000ac0601f891682620009b4565b8355505b6001600288020188555050505b505050505050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b600062000b11601f8362000708565b915062000b1e8262000ad9565b602082019050919050565b6000602082019050818103600083015262000b448162000b02565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b600062000b8782620005a2565b915062000b9483620005a2565b925082820190508082111562000baf5762000bae62000b4b565b5b92915050565b7f536166654d6174683a206164646974696f6e206f766572666c6f770000000000600082015250565b600062000bed601b8362000708565b915062000bfa8262000bb5565b602082019050919050565b6000602082019050818103600083015262000c208162000bde565b9050919050565b62000c3281620005a2565b82525050565b600060208201905062000c4f600083018462000c27565b92915050565b612aa48062000c656000396000f3fe608060405234801561001057600080fd5b50600436106101215760003560e01c8063715018a6116100ad578063a457c2d711610071578063a457c2d71461030c578063a9059cbb1461033c578063d505accf1461036c578063dd62ed3e14610388578063f2fde38b146103b857610121565b8063715018a61461027a5780637ecebe00146102845780638da5cb5b146102b457806395d89b41146102d25780639dc29fac146102f057610121565b8063313ce567116100f4578063313ce567146101c25780633644e515146101e057806339509351146101fe57806340c10f191461022e57806370a082311461024a57610121565b806306fdde0314610126578063095ea7b31461014457806318160ddd1461017457806323b872dd14610192575b600080fd5b61012e6103d4565b60405161013b91906119c3565b60405180910390f35b61015e60048036038101906101599190611a7e565b610466565b60405161016b9190611ad9565b60405180910390f35b61017c61047d565b6040516101899190611b03565b60405180910390f35b6101ac60048036038101906101a79190611b1e565b610487565b6040516101b99190611ad9565b60405180910390f35b6101ca61057a565b6040516101d79190611b8d565b60405180910390f35b6101e8610591565b6040516101f59190611bc1565b60405180910390f35b61021860048036038101906102139190611a7e565b61063e565b6040516102259190611ad9565b60405180910390f35b61024860048036038101906102439190611a7e565b610724565b005b610264600480360381019061025f9190611bdc565b6107c2565b6040516102719190611b03565b60405180910390f35b61028261080a565b005b61029e60048036038101906102999190611bdc565b61095b565b6040516102ab9190611b03565b60405180910390f35b6102bc6109a4565b6040516102c99190611c18565b60405180910390f35b6102da6109ce565b6040516102e791906119c3565b60405180910390f35b61030a60048036038101906103059190611a7e565b610a60565b005b61032660048036038101906103219190611a7e565b610afe565b6040516103339190611ad9565b60405180910390f35b61035660048036038101906103519190611a7e565b610be4565b6040516103639190611ad9565b60405180910390f35b61038660048036038101906103819190611c8b565b610bfb565b005b6103a2600480360381019061039d9190611d2d565b610f1b565b6040516103af9190611b03565b60405180910390f35b6103d260048036038101906103cd9190611bdc565b610fa2565b005b6060600380546103e390611d9c565b80601f016020809104026020016040519081016040528092919081815260200182805461040f90611d9c565b801561045c5780601f106104315761010080835404028352916020019161045c565b820191906000526020600020905b81548152906001019060200180831161043f57829003601f168201915b5050505050905090565b6000610473338484611161565b6001905092915050565b6000600254905090565b600061049484848461132a565b6000600160008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905082811015610558576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161054f90611e3f565b60405180910390fd5b61056e853385846105699190611e8e565b611161565b60019150509392505050565b6000600560009054906101000a900460ff16905090565b6000804690507f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f60036040516105c79190611f65565b60405180910390206040518060400160405280600181526020017f3100000000000000000000000000000000000000000000000000000000000000815250805190602001208330604051602001610622959493929190611f7c565b6040516020818303038152906040528051906020012091505090565b60008082600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020546106c99190611fcf565b90508281101561070e576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107059061204f565b60405180910390fd5b610719338583611161565b600191505092915050565b3373ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16146107b4576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107ab906120bb565b60405180910390fd5b6107be8282611552565b5050565b60008060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b3373ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff161461089a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610891906120bb565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a36000600560016101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b6000600660008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020549050919050565b6000600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b6060600480546109dd90611d9c565b80601f0160208091040260200160405190810160405280929190818152602001828054610a0990611d9c565b8015610a565780601f10610a2b57610100808354040283529160200191610a56565b820191906000526020600020905b815481529060010190602001808311610a3957829003601f168201915b5050505050905090565b3373ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614610af0576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ae7906120bb565b60405180910390fd5b610afa8282611692565b5050565b600080600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905082811015610bc3576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610bba9061214d565b60405180910390fd5b610bd933858584610bd49190611e8e565b611161565b600191505092915050565b6000610bf133848461132a565b6001905092915050565b83421115610c3e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c35906121b9565b60405180910390fd5b60007f6e71edae12b1b97f4d1f60370fef10105fa2faae0126114a169c64845d6126c9888888600660008d73ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000206000815480929190610cb4906121d9565b9190505589604051602001610cce96959493929190612221565b6040516020818303038152906040528051906020012090506000610cf0610591565b82604051602001610d029291906122fa565b6040516020818303038152906040528051906020012090507f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08360001c1115610d80576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d77906123a3565b60405180910390fd5b601b8560ff161480610d955750601c8560ff16145b610dd4576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610dcb90612435565b60405180910390fd5b600060018287878760405160008152602001604052604051610df99493929190612455565b6020604051602081039080840390855afa158015610e1b573d6000803e3d6000fd5b505050602060405103519050600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1603610e96576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610e8d906124e6565b60405180910390fd5b8973ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1614610f04576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610efb90612552565b60405180910390fd5b610f0f8a8a8a611161565b50505050505050505050565b6000600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b3373ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614611032576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611029906120bb565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16036110a1576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611098906125e4565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff16600560019054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a380600560016101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff16036111d0576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016111c790612676565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff160361123f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161123690612708565b60405180910390fd5b80600160008573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020819055508173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b9258360405161131d9190611b03565b60405180910390a3505050565b600073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603611399576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016113909061279a565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603611408576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016113ff9061282c565b60405180910390fd5b806000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020541015611489576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611480906128be565b60405180910390fd5b806000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546114d79190611e8e565b925050819055506114e8828261185a565b8173ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516115459190611b03565b60405180910390a3505050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff16036115c1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016115b89061292a565b60405180910390fd5b6000816002546115d19190611fcf565b905081811015611616576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161160d9061204f565b60405180910390fd5b80600281905550611627838361185a565b8273ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef846040516116859190611b03565b60405180910390a3505050565b600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff1603611701576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016116f8906129bc565b60405180910390fd5b806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020541015611782576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161177990612a4e565b60405180910390fd5b806000808473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546117d09190611e8e565b9250508190555080600260008282546117e99190611e8e565b92505081905550600073ffffffffffffffffffffffffffffffffffffffff168273ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405161184e9190611b03565b60405180910390a35050565b6000816000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020546118a69190611fcf565b9050818110156118eb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118e29061204f565b60405180910390fd5b806000808573ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002081905550505050565b600081519050919050565b600082825260208201905092915050565b60005b8381101561196d578082015181840152602081019050611952565b60008484015250505050565b6000601f19601f8301169050919050565b600061199582611933565b61199f818561193e565b93506119af81856020860161194f565b6119b881611979565b840191505092915050565b600060208201905081810360008301526119dd818461198a565b905092915050565b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b6000611a15826119ea565b9050919050565b611a2581611a0a565b8114611a3057600080fd5b50565b600081359050611a4281611a1c565b92915050565b6000819050919050565b611a5b81611a48565b8114611a6657600080fd5b50565b600081359050611a7881611a52565b92915050565b60008060408385031215611a9557611a946119e5565b5b6000611aa385828601611a33565b9250506020611ab485828601611a69565b9150509250929050565b60008115159050919050565b611ad381611abe565b82525050565b6000602082019050611aee6000830184611aca565b92915050565b611afd81611a48565b82525050565b6000602082019050611b186000830184611af4565b92915050565b600080600060608486031215611b3757611b366119e5565b5b6000611b4586828701611a33565b9350506020611b5686828701611a33565b9250506040611b6786828701611a69565b9150509250925092565b600060ff82169050919050565b611b8781611b71565b82525050565b6000602082019050611ba26000830184611b7e565b92915050565b6000819050919050565b611bbb81611ba8565b82525050565b6000602082019050611bd66000830184611bb2565b92915050565b600060208284031215611bf257611bf16119e5565b5b6000611c0084828501611a33565b91505092915050565b611c1281611a0a565b82525050565b6000602082019050611c2d6000830184611c09565b92915050565b611c3c81611b71565b8114611c4757600080fd5b50565b600081359050611c5981611c33565b92915050565b611c6881611ba8565b8114611c7357600080fd5b50565b600081359050611c8581611c5f565b92915050565b600080600080600080600060e0888a031215611caa57611ca96119e5565b5b6000611cb88a828b01611a33565b9750506020611cc98a828b01611a33565b9650506040611cda8a828b01611a69565b9550506060611ceb8a828b01611a69565b9450506080611cfc8a828b01611c4a565b93505060a0611d0d8a828b01611c76565b92505060c0611d1e8a828b01611c76565b91505092959891949750929550565b60008060408385031215611d4457611d436119e5565b5b6000611d5285828601611a33565b9250506020611d6385828601611a33565b9150509250929050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b60006002820490506001821680611db457607f821691505b602082108103611dc757611dc6611d6d565b5b50919050565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206160008201527f6c6c6f77616e6365000000000000000000000000000000000000000000000000602082015250565b6000611e2960288361193e565b9150611e3482611dcd565b604082019050919050565b60006020820190508181036000830152611e5881611e1c565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b6000611e9982611a48565b9150611ea483611a48565b9250828203905081811115611ebc57611ebb611e5f565b5b92915050565b600081905092915050565b60008190508160005260206000209050919050565b60008154611eef81611d9c565b611ef98186611ec2565b94506001821660008114611f145760018114611f2957611f5c565b60ff1983168652811515820286019350611f5c565b611f3285611ecd565b60005b83811015611f5457815481890152600182019150602081019050611f35565b838801955050505b50505092915050565b6000611f718284611ee2565b915081905092915050565b600060a082019050611f916000830188611bb2565b611f9e6020830187611bb2565b611fab6040830186611bb2565b611fb86060830185611af4565b611fc56080830184611c09565b9695505050505050565b6000611fda82611a48565b9150611fe583611a48565b9250828201905080821115611ffd57611ffc611e5f565b5b92915050565b7f536166654d6174683a206164646974696f6e206f766572666c6f770000000000600082015250565b6000612039601b8361193e565b915061204482612003565b602082019050919050565b600060208201905081810360008301526120688161202c565b9050919050565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572600082015250565b60006120a560208361193e565b91506120b08261206f565b602082019050919050565b600060208201905081810360008301526120d481612098565b9050919050565b7f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f7760008201527f207a65726f000000000000000000000000000000000000000000000000000000602082015250565b600061213760258361193e565b9150612142826120db565b604082019050919050565b600060208201905081810360008301526121668161212a565b9050919050565b7f45524332305065726d69743a206578706972656420646561646c696e65000000600082015250565b60006121a3601d8361193e565b91506121ae8261216d565b602082019050919050565b600060208201905081810360008301526121d281612196565b9050919050565b60006121e482611a48565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff820361221657612215611e5f565b5b600182019050919050565b600060c0820190506122366000830189611bb2565b6122436020830188611c09565b6122506040830187611c09565b61225d6060830186611af4565b61226a6080830185611af4565b61227760a0830184611af4565b979650505050505050565b600081905092915050565b7f1901000000000000000000000000000000000000000000000000000000000000600082015250565b60006122c3600283612282565b91506122ce8261228d565b600282019050919050565b6000819050919050565b6122f46122ef82611ba8565b6122d9565b82525050565b6000612305826122b6565b915061231182856122e3565b60208201915061232182846122e3565b6020820191508190509392505050565b7f45434453413a20696e76616c6964207369676e6174757265202773272076616c60008201527f7565000000000000000000000000000000000000000000000000000000000000602082015250565b600061238d60228361193e565b915061239882612331565b604082019050919050565b600060208201905081810360008301526123bc81612380565b9050919050565b7f45434453413a20696e76616c6964207369676e6174757265202776272076616c60008201527f7565000000000000000000000000000000000000000000000000000000000000602082015250565b600061241f60228361193e565b915061242a826123c3565b604082019050919050565b6000602082019050818103600083015261244e81612412565b9050919050565b600060808201905061246a6000830187611bb2565b6124776020830186611b7e565b6124846040830185611bb2565b6124916060830184611bb2565b95945050505050565b7f45434453413a20696e76616c6964207369676e61747572650000000000000000600082015250565b60006124d060188361193e565b91506124db8261249a565b602082019050919050565b600060208201905081810360008301526124ff816124c3565b9050919050565b7f45524332305065726d69743a20696e76616c6964207369676e61747572650000600082015250565b600061253c601e8361193e565b915061254782612506565b602082019050919050565b6000602082019050818103600083015261256b8161252f565b9050919050565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160008201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b60006125ce60268361193e565b91506125d982612572565b604082019050919050565b600060208201905081810360008301526125fd816125c1565b9050919050565b7f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460008201527f7265737300000000000000000000000000000000000000000000000000000000602082015250565b600061266060248361193e565b915061266b82612604565b604082019050919050565b6000602082019050818103600083015261268f81612653565b9050919050565b7f45524332303a20617070726f766520746f20746865207a65726f20616464726560008201527f7373000000000000000000000000000000000000000000000000000000000000602082015250565b60006126f260228361193e565b91506126fd82612696565b604082019050919050565b60006020820190508181036000830152612721816126e5565b9050919050565b7f45524332303a207472616e736665722066726f6d20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b600061278460258361193e565b915061278f82612728565b604082019050919050565b600060208201905081810360008301526127b381612777565b9050919050565b7f45524332303a207472616e7366657220746f20746865207a65726f206164647260008201527f6573730000000000000000000000000000000000000000000000000000000000602082015250565b600061281660238361193e565b9150612821826127ba565b604082019050919050565b6000602082019050818103600083015261284581612809565b9050919050565b7f45524332303a207472616e7366657220616d6f756e742065786365656473206260008201527f616c616e63650000000000000000000000000000000000000000000000000000602082015250565b60006128a860268361193e565b91506128b38261284c565b604082019050919050565b600060208201905081810360008301526128d78161289b565b9050919050565b7f45524332303a206d696e7420746f20746865207a65726f206164647265737300600082015250565b6000612914601f8361193e565b915061291f826128de565b602082019050919050565b6000602082019050818103600083015261294381612907565b9050919050565b7f45524332303a206275726e2066726f6d20746865207a65726f2061646472657360008201527f7300000000000000000000000000000000000000000000000000000000000000602082015250565b60006129a660218361193e565b91506129b18261294a565b604082019050919050565b600060208201905081810360008301526129d581612999565b9050919050565b7f45524332303a206275726e20616d6f756e7420657863656564732062616c616e60008201527f6365000000000000000000000000000000000000000000000000000000000000602082015250565b6000612a3860228361193e565b9150612a43826129dc565b604082019050919050565b60006020820190508181036000830152612a6781612a2b565b905091905056fea2646970667358221220b9b0fb7915f47cb551124d38b15d7f6b6415aa1f35e133d2fd4e77b860e0e44364736f6c63430008150033",
+}
+
+// DetailedPermitTokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use DetailedPermitTokenMetaData.ABI instead.
+var DetailedPermitTokenABI = DetailedPermitTokenMetaData.ABI
+
+// DetailedPermitTokenBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DetailedPermitTokenMetaData.Bin instead.
+var DetailedPermitTokenBin = DetailedPermitTokenMetaData.Bin
+
+// DeployDetailedPermitToken deploys a new Ethereum contract, binding an instance of DetailedPermitToken to it.
+func DeployDetailedPermitToken(auth *bind.TransactOpts, backend bind.ContractBackend, name_ string, symbol_ string, decimals_ uint8, _amount *big.Int, owner_ common.Address) (common.Address, *types.Transaction, *DetailedPermitToken, error) {
+	parsed, err := DetailedPermitTokenMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DetailedPermitTokenBin), backend, name_, symbol_, decimals_, _amount, owner_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DetailedPermitToken{DetailedPermitTokenCaller: DetailedPermitTokenCaller{contract: contract}, DetailedPermitTokenTransactor: DetailedPermitTokenTransactor{contract: contract}, DetailedPermitTokenFilterer: DetailedPermitTokenFilterer{contract: contract}}, nil
+}
+
+// DetailedPermitToken is an auto generated Go binding around an Ethereum contract.
+type DetailedPermitToken struct {
+	DetailedPermitTokenCaller     // Read-only binding to the contract
+	DetailedPermitTokenTransactor // Write-only binding to the contract
+	DetailedPermitTokenFilterer   // Log filterer for contract events
+}
+
+// DetailedPermitTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DetailedPermitTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DetailedPermitTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DetailedPermitTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DetailedPermitTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DetailedPermitTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DetailedPermitTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DetailedPermitTokenSession struct {
+	Contract     *DetailedPermitToken // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// DetailedPermitTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DetailedPermitTokenCallerSession struct {
+	Contract *DetailedPermitTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// DetailedPermitTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DetailedPermitTokenTransactorSession struct {
+	Contract     *DetailedPermitTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// DetailedPermitTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DetailedPermitTokenRaw struct {
+	Contract *DetailedPermitToken // Generic contract binding to access the raw methods on
+}
+
+// DetailedPermitTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DetailedPermitTokenCallerRaw struct {
+	Contract *DetailedPermitTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DetailedPermitTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DetailedPermitTokenTransactorRaw struct {
+	Contract *DetailedPermitTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDetailedPermitToken creates a new instance of DetailedPermitToken, bound to a specific deployed contract.
+func NewDetailedPermitToken(address common.Address, backend bind.ContractBackend) (*DetailedPermitToken, error) {
+	contract, err := bindDetailedPermitToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitToken{DetailedPermitTokenCaller: DetailedPermitTokenCaller{contract: contract}, DetailedPermitTokenTransactor: DetailedPermitTokenTransactor{contract: contract}, DetailedPermitTokenFilterer: DetailedPermitTokenFilterer{contract: contract}}, nil
+}
+
+// NewDetailedPermitTokenCaller creates a new read-only instance of DetailedPermitToken, bound to a specific deployed contract.
+func NewDetailedPermitTokenCaller(address common.Address, caller bind.ContractCaller) (*DetailedPermitTokenCaller, error) {
+	contract, err := bindDetailedPermitToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenCaller{contract: contract}, nil
+}
+
+// NewDetailedPermitTokenTransactor creates a new write-only instance of DetailedPermitToken, bound to a specific deployed contract.
+func NewDetailedPermitTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*DetailedPermitTokenTransactor, error) {
+	contract, err := bindDetailedPermitToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenTransactor{contract: contract}, nil
+}
+
+// NewDetailedPermitTokenFilterer creates a new log filterer instance of DetailedPermitToken, bound to a specific deployed contract.
+func NewDetailedPermitTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*DetailedPermitTokenFilterer, error) {
+	contract, err := bindDetailedPermitToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenFilterer{contract: contract}, nil
+}
+
+// bindDetailedPermitToken binds a generic wrapper to an already deployed contract.
+func bindDetailedPermitToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(DetailedPermitTokenABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DetailedPermitToken *DetailedPermitTokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DetailedPermitToken.Contract.DetailedPermitTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DetailedPermitToken *DetailedPermitTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.DetailedPermitTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DetailedPermitToken *DetailedPermitTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.DetailedPermitTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DetailedPermitToken *DetailedPermitTokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DetailedPermitToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DetailedPermitToken *DetailedPermitTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DetailedPermitToken *DetailedPermitTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "DOMAIN_SEPARATOR")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_DetailedPermitToken *DetailedPermitTokenSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _DetailedPermitToken.Contract.DOMAINSEPARATOR(&_DetailedPermitToken.CallOpts)
+}
+
+// DOMAINSEPARATOR is a free data retrieval call binding the contract method 0x3644e515.
+//
+// Solidity: function DOMAIN_SEPARATOR() view returns(bytes32)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) DOMAINSEPARATOR() ([32]byte, error) {
+	return _DetailedPermitToken.Contract.DOMAINSEPARATOR(&_DetailedPermitToken.CallOpts)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner_, address spender) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Allowance(opts *bind.CallOpts, owner_ common.Address, spender common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "allowance", owner_, spender)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner_, address spender) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Allowance(owner_ common.Address, spender common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.Allowance(&_DetailedPermitToken.CallOpts, owner_, spender)
+}
+
+// Allowance is a free data retrieval call binding the contract method 0xdd62ed3e.
+//
+// Solidity: function allowance(address owner_, address spender) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Allowance(owner_ common.Address, spender common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.Allowance(&_DetailedPermitToken.CallOpts, owner_, spender)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "balanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.BalanceOf(&_DetailedPermitToken.CallOpts, account)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address account) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) BalanceOf(account common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.BalanceOf(&_DetailedPermitToken.CallOpts, account)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "decimals")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Decimals() (uint8, error) {
+	return _DetailedPermitToken.Contract.Decimals(&_DetailedPermitToken.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0x313ce567.
+//
+// Solidity: function decimals() view returns(uint8)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Decimals() (uint8, error) {
+	return _DetailedPermitToken.Contract.Decimals(&_DetailedPermitToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Name() (string, error) {
+	return _DetailedPermitToken.Contract.Name(&_DetailedPermitToken.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Name() (string, error) {
+	return _DetailedPermitToken.Contract.Name(&_DetailedPermitToken.CallOpts)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner_) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Nonces(opts *bind.CallOpts, owner_ common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "nonces", owner_)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner_) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Nonces(owner_ common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.Nonces(&_DetailedPermitToken.CallOpts, owner_)
+}
+
+// Nonces is a free data retrieval call binding the contract method 0x7ecebe00.
+//
+// Solidity: function nonces(address owner_) view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Nonces(owner_ common.Address) (*big.Int, error) {
+	return _DetailedPermitToken.Contract.Nonces(&_DetailedPermitToken.CallOpts, owner_)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Owner() (common.Address, error) {
+	return _DetailedPermitToken.Contract.Owner(&_DetailedPermitToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Owner() (common.Address, error) {
+	return _DetailedPermitToken.Contract.Owner(&_DetailedPermitToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Symbol() (string, error) {
+	return _DetailedPermitToken.Contract.Symbol(&_DetailedPermitToken.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) Symbol() (string, error) {
+	return _DetailedPermitToken.Contract.Symbol(&_DetailedPermitToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DetailedPermitToken.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenSession) TotalSupply() (*big.Int, error) {
+	return _DetailedPermitToken.Contract.TotalSupply(&_DetailedPermitToken.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_DetailedPermitToken *DetailedPermitTokenCallerSession) TotalSupply() (*big.Int, error) {
+	return _DetailedPermitToken.Contract.TotalSupply(&_DetailedPermitToken.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "approve", spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Approve(&_DetailedPermitToken.TransactOpts, spender, amount)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address spender, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) Approve(spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Approve(&_DetailedPermitToken.TransactOpts, spender, amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9dc29fac.
+//
+// Solidity: function burn(address _from, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) Burn(opts *bind.TransactOpts, _from common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "burn", _from, _amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9dc29fac.
+//
+// Solidity: function burn(address _from, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenSession) Burn(_from common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Burn(&_DetailedPermitToken.TransactOpts, _from, _amount)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x9dc29fac.
+//
+// Solidity: function burn(address _from, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) Burn(_from common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Burn(&_DetailedPermitToken.TransactOpts, _from, _amount)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "decreaseAllowance", spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.DecreaseAllowance(&_DetailedPermitToken.TransactOpts, spender, subtractedValue)
+}
+
+// DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
+//
+// Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) DecreaseAllowance(spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.DecreaseAllowance(&_DetailedPermitToken.TransactOpts, spender, subtractedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "increaseAllowance", spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.IncreaseAllowance(&_DetailedPermitToken.TransactOpts, spender, addedValue)
+}
+
+// IncreaseAllowance is a paid mutator transaction binding the contract method 0x39509351.
+//
+// Solidity: function increaseAllowance(address spender, uint256 addedValue) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) IncreaseAllowance(spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.IncreaseAllowance(&_DetailedPermitToken.TransactOpts, spender, addedValue)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address _to, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) Mint(opts *bind.TransactOpts, _to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "mint", _to, _amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address _to, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenSession) Mint(_to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Mint(&_DetailedPermitToken.TransactOpts, _to, _amount)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x40c10f19.
+//
+// Solidity: function mint(address _to, uint256 _amount) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) Mint(_to common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Mint(&_DetailedPermitToken.TransactOpts, _to, _amount)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner_, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) Permit(opts *bind.TransactOpts, owner_ common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "permit", owner_, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner_, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_DetailedPermitToken *DetailedPermitTokenSession) Permit(owner_ common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Permit(&_DetailedPermitToken.TransactOpts, owner_, spender, value, deadline, v, r, s)
+}
+
+// Permit is a paid mutator transaction binding the contract method 0xd505accf.
+//
+// Solidity: function permit(address owner_, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) Permit(owner_ common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Permit(&_DetailedPermitToken.TransactOpts, owner_, spender, value, deadline, v, r, s)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DetailedPermitToken *DetailedPermitTokenSession) RenounceOwnership() (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.RenounceOwnership(&_DetailedPermitToken.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.RenounceOwnership(&_DetailedPermitToken.TransactOpts)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) Transfer(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "transfer", recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Transfer(&_DetailedPermitToken.TransactOpts, recipient, amount)
+}
+
+// Transfer is a paid mutator transaction binding the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) Transfer(recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.Transfer(&_DetailedPermitToken.TransactOpts, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) TransferFrom(opts *bind.TransactOpts, sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "transferFrom", sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.TransferFrom(&_DetailedPermitToken.TransactOpts, sender, recipient, amount)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address sender, address recipient, uint256 amount) returns(bool)
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) TransferFrom(sender common.Address, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.TransferFrom(&_DetailedPermitToken.TransactOpts, sender, recipient, amount)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _DetailedPermitToken.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DetailedPermitToken *DetailedPermitTokenSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.TransferOwnership(&_DetailedPermitToken.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_DetailedPermitToken *DetailedPermitTokenTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _DetailedPermitToken.Contract.TransferOwnership(&_DetailedPermitToken.TransactOpts, newOwner)
+}
+
+// DetailedPermitTokenApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the DetailedPermitToken contract.
+type DetailedPermitTokenApprovalIterator struct {
+	Event *DetailedPermitTokenApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DetailedPermitTokenApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DetailedPermitTokenApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DetailedPermitTokenApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DetailedPermitTokenApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DetailedPermitTokenApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DetailedPermitTokenApproval represents a Approval event raised by the DetailedPermitToken contract.
+type DetailedPermitTokenApproval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*DetailedPermitTokenApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.FilterLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenApprovalIterator{contract: _DetailedPermitToken.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *DetailedPermitTokenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var spenderRule []interface{}
+	for _, spenderItem := range spender {
+		spenderRule = append(spenderRule, spenderItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.WatchLogs(opts, "Approval", ownerRule, spenderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DetailedPermitTokenApproval)
+				if err := _DetailedPermitToken.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed spender, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) ParseApproval(log types.Log) (*DetailedPermitTokenApproval, error) {
+	event := new(DetailedPermitTokenApproval)
+	if err := _DetailedPermitToken.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DetailedPermitTokenOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the DetailedPermitToken contract.
+type DetailedPermitTokenOwnershipTransferredIterator struct {
+	Event *DetailedPermitTokenOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DetailedPermitTokenOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DetailedPermitTokenOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DetailedPermitTokenOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DetailedPermitTokenOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DetailedPermitTokenOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DetailedPermitTokenOwnershipTransferred represents a OwnershipTransferred event raised by the DetailedPermitToken contract.
+type DetailedPermitTokenOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*DetailedPermitTokenOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenOwnershipTransferredIterator{contract: _DetailedPermitToken.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *DetailedPermitTokenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DetailedPermitTokenOwnershipTransferred)
+				if err := _DetailedPermitToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) ParseOwnershipTransferred(log types.Log) (*DetailedPermitTokenOwnershipTransferred, error) {
+	event := new(DetailedPermitTokenOwnershipTransferred)
+	if err := _DetailedPermitToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// DetailedPermitTokenTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the DetailedPermitToken contract.
+type DetailedPermitTokenTransferIterator struct {
+	Event *DetailedPermitTokenTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *DetailedPermitTokenTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(DetailedPermitTokenTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(DetailedPermitTokenTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *DetailedPermitTokenTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *DetailedPermitTokenTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// DetailedPermitTokenTransfer represents a Transfer event raised by the DetailedPermitToken contract.
+type DetailedPermitTokenTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*DetailedPermitTokenTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &DetailedPermitTokenTransferIterator{contract: _DetailedPermitToken.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *DetailedPermitTokenTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _DetailedPermitToken.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(DetailedPermitTokenTransfer)
+				if err := _DetailedPermitToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_DetailedPermitToken *DetailedPermitTokenFilterer) ParseTransfer(log types.Log) (*DetailedPermitTokenTransfer, error) {
+	event := new(DetailedPermitTokenTransfer)
+	if err := _DetailedPermitToken.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,477 @@
+package detailed_test_token
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	ethacc "go-evm-client/pkg/eth_account"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IPermitInstance contains the EIP-2612 functions of the DetailedPermitToken,
+// its ERC20 functions are called through the DetailedTestToken instance
+type IPermitInstance interface {
+	Permit(
+		opts *bind.TransactOpts,
+		owner common.Address,
+		spender common.Address,
+		value *big.Int,
+		deadline *big.Int,
+		v uint8,
+		r [32]byte,
+		s [32]byte,
+	) (*types.Transaction, error)
+	Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// DetailedPermitTokenContract contains all the data needed to deploy and
+// interact with the DetailedPermitToken contract. Its ABI extends the
+// DetailedTestToken one with EIP-2612 permits, so the ERC20 functions and
+// events are handled by the embedded DetailedTestTokenContract.
+type DetailedPermitTokenContract struct {
+	DetailedTestTokenContract
+	permitQueriableContractData
+	PermitInstance IPermitInstance
+	// Signer is the account signing the permits as the owner of the tokens
+	Signer *ethacc.UserAccount
+	// Relayer submits the permits signed by the Signer, nil when the
+	// permits are only printed
+	Relayer *bind.TransactOpts
+}
+
+// defaultPermitValidity is how long a permit stays valid when it is
+// signed without a deadline
+const defaultPermitValidity = time.Hour
+
+// permitTypeHash is the EIP-712 type hash of the EIP-2612 Permit message
+var permitTypeHash = crypto.Keccak256([]byte("Permit(address owner," +
+	"address spender,uint256 value,uint256 nonce,uint256 deadline)"))
+
+// PermitSignature is an EIP-2612 permit signed by the owner of the tokens,
+// any account can submit it to set the allowance of the spender
+type PermitSignature struct {
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+	V        uint8
+	R        [32]byte
+	S        [32]byte
+}
+
+// permitQueriableContractData is a struct that holds the EIP-2612 data
+// that can be queried from the contract, together with the last permit
+type permitQueriableContractData struct {
+	Nonces          map[common.Address]*big.Int
+	DomainSeparator [32]byte
+	Permit          *PermitSignature
+}
+
+// DeployContract deploys the detailed permit token contract and saves
+// its instances, tx of deployment and contract address
+func (d *DetailedPermitTokenContract) DeployContract(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+) error {
+	address, tx, instance, err := DeployDetailedPermitToken(
+		auth,
+		client,
+		d.ConstructorArgs.name,
+		d.ConstructorArgs.symbol,
+		d.ConstructorArgs.decimals,
+		d.ConstructorArgs.amount,
+		d.owner(auth))
+	if err != nil {
+		return err
+	}
+	erc20Instance, err1 := NewDetailedTestToken(address, client)
+	if err1 != nil {
+		return err1
+	}
+	d.Address = address
+	d.LastTx = tx
+	d.Instance = erc20Instance
+	d.PermitInstance = instance
+	return nil
+}
+
+// DeploymentCode returns the creation bytecode of the detailed permit
+// token contract followed by the packed constructor arguments. The owner
+// must be given since the deployer isn't known.
+func (d *DetailedPermitTokenContract) DeploymentCode() ([]byte, error) {
+	err := checkOwner(d.ConstructorArgs.owner, "DetailedPermitToken")
+	if err != nil {
+		return nil, err
+	}
+	parsed, err1 := DetailedPermitTokenMetaData.GetAbi()
+	if err1 != nil {
+		return nil, err1
+	}
+	args, err2 := parsed.Pack("", d.ConstructorArgs.name,
+		d.ConstructorArgs.symbol, d.ConstructorArgs.decimals,
+		d.ConstructorArgs.amount, d.ConstructorArgs.owner)
+	if err2 != nil {
+		return nil, err2
+	}
+	return append(common.FromHex(DetailedPermitTokenMetaData.Bin), args...), nil
+}
+
+// DeployContractCreate2 deploys the detailed permit token contract through
+// the CREATE2 factory and saves its instances, tx of deployment and
+// contract address
+func (d *DetailedPermitTokenContract) DeployContractCreate2(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer cc.Create2Deployer,
+) error {
+	initCode, err := d.DeploymentCode()
+	if err != nil {
+		return err
+	}
+	address, tx, err1 := deployer.Deploy(auth, initCode)
+	if err1 != nil {
+		return err1
+	}
+	erc20Instance, err2 := NewDetailedTestToken(address, client)
+	if err2 != nil {
+		return err2
+	}
+	instance, err3 := NewDetailedPermitToken(address, client)
+	if err3 != nil {
+		return err3
+	}
+	d.Address = address
+	d.LastTx = tx
+	d.Instance = erc20Instance
+	d.PermitInstance = instance
+	return nil
+}
+
+// LoadContract loads the detailed permit token contract and saves
+// its instances, contract address
+func (d *DetailedPermitTokenContract) LoadContract(
+	address *common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	err := d.DetailedTestTokenContract.LoadContract(address, client)
+	if err != nil {
+		return err
+	}
+	instance, err1 := NewDetailedPermitToken(*address, client)
+	if err1 != nil {
+		return err1
+	}
+	d.PermitInstance = instance
+	d.Nonces = map[common.Address]*big.Int{}
+	return nil
+}
+
+// SetSigner sets the account signing the permits and the relayer which
+// submits them
+func (d *DetailedPermitTokenContract) SetSigner(
+	account *ethacc.UserAccount,
+	relayer *bind.TransactOpts,
+) {
+	d.Signer = account
+	d.Relayer = relayer
+}
+
+// PrintDeploymentData outputs to the terminal the address and
+// transaction of the deployed contract.
+func (d *DetailedPermitTokenContract) PrintDeploymentData() {
+	fmt.Printf("Detailed Permit Token Contract successfully deployed at %s, "+
+		"see transaction here %s \n", d.Address.Hex(), d.LastTx.Hash().Hex())
+}
+
+// PrintLoadedContractData outs the success message of loading the
+// contract as well as the address it's loaded at.
+func (d *DetailedPermitTokenContract) PrintLoadedContractData() {
+	fmt.Printf("Detailed Permit Token Contract successfully loaded at %s \n",
+		d.Address.Hex())
+}
+
+// WriteContract executes write transaction which invokes a state change
+// in the DetailedPermitToken contract. Given the spender, the amount and
+// an optional deadline, permit signs the permit with the Signer and
+// submits it from the Relayer. Given the owner, spender, amount, deadline
+// and the v, r and s of a signature made by the owner, permit relays it
+// from the user account. The ERC20 functions are executed by the
+// DetailedTestTokenContract.
+func (d *DetailedPermitTokenContract) WriteContract(
+	auth *bind.TransactOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "permit":
+		permit, submitter := (*PermitSignature)(nil), auth
+		if len(funcArgs) == 7 {
+			relayed, err := d.parseSignedPermit(funcArgs)
+			if err != nil {
+				return err
+			}
+			permit = relayed
+		} else {
+			if d.Relayer == nil {
+				return fmt.Errorf("error: a relayer is needed to submit the " +
+					"permit, pass its private key with --relayer or use " +
+					"signpermit to only print the signature")
+			}
+			signed, err1 := d.signPermit(nil, funcArgs)
+			if err1 != nil {
+				return err1
+			}
+			permit, submitter = signed, d.Relayer
+		}
+		tx, err2 := d.PermitInstance.Permit(submitter, permit.Owner,
+			permit.Spender, permit.Value, permit.Deadline, permit.V, permit.R,
+			permit.S)
+		if err2 != nil {
+			return err2
+		}
+		d.LastTx = tx
+		d.Permit = permit
+		d.StrToPrint = fmt.Sprintf("info: Submitted Permit of %d tokens "+
+			"from owner %s to spender %s at DetailedPermitToken (%s) from "+
+			"address %s\n", permit.Value, permit.Owner, permit.Spender,
+			d.Address, submitter.From)
+	default:
+		return d.DetailedTestTokenContract.WriteContract(auth, funcName,
+			funcArgs)
+	}
+	return nil
+}
+
+// QueryContract executes query functions which do not invoke a state
+// change in the DetailedPermitToken contract. On top of the ERC20 queries
+// executed by the DetailedTestTokenContract, nonces and domainseparator
+// read the EIP-2612 data while signpermit signs a permit off chain with
+// the Signer and prints it for a relayer.
+func (d *DetailedPermitTokenContract) QueryContract(
+	opts *bind.CallOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "nonces":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		owner, err1 := d.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		nonce, err2 := d.PermitInstance.Nonces(opts, owner)
+		if err2 != nil {
+			return err2
+		}
+		d.Nonces[owner] = nonce
+		d.StrToPrint = fmt.Sprintf("info: Permit Nonce of %s : %d for "+
+			"DetailedPermitToken (%s)\n", owner, nonce, d.Address)
+	case "domainseparator":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		domainSeparator, err1 := d.PermitInstance.DOMAINSEPARATOR(opts)
+		if err1 != nil {
+			return err1
+		}
+		d.DomainSeparator = domainSeparator
+		d.StrToPrint = fmt.Sprintf("info: Domain Separator: %s for "+
+			"DetailedPermitToken (%s)\n", hexutil.Encode(domainSeparator[:]),
+			d.Address)
+	case "signpermit":
+		permit, err := d.signPermit(opts, funcArgs)
+		if err != nil {
+			return err
+		}
+		err1 := d.LoadDenomination(opts)
+		if err1 != nil {
+			return err1
+		}
+		d.Permit = permit
+		d.StrToPrint = fmt.Sprintf("info: Signed Permit of %s from owner %s "+
+			"to spender %s for DetailedPermitToken (%s)\n"+
+			"info: Nonce: %d\n"+
+			"info: Deadline: %d (%s)\n"+
+			"info: v: %d\n"+
+			"info: r: %s\n"+
+			"info: s: %s\n"+
+			"info: Relay it with the permit function and the arguments: "+
+			"%s %s %d %d %d %s %s\n", d.FormatAmount(permit.Value),
+			permit.Owner, permit.Spender, d.Address, permit.Nonce,
+			permit.Deadline, time.Unix(permit.Deadline.Int64(), 0).UTC().Format(
+				time.RFC3339), permit.V, hexutil.Encode(permit.R[:]),
+			hexutil.Encode(permit.S[:]), permit.Owner, permit.Spender,
+			permit.Value, permit.Deadline, permit.V,
+			hexutil.Encode(permit.R[:]), hexutil.Encode(permit.S[:]))
+	default:
+		return d.DetailedTestTokenContract.QueryContract(opts, funcName,
+			funcArgs)
+	}
+	return nil
+}
+
+// signPermit signs with the Signer the EIP-2612 permit allowing the
+// spender to use the amount until the deadline, which defaults to an hour
+// from now. The nonce of the Signer and the EIP-712 domain separator are
+// read from the contract.
+func (d *DetailedPermitTokenContract) signPermit(
+	opts *bind.CallOpts,
+	funcArgs []string,
+) (*PermitSignature, error) {
+	if len(funcArgs) != 3 {
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if d.Signer == nil {
+		return nil, fmt.Errorf("error: permits can only be signed by the " +
+			"account of the private key")
+	}
+	spender, err1 := d.ArgParser.ParseAddress(funcArgs[0])
+	if err1 != nil {
+		return nil, err1
+	}
+	value, err2 := d.ParseAmount(funcArgs[1])
+	if err2 != nil {
+		return nil, err2
+	}
+	deadline := big.NewInt(time.Now().Add(defaultPermitValidity).Unix())
+	if len(funcArgs) == 3 {
+		parsed, err3 := parseDeadline(funcArgs[2], time.Now())
+		if err3 != nil {
+			return nil, err3
+		}
+		deadline = parsed
+	}
+	owner := d.Signer.Account
+	nonce, err4 := d.PermitInstance.Nonces(opts, owner)
+	if err4 != nil {
+		return nil, err4
+	}
+	domainSeparator, err5 := d.PermitInstance.DOMAINSEPARATOR(opts)
+	if err5 != nil {
+		return nil, err5
+	}
+	digest := permitDigest(domainSeparator, owner, spender, value, nonce,
+		deadline)
+	signature, err6 := crypto.Sign(digest, d.Signer.PrivateKey)
+	if err6 != nil {
+		return nil, err6
+	}
+	permit := &PermitSignature{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Nonce:    nonce,
+		Deadline: deadline,
+		// The recovery id is 0 or 1 while ecrecover expects 27 or 28
+		V: signature[64] + 27,
+	}
+	copy(permit.R[:], signature[:32])
+	copy(permit.S[:], signature[32:64])
+	d.Nonces[owner] = nonce
+	d.DomainSeparator = domainSeparator
+	return permit, nil
+}
+
+// parseSignedPermit converts the owner, spender, amount, deadline and the
+// v, r and s of a permit signed by the owner
+func (d *DetailedPermitTokenContract) parseSignedPermit(
+	funcArgs []string,
+) (*PermitSignature, error) {
+	owner, err := d.ArgParser.ParseAddress(funcArgs[0])
+	if err != nil {
+		return nil, err
+	}
+	spender, err1 := d.ArgParser.ParseAddress(funcArgs[1])
+	if err1 != nil {
+		return nil, err1
+	}
+	value, err2 := d.ParseAmount(funcArgs[2])
+	if err2 != nil {
+		return nil, err2
+	}
+	deadline, err3 := parseTimestamp(funcArgs[3])
+	if err3 != nil {
+		return nil, err3
+	}
+	v, err4 := strconv.ParseUint(strings.TrimSpace(funcArgs[4]), 10, 8)
+	if err4 != nil {
+		return nil, fmt.Errorf("error: %q is not a valid signature v", funcArgs[4])
+	}
+	permit := &PermitSignature{
+		Owner:    owner,
+		Spender:  spender,
+		Value:    value,
+		Deadline: deadline,
+		V:        uint8(v),
+	}
+	for i, part := range []*[32]byte{&permit.R, &permit.S} {
+		word, err5 := hexutil.Decode(strings.TrimSpace(funcArgs[5+i]))
+		if err5 != nil || len(word) != 32 {
+			return nil, fmt.Errorf("error: %q is not a valid 32 byte "+
+				"signature part", funcArgs[5+i])
+		}
+		copy(part[:], word)
+	}
+	return permit, nil
+}
+
+// permitDigest is the EIP-712 hash of the Permit message which the owner
+// of the tokens signs
+func permitDigest(
+	domainSeparator [32]byte,
+	owner common.Address,
+	spender common.Address,
+	value *big.Int,
+	nonce *big.Int,
+	deadline *big.Int,
+) []byte {
+	structHash := crypto.Keccak256(permitTypeHash,
+		common.LeftPadBytes(owner.Bytes(), 32),
+		common.LeftPadBytes(spender.Bytes(), 32),
+		common.LeftPadBytes(value.Bytes(), 32),
+		common.LeftPadBytes(nonce.Bytes(), 32),
+		common.LeftPadBytes(deadline.Bytes(), 32))
+	return crypto.Keccak256([]byte("\x19\x01"), domainSeparator[:],
+		structHash)
+}
+
+// parseDeadline converts the deadline of a permit given either as a unix
+// timestamp in seconds or as a duration from now such as 30m or 24h
+func parseDeadline(arg string, now time.Time) (*big.Int, error) {
+	validity, err := time.ParseDuration(strings.TrimSpace(arg))
+	if err != nil {
+		return parseTimestamp(arg)
+	}
+	if validity <= 0 {
+		return nil, fmt.Errorf("error: deadline %q must be in the future", arg)
+	}
+	return big.NewInt(now.Add(validity).Unix()), nil
+}
+
+// parseTimestamp converts a unix timestamp in seconds
+func parseTimestamp(arg string) (*big.Int, error) {
+	timestamp, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 63)
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not a valid deadline, use a "+
+			"unix timestamp or a duration such as 30m", arg)
+	}
+	return new(big.Int).SetUint64(timestamp), nil
+}
@@ -0,0 +1,368 @@
+package detailed_test_token
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	ethacc "go-evm-client/pkg/eth_account"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"testing"
+	"time"
+)
+
+type MockPermitInstance struct {
+	mock.Mock
+}
+
+func (m *MockPermitInstance) Permit(
+	opts *bind.TransactOpts,
+	owner common.Address,
+	spender common.Address,
+	value *big.Int,
+	deadline *big.Int,
+	v uint8,
+	r [32]byte,
+	s [32]byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, owner, spender, value, deadline, v, r, s)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockPermitInstance) Nonces(
+	_ *bind.CallOpts,
+	owner common.Address,
+) (*big.Int, error) {
+	args := m.Called(nil, owner)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockPermitInstance) DOMAINSEPARATOR(_ *bind.CallOpts) ([32]byte, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).([32]byte), args.Error(1)
+}
+
+// testDomainSeparator is the domain separator returned by the mocked contract
+var testDomainSeparator = [32]byte{0x6a, 0x58, 0x62, 0x5f}
+
+// newPermitContract creates a permit contract whose signer is the account
+// of the private key 0x...01
+func newPermitContract(
+	t *testing.T,
+	relayer *bind.TransactOpts,
+) (*DetailedPermitTokenContract, *MockPermitInstance, *MockContractInstance) {
+	signer, err := ethacc.CreateAccount(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	assert.NoError(t, err)
+	mPermitInstance := new(MockPermitInstance)
+	mInstance := new(MockContractInstance)
+	dptc := &DetailedPermitTokenContract{}
+	dptc.Instance = mInstance
+	dptc.PermitInstance = mPermitInstance
+	dptc.Nonces = map[common.Address]*big.Int{}
+	dptc.SetSigner(signer, relayer)
+	return dptc, mPermitInstance, mInstance
+}
+
+// recoverPermitSigner recovers the account which signed the permit
+func recoverPermitSigner(t *testing.T, permit *PermitSignature) common.Address {
+	digest := permitDigest(testDomainSeparator, permit.Owner, permit.Spender,
+		permit.Value, permit.Nonce, permit.Deadline)
+	signature := append(append(permit.R[:], permit.S[:]...), permit.V-27)
+	publicKey, err := crypto.SigToPub(digest, signature)
+	assert.NoError(t, err)
+	return crypto.PubkeyToAddress(*publicKey)
+}
+
+func TestQueryPermitContractSignPermitMethod(t *testing.T) {
+	owner := common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	spender := common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	tests := []struct {
+		testName         string
+		funcArgs         []string
+		expectedValue    *big.Int
+		expectedDeadline *big.Int
+		expectedError    error
+	}{
+		{
+			testName:         "QueryContract func SignPermit successful with a timestamp deadline.",
+			funcArgs:         []string{spender.Hex(), "1.5", "1700000000"},
+			expectedValue:    big.NewInt(1500000000000000000),
+			expectedDeadline: big.NewInt(1700000000),
+			expectedError:    nil,
+		},
+		{
+			testName:         "QueryContract func SignPermit successful with the default deadline.",
+			funcArgs:         []string{spender.Hex(), "100"},
+			expectedValue: new(big.Int).Mul(big.NewInt(100),
+				big.NewInt(1000000000000000000)),
+			expectedDeadline: nil,
+			expectedError:    nil,
+		},
+		{
+			testName: "QueryContract func SignPermit fail arg len validation.",
+			funcArgs: []string{spender.Hex()},
+			expectedError: errors.New("error: 1 arguments does not match " +
+				"required 2"),
+		},
+		{
+			testName: "QueryContract func SignPermit fail past duration deadline.",
+			funcArgs: []string{spender.Hex(), "100", "-5m"},
+			expectedError: errors.New("error: deadline \"-5m\" must be in " +
+				"the future"),
+		},
+		{
+			testName: "QueryContract func SignPermit fail invalid deadline.",
+			funcArgs: []string{spender.Hex(), "100", "tomorrow"},
+			expectedError: errors.New("error: \"tomorrow\" is not a valid " +
+				"deadline, use a unix timestamp or a duration such as 30m"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			dptc, mPermitInstance, mInstance := newPermitContract(t, nil)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("PMT", nil)
+			mPermitInstance.On("Nonces", nil, owner).Return(big.NewInt(3), nil)
+			mPermitInstance.On("DOMAINSEPARATOR", nil).Return(
+				testDomainSeparator, nil)
+			before := time.Now().Add(defaultPermitValidity).Unix()
+			err := dptc.QueryContract(nil, "signpermit", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, dptc.Permit)
+				return
+			}
+			assert.NoError(t, err)
+			permit := dptc.Permit
+			assert.Equal(t, permit.Owner, owner)
+			assert.Equal(t, permit.Spender, spender)
+			assert.Equal(t, permit.Value, tt.expectedValue)
+			assert.Equal(t, permit.Nonce, big.NewInt(3))
+			if tt.expectedDeadline != nil {
+				assert.Equal(t, permit.Deadline, tt.expectedDeadline)
+			} else {
+				assert.True(t, permit.Deadline.Int64() >= before)
+				assert.True(t, permit.Deadline.Int64() <=
+					time.Now().Add(defaultPermitValidity).Unix())
+			}
+			assert.Equal(t, recoverPermitSigner(t, permit), owner)
+			assert.Equal(t, dptc.Nonces[owner], big.NewInt(3))
+			assert.Equal(t, dptc.DomainSeparator, testDomainSeparator)
+			assert.Contains(t, dptc.StrToPrint, fmt.Sprintf("info: v: %d\n"+
+				"info: r: %s\ninfo: s: %s\n", permit.V,
+				hexutil.Encode(permit.R[:]), hexutil.Encode(permit.S[:])))
+			assert.Contains(t, dptc.StrToPrint, fmt.Sprintf("info: Relay it "+
+				"with the permit function and the arguments: %s %s %d %d %d "+
+				"%s %s\n", owner.Hex(), spender.Hex(), tt.expectedValue,
+				permit.Deadline, permit.V, hexutil.Encode(permit.R[:]),
+				hexutil.Encode(permit.S[:])))
+			mPermitInstance.AssertNotCalled(t, "Permit", mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestWritePermitContractPermitMethod(t *testing.T) {
+	owner := common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	spender := common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	r := "0xd346748ebbe160694753742844d1500e04583b281c9bbeb16065e4afe42e7f75"
+	s := "0x204f2c0c237e28fa455b348fdafb43aeef8e5629a9de44a6f83937ab44def9d5"
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		withRelayer   bool
+		relayed       bool
+		strToPrint    string
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName:    "WriteContract func Permit signed and submitted by the relayer.",
+			funcArgs:    []string{spender.Hex(), "2", "1700000000"},
+			withRelayer: true,
+			strToPrint: "info: Submitted Permit of 2 tokens from owner " +
+				"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf to spender " +
+				"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF at " +
+				"DetailedPermitToken (0x0000000000000000000000000000000000000000) " +
+				"from address 0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF\n",
+			expectedError: nil,
+			expectedTx:    &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func Permit relays a signed permit from the user account.",
+			funcArgs: []string{owner.Hex(), spender.Hex(), "2", "1700000000",
+				"28", r, s},
+			relayed: true,
+			strToPrint: "info: Submitted Permit of 2 tokens from owner " +
+				"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf to spender " +
+				"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF at " +
+				"DetailedPermitToken (0x0000000000000000000000000000000000000000) " +
+				"from address 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df\n",
+			expectedError: nil,
+			expectedTx:    &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func Permit fail without relayer.",
+			funcArgs: []string{spender.Hex(), "2"},
+			expectedError: errors.New("error: a relayer is needed to submit " +
+				"the permit, pass its private key with --relayer or use " +
+				"signpermit to only print the signature"),
+		},
+		{
+			testName: "WriteContract func Permit fail invalid signature part.",
+			funcArgs: []string{owner.Hex(), spender.Hex(), "2", "1700000000",
+				"28", r, "0x12"},
+			expectedError: errors.New("error: \"0x12\" is not a valid 32 byte " +
+				"signature part"),
+		},
+		{
+			testName: "WriteContract func Permit fail invalid signature v.",
+			funcArgs: []string{owner.Hex(), spender.Hex(), "2", "1700000000",
+				"300", r, s},
+			expectedError: errors.New("error: \"300\" is not a valid " +
+				"signature v"),
+		},
+		{
+			testName:      "WriteContract func Permit instance failure.",
+			funcArgs:      []string{spender.Hex(), "2", "1700000000"},
+			withRelayer:   true,
+			expectedError: errors.New("error: something bad happened"),
+			expectedTx:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{
+				From: common.HexToAddress(
+					"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"),
+			}
+			var relayer *bind.TransactOpts
+			if tt.withRelayer {
+				relayer = &bind.TransactOpts{From: spender}
+			}
+			dptc, mPermitInstance, mInstance := newPermitContract(t, relayer)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("PMT", nil)
+			mPermitInstance.On("Nonces", nil, owner).Return(big.NewInt(0), nil)
+			mPermitInstance.On("DOMAINSEPARATOR", nil).Return(
+				testDomainSeparator, nil)
+			mPermitInstance.On("Permit", mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+				mock.Anything, mock.Anything).Return(tt.expectedTx,
+				tt.expectedError)
+			err := dptc.WriteContract(auth, "permit", tt.funcArgs)
+			if err != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			}
+			assert.Equal(t, dptc.LastTx, tt.expectedTx)
+			assert.Equal(t, dptc.StrToPrint, tt.strToPrint)
+			if tt.expectedTx == nil {
+				return
+			}
+			permit := dptc.Permit
+			submitter := relayer
+			if tt.relayed {
+				submitter = auth
+				var rWord, sWord [32]byte
+				copy(rWord[:], hexutil.MustDecode(r))
+				copy(sWord[:], hexutil.MustDecode(s))
+				assert.Equal(t, permit.V, uint8(28))
+				assert.Equal(t, permit.R, rWord)
+				assert.Equal(t, permit.S, sWord)
+			} else {
+				permit.Nonce = big.NewInt(0)
+				assert.Equal(t, recoverPermitSigner(t, permit), owner)
+			}
+			mPermitInstance.AssertCalled(t, "Permit", submitter, owner,
+				spender, big.NewInt(2), big.NewInt(1700000000), permit.V,
+				permit.R, permit.S)
+		})
+	}
+}
+
+func TestQueryPermitContractNoncesMethod(t *testing.T) {
+	owner := common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	dptc, mPermitInstance, _ := newPermitContract(t, nil)
+	mPermitInstance.On("Nonces", nil, owner).Return(big.NewInt(4), nil)
+	err := dptc.QueryContract(nil, "nonces", []string{owner.Hex()})
+	assert.NoError(t, err)
+	assert.Equal(t, dptc.Nonces[owner], big.NewInt(4))
+	assert.Equal(t, dptc.StrToPrint, "info: Permit Nonce of "+
+		"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf : 4 for "+
+		"DetailedPermitToken (0x0000000000000000000000000000000000000000)\n")
+}
+
+func TestQueryPermitContractERC20Method(t *testing.T) {
+	account := common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	dptc, mPermitInstance, mInstance := newPermitContract(t, nil)
+	dptc.BalanceOf = map[common.Address]*big.Int{}
+	mInstance.On("BalanceOf", nil, account).Return(big.NewInt(5), nil)
+	mInstance.On("Decimals", nil).Return(uint8(18), nil)
+	mInstance.On("Symbol", nil).Return("PMT", nil)
+	err := dptc.QueryContract(nil, "balanceof", []string{account.Hex()})
+	assert.NoError(t, err)
+	assert.Equal(t, dptc.BalanceOf[account], big.NewInt(5))
+	mPermitInstance.AssertNotCalled(t, "Nonces", nil, account)
+}
+
+func TestPermitContractSimulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+	signer, err1 := ethacc.CreateAccount(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	assert.NoError(t, err1)
+	holder := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+
+	// A token without decimals keeps the amounts below in base units
+	dptc := &DetailedPermitTokenContract{}
+	err2 := dptc.ParseConstructorArguments([]string{"Detailed Permit Token",
+		"DPT", "0", "1000"})
+	assert.NoError(t, err2)
+	err3 := dptc.DeployContract(auth, client)
+	assert.NoError(t, err3)
+	err4 := dptc.LoadContract(&dptc.Address, client)
+	assert.NoError(t, err4)
+
+	// The deployer owns the contract, mints and sends tokens to the signer
+	err5 := dptc.WriteContract(auth, "mint", []string{auth.From.Hex(), "500"})
+	assert.NoError(t, err5)
+	err6 := dptc.WriteContract(auth, "transfer", []string{
+		signer.Account.Hex(), "400"})
+	assert.NoError(t, err6)
+
+	// The signer, which holds no ether, lets the deployer relay its permit
+	// and spend its tokens
+	dptc.SetSigner(signer, auth)
+	err7 := dptc.WriteContract(auth, "permit", []string{auth.From.Hex(),
+		"150"})
+	assert.NoError(t, err7)
+	err8 := dptc.QueryContract(nil, "allowance", []string{
+		signer.Account.Hex(), auth.From.Hex()})
+	assert.NoError(t, err8)
+	assert.Equal(t, dptc.Allowance[signer.Account][auth.From],
+		big.NewInt(150))
+	err9 := dptc.QueryContract(nil, "nonces", []string{signer.Account.Hex()})
+	assert.NoError(t, err9)
+	assert.Equal(t, dptc.Nonces[signer.Account], big.NewInt(1))
+
+	err10 := dptc.WriteContract(auth, "transferfrom", []string{
+		signer.Account.Hex(), holder.Hex(), "150"})
+	assert.NoError(t, err10)
+	for account, balance := range map[common.Address]int64{
+		auth.From: 1100, signer.Account: 250, holder: 150} {
+		err11 := dptc.QueryContract(nil, "balanceof", []string{account.Hex()})
+		assert.NoError(t, err11)
+		assert.Equal(t, dptc.BalanceOf[account], big.NewInt(balance))
+	}
+}
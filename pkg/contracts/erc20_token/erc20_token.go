@@ -0,0 +1,707 @@
+package erc20_token
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"math/big"
+)
+
+// erc20ABI holds the ERC20 view functions, transfer and events together
+// with the Ownable owner function and event, they are shared by every token
+const erc20ABI = `[
+	{"inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],"name":"allowance","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"owner","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"previousOwner","type":"address"},{"indexed":true,"name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"}
+]`
+
+// ERC20MetaData contains the ERC20 ABI shared by the token contracts, the
+// bulk queries and the load generator, it is parsed once
+var ERC20MetaData = &bind.MetaData{
+	ABI: erc20ABI,
+}
+
+// IInstance is the interface of the ERC20 and Ownable functions shared by
+// the token contracts
+type IInstance interface {
+	Transfer(
+		opts *bind.TransactOpts,
+		recipient common.Address,
+		amount *big.Int,
+	) (*types.Transaction, error)
+	Approve(
+		opts *bind.TransactOpts,
+		spender common.Address,
+		amount *big.Int,
+	) (*types.Transaction, error)
+	TransferFrom(
+		opts *bind.TransactOpts,
+		sender common.Address,
+		recipient common.Address,
+		amount *big.Int,
+	) (*types.Transaction, error)
+	IncreaseAllowance(
+		opts *bind.TransactOpts,
+		spender common.Address,
+		addedValue *big.Int,
+	) (*types.Transaction, error)
+	DecreaseAllowance(
+		opts *bind.TransactOpts,
+		spender common.Address,
+		subtractedValue *big.Int,
+	) (*types.Transaction, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	Decimals(opts *bind.CallOpts) (uint8, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Allowance(
+		opts *bind.CallOpts,
+		owner common.Address,
+		spender common.Address,
+	) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IEvents is the interface needed to read and decode the token events,
+// it is satisfied by bind.BoundContract
+type IEvents interface {
+	FilterLogs(
+		opts *bind.FilterOpts,
+		name string,
+		query ...[]interface{},
+	) (chan types.Log, event.Subscription, error)
+	WatchLogs(
+		opts *bind.WatchOpts,
+		name string,
+		query ...[]interface{},
+	) (chan types.Log, event.Subscription, error)
+	UnpackLog(out interface{}, event string, log types.Log) error
+}
+
+// Token contains the data and the functions shared by the ERC20 token
+// contracts, their controllers embed it
+type Token struct {
+	// ContractName names the token contract in the printed data
+	ContractName string
+	Name         string
+	Symbol       string
+	Decimals     uint8
+	TotalSupply  *big.Int
+	BalanceOf    map[common.Address]*big.Int
+	Allowance    map[common.Address]map[common.Address]*big.Int
+	Owner        common.Address
+	CodeSize     int
+	Creation     *cc.CreationInfo
+	Address      common.Address
+	Instance     IInstance
+	// Events reads the Transfer, Approval and OwnershipTransferred events
+	Events     IEvents
+	StrToPrint string
+	// ArgParser validates the address arguments, nil uses strict defaults
+	ArgParser *utils.ArgParser
+	// Client reads the code and the creation transaction of the contract
+	Client cc.IInfoClient
+	// Deployments holds the deployments recorded by the contract deployer,
+	// nil looks up the creation transaction through the events only
+	Deployments cc.IDeployments
+	// Balances reads the native balance of the sender for the pre-flight
+	// checks of the transfers, nil skips the checks
+	Balances cc.IBalanceClient
+	// SkipPreflight sends the transfers without the pre-flight checks
+	SkipPreflight bool
+	// denominationLoaded is set once Decimals and Symbol have been
+	// retrieved from the contract for amount conversions
+	denominationLoaded bool
+}
+
+// transferEvent is a decoded Transfer event
+type transferEvent struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+}
+
+// approvalEvent is a decoded Approval event
+type approvalEvent struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+}
+
+// ownershipTransferredEvent is a decoded OwnershipTransferred event
+type ownershipTransferredEvent struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+}
+
+// eventNames maps the event names given on the command line to the
+// names of the events in the contract ABI
+var eventNames = map[string]string{
+	"transfer":             "Transfer",
+	"approval":             "Approval",
+	"ownershiptransferred": "OwnershipTransferred",
+}
+
+// erc20ViewMethods maps the query function names which can be aggregated
+// to the names of the view functions in the contract ABI
+var erc20ViewMethods = map[string]string{
+	"name":        "name",
+	"symbol":      "symbol",
+	"decimals":    "decimals",
+	"totalsupply": "totalSupply",
+	"balanceof":   "balanceOf",
+	"allowance":   "allowance",
+}
+
+// Load saves the loaded instance of the token contract together with the
+// clients used to read its events, code and the balances of the senders
+func (t *Token) Load(
+	contractName string,
+	address common.Address,
+	instance IInstance,
+	backend bind.ContractBackend,
+	client cc.IInfoClient,
+	balances cc.IBalanceClient,
+) error {
+	parsed, err := ERC20MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	t.ContractName = contractName
+	t.Address = address
+	t.Instance = instance
+	t.Events = bind.NewBoundContract(address, *parsed, backend, backend,
+		backend)
+	t.Client = client
+	t.Balances = balances
+	// Instantiate empty maps
+	t.BalanceOf = map[common.Address]*big.Int{}
+	t.Allowance = map[common.Address]map[common.Address]*big.Int{}
+	return nil
+}
+
+// LoadDenomination retrieves the decimals and symbol of the token once,
+// these are needed to convert amounts between base units and token units
+func (t *Token) LoadDenomination(opts *bind.CallOpts) error {
+	if t.denominationLoaded {
+		return nil
+	}
+	decimals, err := t.Instance.Decimals(opts)
+	if err != nil {
+		return err
+	}
+	symbol, err1 := t.Instance.Symbol(opts)
+	if err1 != nil {
+		return err1
+	}
+	t.Decimals = decimals
+	t.Symbol = symbol
+	t.denominationLoaded = true
+	return nil
+}
+
+// ParseAmount converts an amount argument into base units, the token
+// denomination is only retrieved when the amount is scaled by it
+func (t *Token) ParseAmount(amount string) (*big.Int, error) {
+	if t.ArgParser.ScalesAmounts() {
+		err := t.LoadDenomination(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t.ArgParser.ParseAmount(amount, t.Decimals, t.Symbol)
+}
+
+// FormatAmount shows an amount in base units followed by the amount
+// scaled by the token decimals, e.g. "1500000000000000000 (1.5 DTT)"
+func (t *Token) FormatAmount(amount *big.Int) string {
+	return fmt.Sprintf("%d (%s %s)", amount,
+		utils.FormatTokenAmount(amount, t.Decimals), t.Symbol)
+}
+
+// SetSkipPreflight sets whether the transfers are sent without the
+// pre-flight checks
+func (t *Token) SetSkipPreflight(skip bool) {
+	t.SkipPreflight = skip
+}
+
+// CheckTransfer verifies before sending a transfer of the tokens of the
+// owner that it holds the amount, that the sender is allowed to move it
+// when spending an allowance and that the sender can pay for the gas. The
+// shortfalls are reported together unless the checks are skipped.
+func (t *Token) CheckTransfer(
+	auth *bind.TransactOpts,
+	owner common.Address,
+	amount *big.Int,
+	spendsAllowance bool,
+) error {
+	if t.Balances == nil || t.SkipPreflight {
+		return nil
+	}
+	ctx := auth.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	// The denomination makes the shortfalls readable
+	err := t.LoadDenomination(opts)
+	if err != nil {
+		return err
+	}
+	var shortfalls []string
+	balance, err1 := t.Instance.BalanceOf(opts, owner)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to read the token balance of %s: %v",
+			owner.Hex(), err1)
+	}
+	if balance.Cmp(amount) < 0 {
+		shortfalls = append(shortfalls, fmt.Sprintf("%s holds %s, %s short "+
+			"of the %s transferred", owner.Hex(), t.FormatAmount(balance),
+			t.FormatAmount(new(big.Int).Sub(amount, balance)),
+			t.FormatAmount(amount)))
+	}
+	if spendsAllowance {
+		allowance, err2 := t.Instance.Allowance(opts, owner, auth.From)
+		if err2 != nil {
+			return fmt.Errorf("error: failed to read the allowance of %s "+
+				"from %s: %v", auth.From.Hex(), owner.Hex(), err2)
+		}
+		if allowance.Cmp(amount) < 0 {
+			shortfalls = append(shortfalls, fmt.Sprintf("%s is allowed to "+
+				"transfer %s from %s, %s short of the %s transferred",
+				auth.From.Hex(), t.FormatAmount(allowance), owner.Hex(),
+				t.FormatAmount(new(big.Int).Sub(amount, allowance)),
+				t.FormatAmount(amount)))
+		}
+	}
+	gasShortfall, err3 := cc.CheckGasFunds(ctx, t.Balances, auth)
+	if err3 != nil {
+		return err3
+	}
+	if len(gasShortfall) != 0 {
+		shortfalls = append(shortfalls, gasShortfall)
+	}
+	return cc.PreflightError(shortfalls)
+}
+
+// QuerySummary loads the full metadata of the token contract: its name,
+// symbol, decimals, total supply, owner, code size and deployer, together
+// with the balances of the accounts given as function arguments
+func (t *Token) QuerySummary(
+	opts *bind.CallOpts,
+	funcArgs []string,
+) error {
+	accounts := make([]common.Address, len(funcArgs))
+	for i, arg := range funcArgs {
+		account, err := t.ArgParser.ParseAddress(arg)
+		if err != nil {
+			return err
+		}
+		accounts[i] = account
+	}
+	ctx, blockNumber := context.Background(), (*big.Int)(nil)
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+		if opts.Context != nil {
+			ctx = opts.Context
+		}
+	}
+	name, err1 := t.Instance.Name(opts)
+	if err1 != nil {
+		return err1
+	}
+	t.denominationLoaded = false
+	err2 := t.LoadDenomination(opts)
+	if err2 != nil {
+		return err2
+	}
+	totalSupply, err3 := t.Instance.TotalSupply(opts)
+	if err3 != nil {
+		return err3
+	}
+	owner, err4 := t.Instance.Owner(opts)
+	if err4 != nil {
+		return err4
+	}
+	code, err5 := t.Client.CodeAt(ctx, t.Address, blockNumber)
+	if err5 != nil {
+		return err5
+	}
+	for _, account := range accounts {
+		balance, err6 := t.Instance.BalanceOf(opts, account)
+		if err6 != nil {
+			return err6
+		}
+		t.BalanceOf[account] = balance
+	}
+	t.Name = name
+	t.TotalSupply = totalSupply
+	t.Owner = owner
+	t.CodeSize = len(code)
+
+	// The deployer is only informational, a node refusing the log query
+	// doesn't fail the summary
+	creation, err7 := t.lookupCreation(ctx, blockNumber)
+	deployer := "unknown"
+	if err7 != nil {
+		fmt.Printf("warning: failed to find the creation transaction: %v\n",
+			err7)
+	} else if creation != nil {
+		deployer = creation.String()
+	}
+	t.Creation = creation
+
+	t.StrToPrint = fmt.Sprintf("info: Token Summary for %s (%s)\n"+
+		"info: Name: %s\n"+
+		"info: Symbol: %s\n"+
+		"info: Decimals: %d\n"+
+		"info: Total Supply: %s\n"+
+		"info: Owner: %s\n"+
+		"info: Code Size: %d bytes\n"+
+		"info: Deployer: %s\n", t.ContractName, t.Address, t.Name, t.Symbol,
+		t.Decimals, t.FormatAmount(t.TotalSupply), t.Owner.Hex(),
+		t.CodeSize, deployer)
+	for _, account := range accounts {
+		t.StrToPrint += fmt.Sprintf("info: Balance of %s : %s\n", account,
+			t.FormatAmount(t.BalanceOf[account]))
+	}
+	return nil
+}
+
+// lookupCreation finds the creation transaction in the deployments
+// recorded by the contract deployer, or else through the first
+// OwnershipTransferred event, which the constructor emits from the zero
+// address. The events are scanned in pages which are halved whenever the
+// node refuses them. It returns nil when no such event is found.
+func (t *Token) lookupCreation(
+	ctx context.Context,
+	blockNumber *big.Int,
+) (*cc.CreationInfo, error) {
+	if t.Deployments != nil {
+		txHash, found, err := t.Deployments.FindDeployment(t.Address)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			creation, err1 := cc.LookupDeployment(ctx, t.Client, txHash)
+			if err1 == nil {
+				return creation, nil
+			}
+			// A record left from a restarted development chain doesn't
+			// match this chain anymore
+			fmt.Printf("warning: failed to read the recorded deployment "+
+				"transaction %s, looking up the creation events: %v\n",
+				txHash.Hex(), err1)
+		}
+	}
+	var toBlock uint64
+	if blockNumber != nil {
+		toBlock = blockNumber.Uint64()
+	} else {
+		head, err2 := t.Client.BlockNumber(ctx)
+		if err2 != nil {
+			return nil, err2
+		}
+		toBlock = head
+	}
+	// The whole range is tried first and only split when the node refuses
+	// it, the scan stops at the first event
+	records, err3 := cc.QueryEventRange(ctx, t.QueryEvents,
+		"ownershiptransferred", cc.EventFilter{From: []common.Address{{}}},
+		0, toBlock, toBlock+1, 1)
+	if err3 != nil {
+		return nil, err3
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return cc.LookupCreation(ctx, t.Client, records[0])
+}
+
+// QueryContractMulticall executes several query functions of the token
+// contract atomically through the multicaller. The decimals and symbol are
+// always read in the same call so that amounts are formatted with the
+// denomination of the same block. The function arguments are used by
+// balanceof, one account each, or by allowance, in owner and spender
+// pairs, therefore only one of the two can be aggregated at a time.
+func (t *Token) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller cc.Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	parsed, err := ERC20MetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	calls := []cc.ViewCall{
+		{Target: t.Address, ABI: parsed, Method: "decimals"},
+		{Target: t.Address, ABI: parsed, Method: "symbol"},
+	}
+	// callNames keeps the query function name of every call following the
+	// denomination calls
+	var callNames []string
+	argsFuncName := ""
+	for _, funcName := range funcNames {
+		method, ok := erc20ViewMethods[funcName]
+		if !ok {
+			return fmt.Errorf("error: %s can't be aggregated", funcName)
+		}
+		if funcName != "balanceof" && funcName != "allowance" {
+			calls = append(calls, cc.ViewCall{Target: t.Address, ABI: parsed,
+				Method: method})
+			callNames = append(callNames, funcName)
+			continue
+		}
+		if len(argsFuncName) != 0 {
+			return fmt.Errorf("error: %s and %s can't be aggregated together, "+
+				"both use the function arguments", argsFuncName, funcName)
+		}
+		argsFuncName = funcName
+		accounts := make([]interface{}, len(funcArgs))
+		for i, arg := range funcArgs {
+			account, err1 := t.ArgParser.ParseAddress(arg)
+			if err1 != nil {
+				return err1
+			}
+			accounts[i] = account
+		}
+		step := 1
+		if funcName == "allowance" {
+			step = 2
+		}
+		if len(accounts) == 0 || len(accounts)%step != 0 {
+			return fmt.Errorf("error: %s can't be aggregated with %d "+
+				"arguments, it needs %d address arguments per call", funcName,
+				len(accounts), step)
+		}
+		for i := 0; i < len(accounts); i += step {
+			calls = append(calls, cc.ViewCall{Target: t.Address, ABI: parsed,
+				Method: method, Args: accounts[i : i+step]})
+			callNames = append(callNames, funcName)
+		}
+	}
+	if len(argsFuncName) == 0 {
+		err2 := utils.ValidateLength(&funcArgs, 0)
+		if err2 != nil {
+			return err2
+		}
+	}
+
+	blockNumber, outputs, err3 := multicaller.Aggregate(opts, calls)
+	if err3 != nil {
+		return err3
+	}
+	t.Decimals = outputs[0][0].(uint8)
+	t.Symbol = outputs[1][0].(string)
+	t.denominationLoaded = true
+	t.StrToPrint = fmt.Sprintf("info: Aggregated %d calls at block %d for "+
+		"%s (%s)\n", len(calls), blockNumber, t.ContractName, t.Address)
+	for i, funcName := range callNames {
+		value, args := outputs[i+2][0], calls[i+2].Args
+		switch funcName {
+		case "name":
+			t.Name = value.(string)
+			t.StrToPrint += fmt.Sprintf("info: Token Name: %s\n", t.Name)
+		case "symbol":
+			t.StrToPrint += fmt.Sprintf("info: Token Symbol: %s\n", t.Symbol)
+		case "decimals":
+			t.StrToPrint += fmt.Sprintf("info: Token Decimals: %d\n",
+				t.Decimals)
+		case "totalsupply":
+			t.TotalSupply = value.(*big.Int)
+			t.StrToPrint += fmt.Sprintf("info: Token TotalSupply: %s\n",
+				t.FormatAmount(t.TotalSupply))
+		case "balanceof":
+			account := args[0].(common.Address)
+			t.BalanceOf[account] = value.(*big.Int)
+			t.StrToPrint += fmt.Sprintf("info: Token Balance of %s : %s\n",
+				account, t.FormatAmount(t.BalanceOf[account]))
+		case "allowance":
+			owner, spender := args[0].(common.Address), args[1].(common.Address)
+			if _, ok := t.Allowance[owner]; !ok {
+				t.Allowance[owner] = map[common.Address]*big.Int{}
+			}
+			t.Allowance[owner][spender] = value.(*big.Int)
+			t.StrToPrint += fmt.Sprintf("info: Token Allowance of spender "+
+				"%s from owner %s is %s\n", spender, owner,
+				t.FormatAmount(t.Allowance[owner][spender]))
+		}
+	}
+	return nil
+}
+
+// QueryEvents retrieves the Transfer, Approval and OwnershipTransferred
+// events of the token contract within the block range of the filter
+// options. The filter addresses are matched against the indexed event
+// arguments and the decoded events are returned in the order they were
+// emitted.
+func (t *Token) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	name, ok := eventNames[eventName]
+	if !ok {
+		return nil, nil
+	}
+	if name != "OwnershipTransferred" {
+		err := t.LoadDenomination(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	logs, err1 := t.filterLogs(opts, name, addressRule(filter.From),
+		addressRule(filter.To))
+	if err1 != nil {
+		return nil, err1
+	}
+	var records []cc.EventRecord
+	for _, log := range logs {
+		record, err2 := t.eventRecord(name, log)
+		if err2 != nil {
+			return nil, err2
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// WatchEvents subscribes to the Transfer, Approval or OwnershipTransferred
+// events of the token contract and forwards them decoded to the sink. The
+// returned subscription fails as soon as the underlying log subscription
+// does, so that the caller can resubscribe.
+func (t *Token) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	name, ok := eventNames[eventName]
+	if !ok {
+		return nil, fmt.Errorf("error: unsupported event %s", eventName)
+	}
+	if name != "OwnershipTransferred" {
+		err := t.LoadDenomination(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	logs, sub, err1 := t.Events.WatchLogs(opts, name,
+		addressRule(filter.From), addressRule(filter.To))
+	if err1 != nil {
+		return nil, err1
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				record, err2 := t.eventRecord(name, log)
+				if err2 != nil {
+					return err2
+				}
+				select {
+				case sink <- record:
+				case <-quit:
+					return nil
+				}
+			case err2 := <-sub.Err():
+				return err2
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// filterLogs retrieves all the logs of the named event matching the query
+// within the block range of the filter options
+func (t *Token) filterLogs(
+	opts *bind.FilterOpts,
+	name string,
+	query ...[]interface{},
+) ([]types.Log, error) {
+	logs, sub, err := t.Events.FilterLogs(opts, name, query...)
+	if err != nil {
+		return nil, err
+	}
+	defer sub.Unsubscribe()
+	var found []types.Log
+	for {
+		select {
+		case log := <-logs:
+			found = append(found, log)
+		case err1 := <-sub.Err():
+			// The subscription ends once every log was delivered, collect
+			// the ones still buffered
+			for {
+				select {
+				case log := <-logs:
+					found = append(found, log)
+				default:
+					return found, err1
+				}
+			}
+		}
+	}
+}
+
+// eventRecord decodes the log of the named event into an EventRecord
+func (t *Token) eventRecord(name string, log types.Log) (cc.EventRecord, error) {
+	switch name {
+	case "Transfer":
+		ev := new(transferEvent)
+		err := t.Events.UnpackLog(ev, name, log)
+		if err != nil {
+			return cc.EventRecord{}, err
+		}
+		return cc.NewEventRecord(name, log,
+			cc.EventArg{Name: "from", Value: ev.From.Hex()},
+			cc.EventArg{Name: "to", Value: ev.To.Hex()},
+			cc.EventArg{Name: "value", Value: t.FormatAmount(ev.Value)}), nil
+	case "Approval":
+		ev := new(approvalEvent)
+		err := t.Events.UnpackLog(ev, name, log)
+		if err != nil {
+			return cc.EventRecord{}, err
+		}
+		return cc.NewEventRecord(name, log,
+			cc.EventArg{Name: "owner", Value: ev.Owner.Hex()},
+			cc.EventArg{Name: "spender", Value: ev.Spender.Hex()},
+			cc.EventArg{Name: "value", Value: t.FormatAmount(ev.Value)}), nil
+	default:
+		ev := new(ownershipTransferredEvent)
+		err := t.Events.UnpackLog(ev, name, log)
+		if err != nil {
+			return cc.EventRecord{}, err
+		}
+		return cc.NewEventRecord(name, log,
+			cc.EventArg{Name: "previousOwner", Value: ev.PreviousOwner.Hex()},
+			cc.EventArg{Name: "newOwner", Value: ev.NewOwner.Hex()}), nil
+	}
+}
+
+// addressRule converts the filter addresses into a topic rule of the log
+// query, an empty rule matches any address
+func addressRule(addresses []common.Address) []interface{} {
+	rule := make([]interface{}, len(addresses))
+	for i, address := range addresses {
+		rule[i] = address
+	}
+	return rule
+}
@@ -0,0 +1,985 @@
+package erc20_token
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+type MockContractInstance struct {
+	mock.Mock
+}
+
+func (m *MockContractInstance) Transfer(
+	opts *bind.TransactOpts,
+	recipient common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, recipient, amount)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) Approve(
+	opts *bind.TransactOpts,
+	spender common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, spender, amount)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) TransferFrom(
+	opts *bind.TransactOpts,
+	sender common.Address,
+	recipient common.Address,
+	amount *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, sender, recipient, amount)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) IncreaseAllowance(
+	opts *bind.TransactOpts,
+	spender common.Address,
+	addedValue *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, spender, addedValue)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) DecreaseAllowance(
+	opts *bind.TransactOpts,
+	spender common.Address,
+	subtractedValue *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, spender, subtractedValue)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) Name(_ *bind.CallOpts) (string, error) {
+	args := m.Called(nil)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockContractInstance) Symbol(_ *bind.CallOpts) (string, error) {
+	args := m.Called(nil)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockContractInstance) Decimals(_ *bind.CallOpts) (uint8, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(uint8), args.Error(1)
+}
+
+func (m *MockContractInstance) TotalSupply(_ *bind.CallOpts) (*big.Int, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) BalanceOf(
+	_ *bind.CallOpts,
+	account common.Address,
+) (*big.Int, error) {
+	args := m.Called(nil, account)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) Allowance(
+	_ *bind.CallOpts,
+	owner common.Address,
+	spender common.Address,
+) (*big.Int, error) {
+	args := m.Called(nil, owner, spender)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) Owner(_ *bind.CallOpts) (common.Address, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
+type MockLogFilterer struct {
+	mock.Mock
+}
+
+func (m *MockLogFilterer) FilterLogs(
+	_ context.Context,
+	query ethereum.FilterQuery,
+) ([]types.Log, error) {
+	args := m.Called(query.Topics)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return (args.Get(0)).([]types.Log), args.Error(1)
+}
+
+func (m *MockLogFilterer) SubscribeFilterLogs(
+	_ context.Context,
+	query ethereum.FilterQuery,
+	ch chan<- types.Log,
+) (ethereum.Subscription, error) {
+	args := m.Called(query.Topics, ch)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return (args.Get(0)).(ethereum.Subscription), args.Error(1)
+}
+
+type MockInfoClient struct {
+	mock.Mock
+}
+
+func (m *MockInfoClient) CodeAt(
+	_ context.Context,
+	contract common.Address,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	args := m.Called(contract, blockNumber)
+	return (args.Get(0)).([]byte), args.Error(1)
+}
+
+func (m *MockInfoClient) TransactionByHash(
+	_ context.Context,
+	hash common.Hash,
+) (*types.Transaction, bool, error) {
+	args := m.Called(hash)
+	return (args.Get(0)).(*types.Transaction), args.Bool(1), args.Error(2)
+}
+
+func (m *MockInfoClient) TransactionReceipt(
+	_ context.Context,
+	txHash common.Hash,
+) (*types.Receipt, error) {
+	args := m.Called(txHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return (args.Get(0)).(*types.Receipt), args.Error(1)
+}
+
+func (m *MockInfoClient) BlockNumber(_ context.Context) (uint64, error) {
+	args := m.Called()
+	return (args.Get(0)).(uint64), args.Error(1)
+}
+
+type MockDeployments struct {
+	mock.Mock
+}
+
+func (m *MockDeployments) FindDeployment(
+	address common.Address,
+) (common.Hash, bool, error) {
+	args := m.Called(address)
+	return (args.Get(0)).(common.Hash), args.Bool(1), args.Error(2)
+}
+
+type MockBalanceClient struct {
+	mock.Mock
+}
+
+func (m *MockBalanceClient) BalanceAt(
+	_ context.Context,
+	account common.Address,
+	blockNumber *big.Int,
+) (*big.Int, error) {
+	args := m.Called(account, blockNumber)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+type MockMulticaller struct {
+	mock.Mock
+}
+
+func (m *MockMulticaller) Aggregate(
+	opts *bind.CallOpts,
+	calls []cc.ViewCall,
+) (*big.Int, [][]interface{}, error) {
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	args := m.Called(opts, methods)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*big.Int), args.Get(1).([][]interface{}), args.Error(2)
+}
+
+// newTestToken creates a token reading its functions from the instance
+// and its events from the filterer
+func newTestToken(
+	t *testing.T,
+	instance *MockContractInstance,
+	filterer *MockLogFilterer,
+) *Token {
+	parsed, err := abi.JSON(strings.NewReader(erc20ABI))
+	assert.NoError(t, err)
+	return &Token{
+		ContractName: "TestToken",
+		Instance:     instance,
+		Events: bind.NewBoundContract(common.Address{}, parsed, nil, nil,
+			filterer),
+		BalanceOf: map[common.Address]*big.Int{},
+		Allowance: map[common.Address]map[common.Address]*big.Int{},
+	}
+}
+
+// addressTopic returns the topic of an indexed address argument
+func addressTopic(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+func TestTokenParseAmount(t *testing.T) {
+	tests := []struct {
+		testName         string
+		amount           string
+		raw              bool
+		expectedAmount   *big.Int
+		expectedError    error
+		loadDenomination bool
+	}{
+		{
+			testName:         "ParseAmount integer amount scaled by decimals.",
+			amount:           "1500",
+			expectedAmount: new(big.Int).Mul(big.NewInt(1500),
+				big.NewInt(1000000000000000000)),
+			loadDenomination: true,
+		},
+		{
+			testName:         "ParseAmount raw base units without denomination.",
+			amount:           "1500",
+			raw:              true,
+			expectedAmount:   big.NewInt(1500),
+			loadDenomination: false,
+		},
+		{
+			testName: "ParseAmount raw decimal amount.",
+			amount:   "1.5",
+			raw:      true,
+			expectedError: errors.New("error: amount \"1.5\" in base units " +
+				"must be a plain integer"),
+			loadDenomination: false,
+		},
+		{
+			testName:         "ParseAmount decimal amount scaled by decimals.",
+			amount:           "1.5",
+			expectedAmount:   big.NewInt(1500000000000000000),
+			loadDenomination: true,
+		},
+		{
+			testName:         "ParseAmount scientific amount scaled by decimals.",
+			amount:           "1e-6",
+			expectedAmount:   big.NewInt(1000000000000),
+			loadDenomination: true,
+		},
+		{
+			testName:         "ParseAmount amount with token symbol.",
+			amount:           "2 TTT",
+			expectedAmount:   big.NewInt(2000000000000000000),
+			loadDenomination: true,
+		},
+		{
+			testName: "ParseAmount amount with wrong symbol.",
+			amount:   "2 ETH",
+			expectedError: errors.New("error: unit \"ETH\" of amount \"2 ETH\" " +
+				"does not match the token symbol \"TTT\""),
+			loadDenomination: true,
+		},
+		{
+			testName:         "ParseAmount negative amount.",
+			amount:           "-5",
+			expectedError:    errors.New("error: negative amount \"-5\" is not allowed"),
+			loadDenomination: true,
+		},
+		{
+			testName:         "ParseAmount unparsable amount.",
+			amount:           "1,5",
+			expectedError:    errors.New("error: unable to parse amount \"1,5\""),
+			loadDenomination: true,
+		},
+		{
+			testName: "ParseAmount amount more precise than decimals.",
+			amount:   "0.0000000000000000001",
+			expectedError: errors.New("error: amount \"0.0000000000000000001\" " +
+				"has more precision than the token's 18 decimals"),
+			loadDenomination: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			token := newTestToken(t, mInstance, nil)
+			token.ArgParser = &utils.ArgParser{RawAmounts: tt.raw}
+			amount, err := token.ParseAmount(tt.amount)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, amount)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, amount, tt.expectedAmount)
+			}
+			if !tt.loadDenomination {
+				mInstance.AssertNotCalled(t, "Decimals", nil)
+			}
+		})
+	}
+}
+
+func TestTokenLoadDenominationOnce(t *testing.T) {
+	mInstance := new(MockContractInstance)
+	mInstance.On("Decimals", nil).Return(uint8(6), nil)
+	mInstance.On("Symbol", nil).Return("TTT", nil)
+	token := newTestToken(t, mInstance, nil)
+	assert.NoError(t, token.LoadDenomination(nil))
+	assert.NoError(t, token.LoadDenomination(nil))
+	mInstance.AssertNumberOfCalls(t, "Decimals", 1)
+	assert.Equal(t, token.FormatAmount(big.NewInt(2500000)),
+		"2500000 (2.5 TTT)")
+}
+
+func TestTokenCheckTransfer(t *testing.T) {
+	owner := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	caller := common.HexToAddress("0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384")
+	tests := []struct {
+		testName        string
+		owner           common.Address
+		amount          *big.Int
+		spendsAllowance bool
+		balance         *big.Int
+		balanceError    error
+		allowance       *big.Int
+		nativeBalance   *big.Int
+		skipPreflight   bool
+		skipBalances    bool
+		expectedError   error
+	}{
+		{
+			testName:      "CheckTransfer funds cover the transfer.",
+			owner:         caller,
+			amount:        big.NewInt(1500000000000000000),
+			balance:       big.NewInt(1500000000000000000),
+			nativeBalance: big.NewInt(100000000),
+		},
+		{
+			testName:      "CheckTransfer balance too low.",
+			owner:         caller,
+			amount:        big.NewInt(1500000000000000000),
+			balance:       big.NewInt(1000000000000000000),
+			nativeBalance: big.NewInt(100000000),
+			expectedError: errors.New("error: the transaction would fail, use " +
+				"--skip-preflight to send it anyway:\n  " +
+				"0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384 holds " +
+				"1000000000000000000 (1 TTT), 500000000000000000 (0.5 TTT) " +
+				"short of the 1500000000000000000 (1.5 TTT) transferred"),
+		},
+		{
+			testName:        "CheckTransfer allowance and gas funds too low.",
+			owner:           owner,
+			amount:          big.NewInt(2000000000000000000),
+			spendsAllowance: true,
+			balance:         big.NewInt(2000000000000000000),
+			allowance:       big.NewInt(500000000000000000),
+			nativeBalance:   big.NewInt(40000000),
+			expectedError: errors.New("error: the transaction would fail, use " +
+				"--skip-preflight to send it anyway:\n  " +
+				"0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384 is allowed to " +
+				"transfer 500000000000000000 (0.5 TTT) from " +
+				"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df, " +
+				"1500000000000000000 (1.5 TTT) short of the " +
+				"2000000000000000000 (2 TTT) transferred\n  " +
+				"0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384 holds 40000000 " +
+				"wei (0.00000000004 coins), 60000000 wei (0.00000000006 coins) " +
+				"short of the 100000000 wei (0.0000000001 coins) the gas may " +
+				"cost"),
+		},
+		{
+			testName:        "CheckTransfer skipped on request.",
+			owner:           owner,
+			amount:          big.NewInt(2000000000000000000),
+			spendsAllowance: true,
+			balance:         big.NewInt(0),
+			allowance:       big.NewInt(0),
+			nativeBalance:   big.NewInt(0),
+			skipPreflight:   true,
+		},
+		{
+			testName:      "CheckTransfer skipped without balance client.",
+			owner:         caller,
+			amount:        big.NewInt(1),
+			balance:       big.NewInt(0),
+			nativeBalance: big.NewInt(0),
+			skipBalances:  true,
+		},
+		{
+			testName:      "CheckTransfer balance read failure.",
+			owner:         caller,
+			amount:        big.NewInt(1),
+			balance:       big.NewInt(0),
+			balanceError:  errors.New("connection refused"),
+			nativeBalance: big.NewInt(100000000),
+			expectedError: errors.New("error: failed to read the token balance " +
+				"of 0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384: connection refused"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{From: caller, GasLimit: 100000,
+				GasPrice: big.NewInt(1000)}
+			mInstance := new(MockContractInstance)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			mInstance.On("BalanceOf", nil, tt.owner).Return(tt.balance,
+				tt.balanceError)
+			mInstance.On("Allowance", nil, tt.owner, caller).Return(
+				tt.allowance, nil)
+			mClient := new(MockBalanceClient)
+			mClient.On("BalanceAt", caller, (*big.Int)(nil)).Return(
+				tt.nativeBalance, nil)
+			token := newTestToken(t, mInstance, nil)
+			if !tt.skipBalances {
+				token.Balances = mClient
+			}
+			token.SetSkipPreflight(tt.skipPreflight)
+			err := token.CheckTransfer(auth, tt.owner, tt.amount,
+				tt.spendsAllowance)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			if tt.skipPreflight || tt.skipBalances {
+				mInstance.AssertNotCalled(t, "BalanceOf", nil, tt.owner)
+				mClient.AssertNotCalled(t, "BalanceAt", caller,
+					(*big.Int)(nil))
+			}
+		})
+	}
+}
+
+func TestTokenQuerySummary(t *testing.T) {
+	key, _ := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	deployer := crypto.PubkeyToAddress(key.PublicKey)
+	holder := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	creationTx, _ := types.SignTx(types.NewContractCreation(0, big.NewInt(0),
+		1000000, big.NewInt(1), []byte{0x60}), types.NewEIP155Signer(
+		big.NewInt(1337)), key)
+	transferTx, _ := types.SignTx(types.NewTransaction(0, holder,
+		big.NewInt(0), 21000, big.NewInt(1), nil), types.NewEIP155Signer(
+		big.NewInt(1337)), key)
+	ownershipTopic := crypto.Keccak256Hash(
+		[]byte("OwnershipTransferred(address,address)"))
+	ownershipLog := types.Log{
+		Topics:      []common.Hash{ownershipTopic, {}, addressTopic(deployer)},
+		BlockNumber: 1,
+		TxHash:      creationTx.Hash(),
+	}
+	summary := "info: Token Summary for TestToken " +
+		"(0x0000000000000000000000000000000000000000)\n" +
+		"info: Name: TestToken\n" +
+		"info: Symbol: TTT\n" +
+		"info: Decimals: 18\n" +
+		"info: Total Supply: 1500000000000000000 (1.5 TTT)\n" +
+		"info: Owner: " + deployer.Hex() + "\n" +
+		"info: Code Size: 3 bytes\n"
+	recordedTx, _ := types.SignTx(types.NewTransaction(1, holder,
+		big.NewInt(0), 1000000, big.NewInt(1), []byte{0x60}),
+		types.NewEIP155Signer(big.NewInt(1337)), key)
+	rangeError := errors.New("query returned more than 10000 results")
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		recorded      bool
+		receipt       *types.Receipt
+		receiptError  error
+		refusedPages  int
+		logs          []types.Log
+		filterError   error
+		tx            *types.Transaction
+		ownerError    error
+		strToPrint    string
+		filterCalls   int
+		expectedError error
+	}{
+		{
+			testName: "QuerySummary successful all data returned.",
+			funcArgs: []string{holder.Hex()},
+			logs:     []types.Log{ownershipLog},
+			tx:       creationTx,
+			strToPrint: summary + "info: Deployer: " + deployer.Hex() +
+				" in transaction " + creationTx.Hash().Hex() + " at block 1\n" +
+				"info: Balance of " + holder.Hex() + " : 100000000000 " +
+				"(0.0000001 TTT)\n",
+			filterCalls: 1,
+		},
+		{
+			testName: "QuerySummary deployer from the recorded deployment.",
+			funcArgs: []string{},
+			recorded: true,
+			receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful,
+				BlockNumber: big.NewInt(7)},
+			strToPrint: summary + "info: Deployer: " + deployer.Hex() +
+				" in transaction " + recordedTx.Hash().Hex() + " at block 7\n",
+			filterCalls: 0,
+		},
+		{
+			testName: "QuerySummary recorded deployment failed.",
+			funcArgs: []string{},
+			recorded: true,
+			receipt: &types.Receipt{Status: types.ReceiptStatusFailed,
+				BlockNumber: big.NewInt(7)},
+			logs: []types.Log{ownershipLog},
+			tx:   creationTx,
+			strToPrint: summary + "info: Deployer: " + deployer.Hex() +
+				" in transaction " + creationTx.Hash().Hex() + " at block 1\n",
+			filterCalls: 1,
+		},
+		{
+			testName:     "QuerySummary recorded deployment not on the chain.",
+			funcArgs:     []string{},
+			recorded:     true,
+			receiptError: errors.New("not found"),
+			logs:         []types.Log{ownershipLog},
+			tx:           creationTx,
+			strToPrint: summary + "info: Deployer: " + deployer.Hex() +
+				" in transaction " + creationTx.Hash().Hex() + " at block 1\n",
+			filterCalls: 1,
+		},
+		{
+			testName:     "QuerySummary range refused and halved.",
+			funcArgs:     []string{},
+			refusedPages: 2,
+			logs:         []types.Log{ownershipLog},
+			tx:           creationTx,
+			strToPrint: summary + "info: Deployer: " + deployer.Hex() +
+				" in transaction " + creationTx.Hash().Hex() + " at block 1\n",
+			filterCalls: 3,
+		},
+		{
+			testName:    "QuerySummary without constructor event.",
+			funcArgs:    []string{},
+			logs:        []types.Log{},
+			tx:          creationTx,
+			strToPrint:  summary + "info: Deployer: unknown\n",
+			filterCalls: 1,
+		},
+		{
+			testName:    "QuerySummary event not from the creation.",
+			funcArgs:    []string{},
+			logs:        []types.Log{ownershipLog},
+			tx:          transferTx,
+			strToPrint:  summary + "info: Deployer: unknown\n",
+			filterCalls: 1,
+		},
+		{
+			testName:    "QuerySummary log query failure.",
+			funcArgs:    []string{},
+			filterError: errors.New("connection refused"),
+			tx:          creationTx,
+			strToPrint:  summary + "info: Deployer: unknown\n",
+			filterCalls: 1,
+		},
+		{
+			testName:      "QuerySummary fail address validation.",
+			funcArgs:      []string{"fail"},
+			expectedError: errors.New("error: \"fail\" is not a valid hex address"),
+		},
+		{
+			testName:      "QuerySummary instance failure.",
+			funcArgs:      []string{},
+			ownerError:    errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("Name", nil).Return("TestToken", nil)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			mInstance.On("TotalSupply", nil).Return(
+				big.NewInt(1500000000000000000), nil)
+			mInstance.On("Owner", nil).Return(deployer, tt.ownerError)
+			mInstance.On("BalanceOf", nil, holder).Return(
+				big.NewInt(100000000000), nil)
+			// The constructor event is looked up from the zero address
+			ownershipTopics := mock.MatchedBy(func(topics [][]common.Hash) bool {
+				return topics[0][0] == ownershipTopic &&
+					len(topics[1]) == 1 && topics[1][0] == common.Hash{}
+			})
+			mFilterer := new(MockLogFilterer)
+			if tt.refusedPages > 0 {
+				mFilterer.On("FilterLogs", ownershipTopics).Return(nil,
+					rangeError).Times(tt.refusedPages)
+			}
+			mFilterer.On("FilterLogs", ownershipTopics).Return(tt.logs,
+				tt.filterError)
+			mClient := new(MockInfoClient)
+			mClient.On("CodeAt", common.Address{}, (*big.Int)(nil)).Return(
+				[]byte{0x60, 0x80, 0x60}, nil)
+			mClient.On("BlockNumber").Return(uint64(100), nil)
+			mClient.On("TransactionByHash", creationTx.Hash()).Return(tt.tx,
+				false, nil)
+			mClient.On("TransactionByHash", recordedTx.Hash()).Return(
+				recordedTx, false, nil)
+			mClient.On("TransactionReceipt", recordedTx.Hash()).Return(
+				tt.receipt, tt.receiptError)
+			mDeployments := new(MockDeployments)
+			mDeployments.On("FindDeployment", common.Address{}).Return(
+				recordedTx.Hash(), tt.recorded, nil)
+			token := newTestToken(t, mInstance, mFilterer)
+			token.Client = mClient
+			token.Deployments = mDeployments
+			err := token.QuerySummary(nil, tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, token.StrToPrint, tt.strToPrint)
+			mFilterer.AssertNumberOfCalls(t, "FilterLogs", tt.filterCalls)
+		})
+	}
+}
+
+func TestTokenQueryContractMulticall(t *testing.T) {
+	tests := []struct {
+		testName        string
+		funcNames       []string
+		funcArgs        []string
+		expectedMethods []string
+		outputs         [][]interface{}
+		aggregateError  error
+		strToPrint      string
+		expectedError   error
+	}{
+		{
+			testName:        "QueryContractMulticall token data successful.",
+			funcNames:       []string{"name", "totalsupply"},
+			funcArgs:        []string{},
+			expectedMethods: []string{"decimals", "symbol", "name", "totalSupply"},
+			outputs: [][]interface{}{{uint8(18)}, {"TTT"}, {"TestToken"},
+				{big.NewInt(1500000000000000000)}},
+			strToPrint: "info: Aggregated 4 calls at block 10 for TestToken " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Name: TestToken\n" +
+				"info: Token TotalSupply: 1500000000000000000 (1.5 TTT)\n",
+		},
+		{
+			testName:  "QueryContractMulticall balanceOf many holders successful.",
+			funcNames: []string{"balanceof"},
+			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df",
+				"0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"},
+			expectedMethods: []string{"decimals", "symbol", "balanceOf", "balanceOf"},
+			outputs: [][]interface{}{{uint8(18)}, {"TTT"},
+				{big.NewInt(100000000000)}, {big.NewInt(0)}},
+			strToPrint: "info: Aggregated 4 calls at block 10 for TestToken " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Balance of 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df : " +
+				"100000000000 (0.0000001 TTT)\n" +
+				"info: Token Balance of 0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 : " +
+				"0 (0 TTT)\n",
+		},
+		{
+			testName:  "QueryContractMulticall allowance pair successful.",
+			funcNames: []string{"allowance"},
+			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df",
+				"0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"},
+			expectedMethods: []string{"decimals", "symbol", "allowance"},
+			outputs: [][]interface{}{{uint8(18)}, {"TTT"},
+				{big.NewInt(500000000000000000)}},
+			strToPrint: "info: Aggregated 3 calls at block 10 for TestToken " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Allowance of spender " +
+				"0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 from owner " +
+				"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df is " +
+				"500000000000000000 (0.5 TTT)\n",
+		},
+		{
+			testName:  "QueryContractMulticall fail balanceOf with allowance.",
+			funcNames: []string{"balanceof", "allowance"},
+			funcArgs:  []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"},
+			expectedError: errors.New("error: balanceof and allowance can't be " +
+				"aggregated together, both use the function arguments"),
+		},
+		{
+			testName:  "QueryContractMulticall fail allowance odd arguments.",
+			funcNames: []string{"allowance"},
+			funcArgs:  []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"},
+			expectedError: errors.New("error: allowance can't be aggregated " +
+				"with 1 arguments, it needs 2 address arguments per call"),
+		},
+		{
+			testName:      "QueryContractMulticall fail unknown function.",
+			funcNames:     []string{"owner"},
+			funcArgs:      []string{},
+			expectedError: errors.New("error: owner can't be aggregated"),
+		},
+		{
+			testName:  "QueryContractMulticall fail unused arguments.",
+			funcNames: []string{"name"},
+			funcArgs:  []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"},
+			expectedError: errors.New("error: 1 arguments does not match " +
+				"required 0"),
+		},
+		{
+			testName:        "QueryContractMulticall aggregate failure.",
+			funcNames:       []string{"symbol"},
+			funcArgs:        []string{},
+			expectedMethods: []string{"decimals", "symbol", "symbol"},
+			aggregateError:  errors.New("error: something bad happened"),
+			expectedError:   errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			multicaller := new(MockMulticaller)
+			if tt.aggregateError != nil {
+				multicaller.On("Aggregate", (*bind.CallOpts)(nil),
+					tt.expectedMethods).Return(nil, nil, tt.aggregateError)
+			} else {
+				multicaller.On("Aggregate", (*bind.CallOpts)(nil),
+					tt.expectedMethods).Return(big.NewInt(10), tt.outputs, nil)
+			}
+			token := newTestToken(t, new(MockContractInstance), nil)
+			err := token.QueryContractMulticall(nil, multicaller, tt.funcNames,
+				tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, token.StrToPrint, tt.strToPrint)
+			multicaller.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTokenQueryEvents(t *testing.T) {
+	from := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	to := common.HexToAddress("0xfd6f5A60D2D8b12039F906D112f10Fb66F881087")
+	transferLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			addressTopic(from),
+			addressTopic(to),
+		},
+		Data:        common.LeftPadBytes(big.NewInt(1500000000000000000).Bytes(), 32),
+		BlockNumber: 120,
+		TxHash:      common.HexToHash("0x01"),
+		Index:       3,
+	}
+	approvalLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")),
+			addressTopic(from),
+			addressTopic(to),
+		},
+		Data:        common.LeftPadBytes(big.NewInt(500000000000000000).Bytes(), 32),
+		BlockNumber: 121,
+		TxHash:      common.HexToHash("0x02"),
+		Index:       0,
+	}
+	ownershipLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("OwnershipTransferred(address,address)")),
+			addressTopic(from),
+			addressTopic(to),
+		},
+		BlockNumber: 122,
+		TxHash:      common.HexToHash("0x03"),
+		Index:       1,
+	}
+	end := uint64(200)
+	tests := []struct {
+		testName        string
+		eventName       string
+		logs            []types.Log
+		filterError     error
+		expectedError   error
+		expectedRecords []string
+	}{
+		{
+			testName:  "QueryEvents Transfer successful all data returned.",
+			eventName: "transfer",
+			logs:      []types.Log{transferLog},
+			expectedRecords: []string{"Transfer block=120 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000001 " +
+				"logIndex=3 from=0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"to=0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 " +
+				"value=1500000000000000000 (1.5 TTT)"},
+		},
+		{
+			testName:  "QueryEvents Approval successful all data returned.",
+			eventName: "approval",
+			logs:      []types.Log{approvalLog},
+			expectedRecords: []string{"Approval block=121 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000002 " +
+				"logIndex=0 owner=0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"spender=0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 " +
+				"value=500000000000000000 (0.5 TTT)"},
+		},
+		{
+			testName:  "QueryEvents OwnershipTransferred successful all data returned.",
+			eventName: "ownershiptransferred",
+			logs:      []types.Log{ownershipLog},
+			expectedRecords: []string{"OwnershipTransferred block=122 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000003 " +
+				"logIndex=1 previousOwner=0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"newOwner=0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"},
+		},
+		{
+			testName:        "QueryEvents Transfer no events.",
+			eventName:       "transfer",
+			logs:            []types.Log{},
+			expectedRecords: []string{},
+		},
+		{
+			testName:      "QueryEvents Transfer filter failure.",
+			eventName:     "transfer",
+			filterError:   errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			opts := &bind.FilterOpts{Start: 100, End: &end}
+			filter := cc.EventFilter{From: []common.Address{from}}
+			mInstance := new(MockContractInstance)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			mFilterer := new(MockLogFilterer)
+			// Only the first indexed address is filtered
+			mFilterer.On("FilterLogs", mock.MatchedBy(
+				func(topics [][]common.Hash) bool {
+					return len(topics) > 1 &&
+						topics[1][0] == addressTopic(from)
+				})).Return(tt.logs, tt.filterError)
+			token := newTestToken(t, mInstance, mFilterer)
+			records, err := token.QueryEvents(opts, tt.eventName, filter)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, records)
+				return
+			}
+			assert.NoError(t, err)
+			printed := []string{}
+			for _, record := range records {
+				printed = append(printed, record.String())
+			}
+			assert.Equal(t, printed, tt.expectedRecords)
+		})
+	}
+}
+
+func TestTokenWatchEvents(t *testing.T) {
+	from := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	to := common.HexToAddress("0xfd6f5A60D2D8b12039F906D112f10Fb66F881087")
+	transferLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			addressTopic(from),
+			addressTopic(to),
+		},
+		Data:        common.LeftPadBytes(big.NewInt(2500000000000000000).Bytes(), 32),
+		BlockNumber: 121,
+		TxHash:      common.HexToHash("0x02"),
+		Index:       0,
+	}
+	tests := []struct {
+		testName       string
+		eventName      string
+		watchError     error
+		subError       error
+		expectedError  error
+		expectedRecord string
+	}{
+		{
+			testName:  "WatchEvents Transfer event forwarded.",
+			eventName: "transfer",
+			expectedRecord: "Transfer block=121 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000002 " +
+				"logIndex=0 from=0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"to=0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 " +
+				"value=2500000000000000000 (2.5 TTT)",
+		},
+		{
+			testName:      "WatchEvents Transfer subscription dropped.",
+			eventName:     "transfer",
+			subError:      errors.New("error: websocket: close 1006"),
+			expectedError: errors.New("error: websocket: close 1006"),
+		},
+		{
+			testName:      "WatchEvents Transfer subscribe failure.",
+			eventName:     "transfer",
+			watchError:    errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+		{
+			testName:      "WatchEvents unsupported event.",
+			eventName:     "mint",
+			expectedError: errors.New("error: unsupported event mint"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			opts := &bind.WatchOpts{}
+			filter := cc.EventFilter{To: []common.Address{to}}
+			filtererSub := event.NewSubscription(func(quit <-chan struct{}) error {
+				if tt.subError != nil {
+					return tt.subError
+				}
+				<-quit
+				return nil
+			})
+			mInstance := new(MockContractInstance)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			mFilterer := new(MockLogFilterer)
+			call := mFilterer.On("SubscribeFilterLogs", mock.Anything,
+				mock.Anything).Run(func(args mock.Arguments) {
+				logs := args.Get(1).(chan<- types.Log)
+				if tt.subError == nil {
+					go func() { logs <- transferLog }()
+				}
+			})
+			if tt.watchError != nil {
+				call.Return(nil, tt.watchError)
+			} else {
+				call.Return(filtererSub, nil)
+			}
+			token := newTestToken(t, mInstance, mFilterer)
+			sink := make(chan cc.EventRecord)
+			sub, err := token.WatchEvents(opts, tt.eventName, filter, sink)
+			if tt.watchError != nil || len(tt.expectedRecord) == 0 &&
+				tt.subError == nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, sub)
+				return
+			}
+			assert.NoError(t, err)
+			defer sub.Unsubscribe()
+			select {
+			case record := <-sink:
+				assert.Equal(t, record.String(), tt.expectedRecord)
+			case err1 := <-sub.Err():
+				assert.Equal(t, err1.Error(), tt.expectedError.Error())
+			}
+		})
+	}
+}
@@ -5,84 +5,46 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	cc "go-evm-client/internal/contracts_template_interface"
 	utils "go-evm-client/internal/utils"
+	erc20 "go-evm-client/pkg/contracts/erc20_token"
 	"go-evm-client/pkg/eth_rpc_client"
 	"math/big"
 )
 
-// IInstance is the interface needed for these contract functions
-type IInstance interface {
-	Transfer(
-		opts *bind.TransactOpts,
-		recipient common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	Approve(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	TransferFrom(
-		opts *bind.TransactOpts,
-		sender common.Address,
-		recipient common.Address,
-		amount *big.Int,
-	) (*types.Transaction, error)
-	IncreaseAllowance(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		addedValue *big.Int,
-	) (*types.Transaction, error)
-	DecreaseAllowance(
-		opts *bind.TransactOpts,
-		spender common.Address,
-		subtractedValue *big.Int,
-	) (*types.Transaction, error)
-	Name(opts *bind.CallOpts) (string, error)
-	Symbol(opts *bind.CallOpts) (string, error)
-	Decimals(opts *bind.CallOpts) (uint8, error)
-	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
-	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
-	Allowance(
-		opts *bind.CallOpts,
-		owner common.Address,
-		spender common.Address,
-	) (*big.Int, error)
-}
-
 // FastTestTokenContract contains all the data needed to
 // deploy and interact with the FastTestToken contract
 type FastTestTokenContract struct {
 	cc.Contract
-	fastQueriableContractData
+	erc20.Token
 	ConstructorArgs contractConstructorArgs
-	Address  		common.Address
 	LastTx   		*types.Transaction
-	Instance 		IInstance
-	StrToPrint 		string
 }
 
-// contractConstructorArgs is empty and FastTestToken doesn't
-// have any arguments on constructor, but it is kept here for
-// formality and standardization across contracts
+// contractConstructorArgs contains the owner FastTestToken mints its
+// supply to, the zero address stands for the deployer
 type contractConstructorArgs struct {
+	owner common.Address
 }
 
-// fastQueriableContractData is a struct that holds all the data
-// that can be queried from the contract
-type fastQueriableContractData struct {
-	Name		string
-	Symbol      string
-	Decimals    uint8
-	TotalSupply *big.Int
-	BalanceOf   map[common.Address]*big.Int
-	Allowance   map[common.Address]map[common.Address]*big.Int
-}
-
-// ParseConstructorArguments is kept here so that this contract can
-// be used by the templated Contract method
-func (f *FastTestTokenContract) ParseConstructorArguments(_ []string) error {
+// ParseConstructorArguments parses the optional owner of the token, the
+// deployer owns it when no argument is given
+func (f *FastTestTokenContract) ParseConstructorArguments(
+	contractArgs []string) error {
+	f.ConstructorArgs = contractConstructorArgs{}
+	if len(contractArgs) == 0 {
+		return nil
+	}
+	err := utils.ValidateLength(&contractArgs, 1)
+	if err != nil {
+		return err
+	}
+	owner, err1 := f.ArgParser.ParseRecipient(contractArgs[0])
+	if err1 != nil {
+		return err1
+	}
+	f.ConstructorArgs.owner = owner
 	return nil
 }
 
@@ -92,10 +54,60 @@ func (f *FastTestTokenContract) DeployContract(
 	auth *bind.TransactOpts,
 	client eth_rpc_client.IEthClient,
 ) error {
-	address, tx, instance, err := DeployFastTestToken(auth, client)
+	owner := f.ConstructorArgs.owner
+	if owner == (common.Address{}) {
+		owner = auth.From
+	}
+	address, tx, instance, err := DeployFastTestToken(auth, client, owner)
+	if err != nil {
+		return err
+	}
+	f.Address = address
+	f.LastTx = tx
+	f.Instance = instance
+	return nil
+}
+
+// DeploymentCode returns the creation bytecode of the fast test token
+// contract followed by the packed owner, which must be given since the
+// deployer isn't known
+func (f *FastTestTokenContract) DeploymentCode() ([]byte, error) {
+	if f.ConstructorArgs.owner == (common.Address{}) {
+		return nil, fmt.Errorf("error: the owner of FastTestToken must be " +
+			"passed as its constructor argument to deploy it through a " +
+			"CREATE2 factory, the factory would own it otherwise")
+	}
+	parsed, err := FastTestTokenMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	args, err1 := parsed.Pack("", f.ConstructorArgs.owner)
+	if err1 != nil {
+		return nil, err1
+	}
+	return append(common.FromHex(FastTestTokenMetaData.Bin), args...), nil
+}
+
+// DeployContractCreate2 deploys the fast test token contract through the
+// CREATE2 factory and saves its instance, tx of deployment and contract
+// address
+func (f *FastTestTokenContract) DeployContractCreate2(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer cc.Create2Deployer,
+) error {
+	initCode, err := f.DeploymentCode()
 	if err != nil {
 		return err
 	}
+	address, tx, err1 := deployer.Deploy(auth, initCode)
+	if err1 != nil {
+		return err1
+	}
+	instance, err2 := NewFastTestToken(address, client)
+	if err2 != nil {
+		return err2
+	}
 	f.Address = address
 	f.LastTx = tx
 	f.Instance = instance
@@ -112,13 +124,13 @@ func (f *FastTestTokenContract) LoadContract(
 	if err != nil {
 		return err
 	}
-	f.Instance = instance
-	f.Address = *address
-	// Insansiate empty maps
-	// Instansiate empty maps
-	f.BalanceOf = map[common.Address]*big.Int{}
-	f.Allowance = map[common.Address]map[common.Address]*big.Int{}
-	return nil
+	return f.Load("FastTestToken", *address, instance, client, client,
+		client)
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (f *FastTestTokenContract) SetArgParser(parser *utils.ArgParser) {
+	f.ArgParser = parser
 }
 
 // PrintDeploymentData outputs to the terminal the address and
@@ -155,13 +167,22 @@ func (f *FastTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		recipient := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := f.Instance.Transfer(auth, recipient, amount)
+		recipient, err1 := f.ArgParser.ParseRecipient(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := f.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		err3 := f.CheckTransfer(auth, auth.From, amount, false)
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := f.Instance.Transfer(auth, recipient, amount)
+		if err4 != nil {
+			return err4
+		}
 		f.LastTx = tx
 		f.StrToPrint = fmt.Sprintf("info: Transferred %d tokens at " +
 			"FastTestToken (%s) to address %s\n", amount, f.Address, recipient)
@@ -170,13 +191,18 @@ func (f *FastTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		recipient := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := f.Instance.Approve(auth, recipient, amount)
+		recipient, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := f.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := f.Instance.Approve(auth, recipient, amount)
+		if err3 != nil {
+			return err3
+		}
 		f.LastTx = tx
 		f.StrToPrint = fmt.Sprintf("info: Approved %d tokens at " +
 			"FastTestToken (%s) to address %s\n", amount, f.Address, recipient)
@@ -185,14 +211,26 @@ func (f *FastTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		sender := common.HexToAddress(funcArgs[0])
-		recipient := common.HexToAddress(funcArgs[1])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[2], 10)
-		tx, err1 := f.Instance.TransferFrom(auth, sender, recipient, amount)
+		sender, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		recipient, err2 := f.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		amount, err3 := f.ParseAmount(funcArgs[2])
+		if err3 != nil {
+			return err3
+		}
+		err4 := f.CheckTransfer(auth, sender, amount, true)
+		if err4 != nil {
+			return err4
+		}
+		tx, err5 := f.Instance.TransferFrom(auth, sender, recipient, amount)
+		if err5 != nil {
+			return err5
+		}
 		f.LastTx = tx
 		f.StrToPrint = fmt.Sprintf("info: Transferred From %s %d tokens at " +
 			"FastTestToken (%s) to address %s\n", sender, amount, f.Address, recipient)
@@ -201,13 +239,18 @@ func (f *FastTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		spender := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := f.Instance.IncreaseAllowance(auth, spender, amount)
+		spender, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := f.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := f.Instance.IncreaseAllowance(auth, spender, amount)
+		if err3 != nil {
+			return err3
+		}
 		f.LastTx = tx
 		f.StrToPrint = fmt.Sprintf("info: Increased Allowance by %d tokens at " +
 			"FastTestToken (%s) to address %s\n", amount, f.Address, spender)
@@ -216,13 +259,18 @@ func (f *FastTestTokenContract) WriteContract(
 		if err != nil {
 			return err
 		}
-		spender := common.HexToAddress(funcArgs[0])
-		amount := new(big.Int)
-		amount.SetString(funcArgs[1], 10)
-		tx, err1 := f.Instance.DecreaseAllowance(auth, spender, amount)
+		spender, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		amount, err2 := f.ParseAmount(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := f.Instance.DecreaseAllowance(auth, spender, amount)
+		if err3 != nil {
+			return err3
+		}
 		f.LastTx = tx
 		f.StrToPrint = fmt.Sprintf("info: Decreased Allowance by %d tokens at " +
 			"FastTestToken (%s) from address %s\n", amount, f.Address, spender)
@@ -236,8 +284,10 @@ func (f *FastTestTokenContract) WriteContract(
 // change in the FastTestToken contract, this execution is based on
 // function name and arguments. Function arguments are verified for
 // length and then converted to fit the appropriate types.
-// Data retrieved is then stored in fastQueriableContractData
+// The call options select the block and caller the view calls are
+// executed with. Data retrieved is then stored in fastQueriableContractData
 func (f *FastTestTokenContract) QueryContract(
+	opts *bind.CallOpts,
 	funcName string,
 	funcArgs []string,
 ) error {
@@ -247,7 +297,7 @@ func (f *FastTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		name, err1 := f.Instance.Name(nil)
+		name, err1 := f.Instance.Name(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -259,7 +309,7 @@ func (f *FastTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		symbol, err1 := f.Instance.Symbol(nil)
+		symbol, err1 := f.Instance.Symbol(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -271,7 +321,7 @@ func (f *FastTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		decimals, err1 := f.Instance.Decimals(nil)
+		decimals, err1 := f.Instance.Decimals(opts)
 		if err1 != nil {
 			return err1
 		}
@@ -283,37 +333,58 @@ func (f *FastTestTokenContract) QueryContract(
 		if err != nil {
 			return err
 		}
-		totalSupply, err1 := f.Instance.TotalSupply(nil)
+		totalSupply, err1 := f.Instance.TotalSupply(opts)
 		if err1 != nil {
 			return err1
 		}
+		err2 := f.LoadDenomination(opts)
+		if err2 != nil {
+			return err2
+		}
 		f.TotalSupply = totalSupply
-		f.StrToPrint = fmt.Sprintf("info: Token TotalSupply: %d for FastTestToken (%s)" +
-			"\n", totalSupply, f.Address)
+		f.StrToPrint = fmt.Sprintf("info: Token TotalSupply: %s for FastTestToken (%s)" +
+			"\n", f.FormatAmount(totalSupply), f.Address)
 	case "balanceof":
 		err := utils.ValidateLength(&funcArgs, 1)
 		if err != nil {
 			return err
 		}
-		account := common.HexToAddress(funcArgs[0])
-		balOfAccount, err1 := f.Instance.BalanceOf(nil, account)
+		account, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		balOfAccount, err2 := f.Instance.BalanceOf(opts, account)
+		if err2 != nil {
+			return err2
+		}
+		err3 := f.LoadDenomination(opts)
+		if err3 != nil {
+			return err3
+		}
 		f.BalanceOf[account] = balOfAccount
-		f.StrToPrint = fmt.Sprintf("info: Token Balance of %s : %d for FastTestToken (%s)" +
-			"\n", account, balOfAccount, f.Address)
+		f.StrToPrint = fmt.Sprintf("info: Token Balance of %s : %s for FastTestToken (%s)" +
+			"\n", account, f.FormatAmount(balOfAccount), f.Address)
 	case "allowance":
 		err := utils.ValidateLength(&funcArgs, 2)
 		if err != nil {
 			return err
 		}
-		owner := common.HexToAddress(funcArgs[0])
-		spender := common.HexToAddress(funcArgs[1])
-		alwOfAccounts, err1 := f.Instance.Allowance(nil, owner, spender)
+		owner, err1 := f.ArgParser.ParseAddress(funcArgs[0])
 		if err1 != nil {
 			return err1
 		}
+		spender, err2 := f.ArgParser.ParseAddress(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		alwOfAccounts, err3 := f.Instance.Allowance(opts, owner, spender)
+		if err3 != nil {
+			return err3
+		}
+		err4 := f.LoadDenomination(opts)
+		if err4 != nil {
+			return err4
+		}
 		// Since this is a nested map of data we must check if `owner`
 		// has a map instantiated towards them, if not create one
 		if _, ok := f.Allowance[owner]; !ok {
@@ -321,10 +392,56 @@ func (f *FastTestTokenContract) QueryContract(
 		}
 		f.Allowance[owner][spender] = alwOfAccounts
 		f.StrToPrint = fmt.Sprintf("info: Token Allowance of spender %s " +
-			"from owner %s is %d for FastTestToken (%s)" +
-			"\n", spender, owner, alwOfAccounts, f.Address)
+			"from owner %s is %s for FastTestToken (%s)" +
+			"\n", spender, owner, f.FormatAmount(alwOfAccounts), f.Address)
+	case "info":
+		err := f.QuerySummary(opts, funcArgs)
+		if err != nil {
+			return err
+		}
 	default:
 		return nil
 	}
 	return nil
 }
+
+// QueryContractMulticall executes several query functions of the
+// FastTestToken contract atomically through the multicaller
+func (f *FastTestTokenContract) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller cc.Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	return f.Token.QueryContractMulticall(opts, multicaller, funcNames,
+		funcArgs)
+}
+
+// QueryEvents retrieves the Transfer, Approval and OwnershipTransferred
+// events of the FastTestToken contract
+func (f *FastTestTokenContract) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	return f.Token.QueryEvents(opts, eventName, filter)
+}
+
+// WatchEvents subscribes to the Transfer, Approval or OwnershipTransferred
+// events of the FastTestToken contract
+func (f *FastTestTokenContract) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	return f.Token.WatchEvents(opts, eventName, filter, sink)
+}
+
+// SetDeployments sets the deployments recorded by the contract deployer,
+// the FastTestToken summary reads its deployer from them when present
+func (f *FastTestTokenContract) SetDeployments(
+	deployments cc.IDeployments,
+) {
+	f.Deployments = deployments
+}
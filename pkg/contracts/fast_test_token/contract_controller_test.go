@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	utils "go-evm-client/internal/utils"
 	"math/big"
 	"testing"
 )
@@ -95,6 +96,11 @@ func (m *MockContractInstance) 	Allowance(
 	return (args.Get(0)).(*big.Int), args.Error(1)
 }
 
+func (m *MockContractInstance) Owner(_ *bind.CallOpts) (common.Address, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
 func TestQueryContractNameMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -138,7 +144,7 @@ func TestQueryContractNameMethod(t *testing.T) {
 				tt.expectedError)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -191,7 +197,7 @@ func TestQueryContractSymbolMethod(t *testing.T) {
 				tt.expectedError)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -244,7 +250,7 @@ func TestQueryContractDecimalsMethod(t *testing.T) {
 				tt.expectedError)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -267,7 +273,7 @@ func TestQueryContractTotalSupplyMethod(t *testing.T) {
 			testName: "QueryContract func TotalSupply successful all data returned.",
 			funcName: "totalsupply",
 			funcArgs: []string{},
-			strToPrint: "info: Token TotalSupply: 100000000000 for FastTestToken " +
+			strToPrint: "info: Token TotalSupply: 100000000000 (0.0000001 FTT) for FastTestToken " +
 				"(0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
@@ -295,9 +301,11 @@ func TestQueryContractTotalSupplyMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("TotalSupply", nil).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("FTT", nil)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -321,7 +329,7 @@ func TestQueryContractBalanceOfMethod(t *testing.T) {
 			funcName: "balanceof",
 			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"},
 			strToPrint: "info: Token Balance of 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df : " +
-				"100000000000 for FastTestToken (0x0000000000000000000000000000000000000000)\n",
+				"100000000000 (0.0000001 FTT) for FastTestToken (0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
 		},
@@ -349,11 +357,13 @@ func TestQueryContractBalanceOfMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("BalanceOf", nil, account).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("FTT", nil)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
 			// Need to set the empty map
 			fttc.BalanceOf = map[common.Address]*big.Int{}
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -378,7 +388,7 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df",
 				"0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"},
 			strToPrint: "info: Token Allowance of spender 0xfd6f5A60D2D8b12039F906D112f10Fb66F881087 " +
-				"from owner 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df is 100000000000 for FastTestToken " +
+				"from owner 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df is 100000000000 (0.0000001 FTT) for FastTestToken " +
 				"(0x0000000000000000000000000000000000000000)\n",
 			expectedError: nil,
 			expectedBigIntReturn: big.NewInt(100000000000),
@@ -410,10 +420,12 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Allowance", nil, owner, spender).Return(tt.expectedBigIntReturn,
 				tt.expectedError)
+			mInstance.On("Decimals", nil).Return(uint8(18), nil)
+			mInstance.On("Symbol", nil).Return("FTT", nil)
 			fttc := FastTestTokenContract{}
 			fttc.Instance = mInstance
 			fttc.Allowance = map[common.Address]map[common.Address]*big.Int{}
-			err := fttc.QueryContract(tt.funcName, tt.funcArgs)
+			err := fttc.QueryContract(nil, tt.funcName, tt.funcArgs)
 			if err != nil {
 				assert.Equal(t, err.Error(), tt.expectedError.Error())
 			}
@@ -423,7 +435,6 @@ func TestQueryContractAllowanceMethod(t *testing.T) {
 	}
 }
 
-
 func TestWriteContractTransferMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -469,6 +480,10 @@ func TestWriteContractTransferMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Transfer", auth, recipient, amount).Return(
@@ -530,6 +545,10 @@ func TestWriteContractApproveMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("Approve", auth, recipient, amount).Return(
@@ -594,6 +613,10 @@ func TestWriteContractTransferFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[2], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("TransferFrom", auth, sender, recipient,
@@ -654,6 +677,10 @@ func TestWriteContractIncreaseAllowanceFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("IncreaseAllowance", auth, spender,
@@ -670,7 +697,6 @@ func TestWriteContractIncreaseAllowanceFromMethod(t *testing.T) {
 	}
 }
 
-
 func TestWriteContractDecreaseAllowanceFromMethod(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -715,6 +741,10 @@ func TestWriteContractDecreaseAllowanceFromMethod(t *testing.T) {
 			amount := new(big.Int)
 			amount.SetString(tt.funcArgs[1], 10)
 			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
 			auth :=  &bind.TransactOpts{}
 			// Since this is run after validation it's fine to use only one error var
 			mInstance.On("DecreaseAllowance", auth, spender,
@@ -729,4 +759,79 @@ func TestWriteContractDecreaseAllowanceFromMethod(t *testing.T) {
 			assert.Equal(t, fttc.StrToPrint, tt.strToPrint)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestWriteContractTransferAddressValidation(t *testing.T) {
+	tests := []struct {
+		testName string
+		funcArgs []string
+		argParser *utils.ArgParser
+		expectedError error
+	}{
+		{
+			testName: "WriteContract func Transfer lowercase address accepted.",
+			funcArgs: []string{"0x86be6fc9b05b55cbd04f3161f9b481f27f90a8df", "1"},
+			argParser: nil,
+			expectedError: nil,
+		},
+		{
+			testName: "WriteContract func Transfer malformed address rejected.",
+			funcArgs: []string{"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: \"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8\" " +
+				"is not a valid hex address"),
+		},
+		{
+			testName: "WriteContract func Transfer bad checksum rejected.",
+			funcArgs: []string{"0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: address 0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"has an invalid EIP-55 checksum, expected " +
+				"0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"),
+		},
+		{
+			testName: "WriteContract func Transfer bad checksum only warned.",
+			funcArgs: []string{"0x86bE6FC9B05B55CBD04F3161f9b481f27F90a8Df", "1"},
+			argParser: &utils.ArgParser{WarnOnBadChecksum: true},
+			expectedError: nil,
+		},
+		{
+			testName: "WriteContract func Transfer zero address refused.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000", "1"},
+			argParser: nil,
+			expectedError: errors.New("error: refusing to use the zero address as " +
+				"recipient, use --force to override"),
+		},
+		{
+			testName: "WriteContract func Transfer zero address forced.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000", "1"},
+			argParser: &utils.ArgParser{AllowZeroAddress: true},
+			expectedError: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			recipient := common.HexToAddress(tt.funcArgs[0])
+			mInstance := new(MockContractInstance)
+			// The amounts are scaled by zero decimals so that they are
+			// sent as given
+			mInstance.On("Decimals", nil).Return(uint8(0), nil)
+			mInstance.On("Symbol", nil).Return("TTT", nil)
+			auth :=  &bind.TransactOpts{}
+			mInstance.On("Transfer", auth, recipient, big.NewInt(1)).Return(
+				&types.Transaction{}, nil)
+			fttc := FastTestTokenContract{}
+			fttc.Instance = mInstance
+			fttc.SetArgParser(tt.argParser)
+			err := fttc.WriteContract(auth, "transfer", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				mInstance.AssertNotCalled(t, "Transfer", auth, recipient,
+					big.NewInt(1))
+				return
+			}
+			assert.NoError(t, err)
+			mInstance.AssertCalled(t, "Transfer", auth, recipient, big.NewInt(1))
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package multicall3
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+)
+
+// IInstance is the interface needed for these contract functions
+type IInstance interface {
+	GetBlockNumber(opts *bind.CallOpts) (*big.Int, error)
+	GetEthBalance(opts *bind.CallOpts, addr common.Address) (*big.Int, error)
+}
+
+// Multicall3Contract contains all the data needed to deploy and
+// interact with the Multicall3 contract
+type Multicall3Contract struct {
+	cc.Contract
+	Address    common.Address
+	LastTx     *types.Transaction
+	Instance   IInstance
+	StrToPrint string
+	// ArgParser validates the address arguments, nil uses strict defaults
+	ArgParser *utils.ArgParser
+}
+
+// ParseConstructorArguments is kept here so that this contract can
+// be used by the templated Contract method, Multicall3 doesn't have
+// any constructor arguments
+func (m *Multicall3Contract) ParseConstructorArguments(
+	contractArgs []string) error {
+	return utils.ValidateLength(&contractArgs, 0)
+}
+
+// DeployContract deploys the Multicall3 contract and saves its instance,
+// tx of deployment and contract address
+func (m *Multicall3Contract) DeployContract(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+) error {
+	code, err := m.DeploymentCode()
+	if err != nil {
+		return err
+	}
+	parsed, err1 := Multicall3MetaData.GetAbi()
+	if err1 != nil {
+		return err1
+	}
+	address, tx, _, err2 := bind.DeployContract(auth, *parsed, code, client)
+	if err2 != nil {
+		return err2
+	}
+	instance, err3 := NewMulticall3(address, client)
+	if err3 != nil {
+		return err3
+	}
+	m.Address = address
+	m.LastTx = tx
+	m.Instance = instance
+	return nil
+}
+
+// DeploymentCode returns the creation bytecode of the Multicall3
+// contract, it doesn't take any constructor arguments
+func (m *Multicall3Contract) DeploymentCode() ([]byte, error) {
+	return common.FromHex(Multicall3MetaData.Bin), nil
+}
+
+// DeployContractCreate2 deploys the Multicall3 contract through the
+// CREATE2 factory and saves its instance, tx of deployment and contract
+// address
+func (m *Multicall3Contract) DeployContractCreate2(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer cc.Create2Deployer,
+) error {
+	initCode, err := m.DeploymentCode()
+	if err != nil {
+		return err
+	}
+	address, tx, err1 := deployer.Deploy(auth, initCode)
+	if err1 != nil {
+		return err1
+	}
+	instance, err2 := NewMulticall3(address, client)
+	if err2 != nil {
+		return err2
+	}
+	m.Address = address
+	m.LastTx = tx
+	m.Instance = instance
+	return nil
+}
+
+// LoadContract loads the Multicall3 contract and saves its instance,
+// contract address
+func (m *Multicall3Contract) LoadContract(
+	address *common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	instance, err := NewMulticall3(*address, client)
+	if err != nil {
+		return err
+	}
+	m.Instance = instance
+	m.Address = *address
+	return nil
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (m *Multicall3Contract) SetArgParser(parser *utils.ArgParser) {
+	m.ArgParser = parser
+}
+
+// PrintDeploymentData outputs to the terminal the address and
+// transaction of the deployed contract.
+func (m *Multicall3Contract) PrintDeploymentData() {
+	fmt.Printf("Multicall3 Contract successfully deployed at %s, "+
+		"see transaction here %s\n", m.Address.Hex(), m.LastTx.Hash().Hex())
+}
+
+// PrintLoadedContractData outs the success message of loading the
+// contract as well as the address it's loaded at.
+func (m *Multicall3Contract) PrintLoadedContractData() {
+	fmt.Printf("Multicall3 Contract successfully loaded at %s\n",
+		m.Address.Hex())
+}
+
+// PrintContractDataAfterExecution print out whatever was saved in StrToPrint
+func (m *Multicall3Contract) PrintContractDataAfterExecution() {
+	fmt.Printf("%s", m.StrToPrint)
+}
+
+// WriteContract is kept here so that this contract can be used by the
+// templated Contract method, aggregated calls are only made as queries
+func (m *Multicall3Contract) WriteContract(
+	_ *bind.TransactOpts,
+	_ string,
+	_ []string,
+) error {
+	return nil
+}
+
+// QueryContract executes the query functions of the Multicall3 contract
+// based on the function name and arguments, at the block and for the
+// caller set in the call options
+func (m *Multicall3Contract) QueryContract(
+	opts *bind.CallOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "getblocknumber":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		blockNumber, err1 := m.Instance.GetBlockNumber(opts)
+		if err1 != nil {
+			return err1
+		}
+		m.StrToPrint = fmt.Sprintf("info: Block Number: %d for Multicall3 "+
+			"(%s)\n", blockNumber, m.Address)
+	case "getethbalance":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		account, err1 := m.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		balance, err2 := m.Instance.GetEthBalance(opts, account)
+		if err2 != nil {
+			return err2
+		}
+		m.StrToPrint = fmt.Sprintf("info: Native Balance of %s : %d for "+
+			"Multicall3 (%s)\n", account, balance, m.Address)
+	default:
+		return nil
+	}
+	return nil
+}
+
+// QueryEvents is kept here so that this contract can be used by the
+// templated Contract method, Multicall3 doesn't emit any events
+func (m *Multicall3Contract) QueryEvents(
+	_ *bind.FilterOpts,
+	eventName string,
+	_ cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	return nil, fmt.Errorf("error: unsupported event %s", eventName)
+}
+
+// WatchEvents is kept here so that this contract can be used by the
+// templated Contract method, Multicall3 doesn't emit any events
+func (m *Multicall3Contract) WatchEvents(
+	_ *bind.WatchOpts,
+	eventName string,
+	_ cc.EventFilter,
+	_ chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	return nil, fmt.Errorf("error: unsupported event %s", eventName)
+}
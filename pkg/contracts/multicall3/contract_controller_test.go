@@ -0,0 +1,40 @@
+package multicall3
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	cc "go-evm-client/internal/contracts_template_interface"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"testing"
+)
+
+func TestDeployContractSimulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+
+	m := Multicall3Contract{}
+	err1 := m.DeployContract(auth, client)
+	assert.NoError(t, err1)
+	code, err2 := client.CodeAt(context.Background(), m.Address, nil)
+	assert.NoError(t, err2)
+	assert.NotEmpty(t, code)
+
+	// aggregate3 runs the views of the deployed contract itself
+	multicaller, err3 := NewMulticaller(m.Address, client)
+	assert.NoError(t, err3)
+	parsed, err4 := Multicall3MetaData.GetAbi()
+	assert.NoError(t, err4)
+	calls := []cc.ViewCall{
+		{Target: m.Address, ABI: parsed, Method: "getEthBalance",
+			Args: []interface{}{auth.From}},
+		{Target: m.Address, ABI: parsed, Method: "getChainId"},
+	}
+	block, outputs, err5 := multicaller.Aggregate(nil, calls)
+	assert.NoError(t, err5)
+	balance, err6 := client.BalanceAt(context.Background(), auth.From, nil)
+	assert.NoError(t, err6)
+	assert.Equal(t, block, big.NewInt(1))
+	assert.Equal(t, outputs, [][]interface{}{{balance}, {big.NewInt(1337)}})
+}
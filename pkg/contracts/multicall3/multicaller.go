@@ -0,0 +1,87 @@
+package multicall3
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	cc "go-evm-client/internal/contracts_template_interface"
+	"math/big"
+)
+
+// CanonicalAddress is the address Multicall3 is deployed at on most
+// chains, chains lacking it need their own deployment
+const CanonicalAddress = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// Multicaller aggregates view calls through the aggregate3 function of a
+// Multicall3 contract, it implements the cc.Multicaller interface
+type Multicaller struct {
+	Address  common.Address
+	Instance *Multicall3Caller
+}
+
+// NewMulticaller creates a Multicaller for the Multicall3 contract at the
+// given address
+func NewMulticaller(
+	address common.Address,
+	caller bind.ContractCaller,
+) (*Multicaller, error) {
+	instance, err := NewMulticall3Caller(address, caller)
+	if err != nil {
+		return nil, err
+	}
+	return &Multicaller{Address: address, Instance: instance}, nil
+}
+
+// Aggregate packs the view calls into a single aggregate3 eth_call, the
+// block number is read in the same call. Calls aren't allowed to fail so
+// a reverting call fails the whole aggregation instead of returning
+// partial data.
+func (m *Multicaller) Aggregate(
+	opts *bind.CallOpts,
+	calls []cc.ViewCall,
+) (*big.Int, [][]interface{}, error) {
+	parsed, err := Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	blockCall := cc.ViewCall{
+		Target: m.Address,
+		ABI:    parsed,
+		Method: "getBlockNumber",
+	}
+	calls = append([]cc.ViewCall{blockCall}, calls...)
+	calls3 := make([]Multicall3Call3, len(calls))
+	for i, call := range calls {
+		data, err1 := call.ABI.Pack(call.Method, call.Args...)
+		if err1 != nil {
+			return nil, nil, fmt.Errorf("error: failed to pack %s: %v",
+				call.Method, err1)
+		}
+		calls3[i] = Multicall3Call3{Target: call.Target, CallData: data}
+	}
+
+	var out []interface{}
+	err2 := m.Instance.contract.Call(opts, &out, "aggregate3", calls3)
+	if err2 != nil {
+		return nil, nil, fmt.Errorf("error: multicall through %s failed: %v",
+			m.Address.Hex(), err2)
+	}
+	results := *abi.ConvertType(out[0],
+		new([]Multicall3Result)).(*[]Multicall3Result)
+	if len(results) != len(calls) {
+		return nil, nil, fmt.Errorf("error: multicall returned %d results "+
+			"for %d calls", len(results), len(calls))
+	}
+
+	outputs := make([][]interface{}, len(calls))
+	for i, call := range calls {
+		values, err3 := call.ABI.Unpack(call.Method, results[i].ReturnData)
+		if err3 != nil {
+			return nil, nil, fmt.Errorf("error: failed to unpack %s: %v",
+				call.Method, err3)
+		}
+		outputs[i] = values
+	}
+	return outputs[0][0].(*big.Int), outputs[1:], nil
+}
@@ -0,0 +1,127 @@
+package multicall3
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	cc "go-evm-client/internal/contracts_template_interface"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+const testTokenABI = `[
+	{"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+]`
+
+// stubCaller answers aggregate3 calls with the configured results and
+// records the calls it received
+type stubCaller struct {
+	results []Multicall3Result
+	err     error
+	calls   []Multicall3Call3
+}
+
+func (s *stubCaller) CodeAt(
+	_ context.Context,
+	_ common.Address,
+	_ *big.Int,
+) ([]byte, error) {
+	return []byte{0x01}, nil
+}
+
+func (s *stubCaller) CallContract(
+	_ context.Context,
+	call ethereum.CallMsg,
+	_ *big.Int,
+) ([]byte, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	parsed, _ := Multicall3MetaData.GetAbi()
+	args, err := parsed.Methods["aggregate3"].Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	s.calls = *abi.ConvertType(args[0],
+		new([]Multicall3Call3)).(*[]Multicall3Call3)
+	return parsed.Methods["aggregate3"].Outputs.Pack(s.results)
+}
+
+func TestMulticallerAggregate(t *testing.T) {
+	multicallAddress := common.HexToAddress(CanonicalAddress)
+	tokenAddress := common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	account := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	parsed, _ := Multicall3MetaData.GetAbi()
+	tokenABI, _ := abi.JSON(strings.NewReader(testTokenABI))
+	blockData, _ := parsed.Methods["getBlockNumber"].Outputs.Pack(big.NewInt(42))
+	symbolData, _ := tokenABI.Methods["symbol"].Outputs.Pack("FTT")
+	balanceData, _ := tokenABI.Methods["balanceOf"].Outputs.Pack(big.NewInt(100))
+	calls := []cc.ViewCall{
+		{Target: tokenAddress, ABI: &tokenABI, Method: "symbol"},
+		{Target: tokenAddress, ABI: &tokenABI, Method: "balanceOf",
+			Args: []interface{}{account}},
+	}
+	tests := []struct {
+		testName        string
+		results         []Multicall3Result
+		callError       error
+		expectedBlock   *big.Int
+		expectedOutputs [][]interface{}
+		expectedError   error
+	}{
+		{
+			testName: "Aggregate successful.",
+			results: []Multicall3Result{
+				{Success: true, ReturnData: blockData},
+				{Success: true, ReturnData: symbolData},
+				{Success: true, ReturnData: balanceData},
+			},
+			expectedBlock: big.NewInt(42),
+			expectedOutputs: [][]interface{}{{"FTT"},
+				{big.NewInt(100)}},
+		},
+		{
+			testName:  "Aggregate reverted call.",
+			callError: errors.New("execution reverted: Multicall3: call failed"),
+			expectedError: errors.New("error: multicall through " +
+				multicallAddress.Hex() + " failed: execution reverted: " +
+				"Multicall3: call failed"),
+		},
+		{
+			testName: "Aggregate missing results.",
+			results: []Multicall3Result{
+				{Success: true, ReturnData: blockData},
+			},
+			expectedError: errors.New("error: multicall returned 1 results " +
+				"for 3 calls"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			caller := &stubCaller{results: tt.results, err: tt.callError}
+			multicaller, err := NewMulticaller(multicallAddress, caller)
+			assert.NoError(t, err)
+			block, outputs, err1 := multicaller.Aggregate(nil, calls)
+			if tt.expectedError != nil {
+				assert.Equal(t, err1.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err1)
+			assert.Equal(t, block, tt.expectedBlock)
+			assert.Equal(t, outputs, tt.expectedOutputs)
+			// The block number is read first from the multicall itself and
+			// failures aren't allowed
+			assert.Equal(t, len(caller.calls), 3)
+			assert.Equal(t, caller.calls[0].Target, multicallAddress)
+			assert.Equal(t, caller.calls[2].Target, tokenAddress)
+			for _, call := range caller.calls {
+				assert.False(t, call.AllowFailure)
+			}
+		})
+	}
+}
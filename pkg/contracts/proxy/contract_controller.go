@@ -0,0 +1,637 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"strings"
+)
+
+// IClient is the part of the blockchain client needed to read the slots of
+// the proxy and check for contract code
+type IClient interface {
+	IStorageReader
+	CodeAt(ctx context.Context, contract common.Address,
+		blockNumber *big.Int) ([]byte, error)
+}
+
+// ITransactor sends transactions to the functions of the ABI it is bound
+// with
+type ITransactor interface {
+	Transact(opts *bind.TransactOpts, method string,
+		params ...interface{}) (*types.Transaction, error)
+}
+
+// IEventInstance is the interface needed for the events of the proxy
+type IEventInstance interface {
+	FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (
+		*TransparentUpgradeableProxyUpgradedIterator, error)
+	FilterAdminChanged(opts *bind.FilterOpts) (
+		*TransparentUpgradeableProxyAdminChangedIterator, error)
+	WatchUpgraded(opts *bind.WatchOpts, sink chan<- *TransparentUpgradeableProxyUpgraded,
+		implementation []common.Address) (event.Subscription, error)
+	WatchAdminChanged(opts *bind.WatchOpts,
+		sink chan<- *TransparentUpgradeableProxyAdminChanged) (event.Subscription, error)
+}
+
+// proxyConstructorArgs contains the arguments of the proxy constructor
+type proxyConstructorArgs struct {
+	implementation common.Address
+	admin          common.Address
+	data           []byte
+}
+
+// The kinds of EIP-1967 proxies
+const (
+	// TransparentKind is upgraded by its admin through the proxy itself
+	TransparentKind = "transparent"
+	// UUPSKind forwards the upgrade functions to its implementation
+	UUPSKind = "uups"
+)
+
+// ProxyContract contains all the data needed to deploy and interact with
+// an EIP-1967 proxy. A TransparentUpgradeableProxy is upgraded by its
+// admin through the proxy itself. A UUPS proxy forwards the upgrades to
+// its implementation, none of the contracts deployed by this tool
+// implement them so existing UUPS proxies are loaded and upgraded but
+// never deployed.
+type ProxyContract struct {
+	cc.Contract
+	// Kind is TransparentKind or UUPSKind, empty is TransparentKind
+	Kind            string
+	Address         common.Address
+	LastTx          *types.Transaction
+	ConstructorArgs proxyConstructorArgs
+	Client          IClient
+	// Proxy sends the admin functions to the proxy
+	Proxy ITransactor
+	// AdminInstance sends the upgrade functions to the ProxyAdmin contract,
+	// it is nil when the admin of the proxy isn't a contract
+	AdminInstance  ITransactor
+	Events         IEventInstance
+	Implementation common.Address
+	Admin          common.Address
+	StrToPrint     string
+	// ArgParser validates the address arguments, nil uses strict defaults
+	ArgParser *utils.ArgParser
+}
+
+// name returns the name of the proxy contract
+func (p *ProxyContract) name() string {
+	if p.Kind == UUPSKind {
+		return "ERC1967Proxy"
+	}
+	return "TransparentUpgradeableProxy"
+}
+
+// uupsDeploymentError is returned when deploying a UUPS proxy
+func uupsDeploymentError() error {
+	return fmt.Errorf("error: UUPS proxies can't be deployed, the " +
+		"contracts this tool deploys don't implement the upgrade functions " +
+		"a UUPS proxy forwards to its implementation, existing ones can be " +
+		"loaded with --contract uups_proxy")
+}
+
+// ParseConstructorArguments parses the implementation, the admin of the
+// proxy and the optional hex encoded initializer call data
+func (p *ProxyContract) ParseConstructorArguments(
+	contractArgs []string) error {
+	if p.Kind == UUPSKind {
+		return uupsDeploymentError()
+	}
+	required := 2
+	if len(contractArgs) != required && len(contractArgs) != required+1 {
+		return fmt.Errorf("error: %d arguments does not match required %d "+
+			"or %d", len(contractArgs), required, required+1)
+	}
+	implementation, err := p.ArgParser.ParseAddress(contractArgs[0])
+	if err != nil {
+		return err
+	}
+	admin, err1 := p.ArgParser.ParseAddress(contractArgs[1])
+	if err1 != nil {
+		return err1
+	}
+	if admin == (common.Address{}) {
+		return fmt.Errorf("error: the admin of a transparent proxy can't " +
+			"be the zero address")
+	}
+	p.ConstructorArgs = proxyConstructorArgs{
+		implementation: implementation,
+		admin:          admin,
+	}
+	if len(contractArgs) == required+1 {
+		data, err2 := parseCallData(contractArgs[required])
+		if err2 != nil {
+			return err2
+		}
+		p.ConstructorArgs.data = data
+	}
+	return nil
+}
+
+// parseCallData decodes hex encoded call data, an empty value means no
+// call
+func parseCallData(arg string) ([]byte, error) {
+	if arg == "" || arg == "0x" {
+		return []byte{}, nil
+	}
+	data, err := hexutil.Decode(arg)
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not valid hex call data", arg)
+	}
+	return data, nil
+}
+
+// DeployContract deploys the proxy and saves its instances, tx of
+// deployment and contract address
+func (p *ProxyContract) DeployContract(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+) error {
+	if p.Kind == UUPSKind {
+		return uupsDeploymentError()
+	}
+	parsed, err := TransparentUpgradeableProxyMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	args := p.ConstructorArgs
+	address, tx, _, err1 := bind.DeployContract(auth, *parsed, Bytecode(),
+		client, args.implementation, args.admin, args.data)
+	if err1 != nil {
+		return err1
+	}
+	err2 := p.bind(address, client)
+	if err2 != nil {
+		return err2
+	}
+	p.LastTx = tx
+	p.Implementation = args.implementation
+	p.Admin = args.admin
+	return nil
+}
+
+// DeploymentCode returns the creation bytecode of the proxy followed by
+// the parsed constructor arguments
+func (p *ProxyContract) DeploymentCode() ([]byte, error) {
+	if p.Kind == UUPSKind {
+		return nil, uupsDeploymentError()
+	}
+	parsed, err := TransparentUpgradeableProxyMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	packed, err1 := parsed.Pack("", p.ConstructorArgs.implementation,
+		p.ConstructorArgs.admin, p.ConstructorArgs.data)
+	if err1 != nil {
+		return nil, err1
+	}
+	return append(Bytecode(), packed...), nil
+}
+
+// DeployContractCreate2 deploys the proxy through the CREATE2 factory and
+// saves its instances, tx of deployment and contract address
+func (p *ProxyContract) DeployContractCreate2(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+	deployer cc.Create2Deployer,
+) error {
+	initCode, err := p.DeploymentCode()
+	if err != nil {
+		return err
+	}
+	address, tx, err1 := deployer.Deploy(auth, initCode)
+	if err1 != nil {
+		return err1
+	}
+	err2 := p.bind(address, client)
+	if err2 != nil {
+		return err2
+	}
+	p.LastTx = tx
+	p.Implementation = p.ConstructorArgs.implementation
+	p.Admin = p.ConstructorArgs.admin
+	return nil
+}
+
+// bind creates the instances used to upgrade the proxy and read its
+// events
+func (p *ProxyContract) bind(
+	address common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	metaData := TransparentUpgradeableProxyMetaData
+	if p.Kind == UUPSKind {
+		metaData = UUPSProxyMetaData
+	}
+	parsed, err := abi.JSON(strings.NewReader(metaData.ABI))
+	if err != nil {
+		return err
+	}
+	events, err1 := NewTransparentUpgradeableProxyFilterer(address,
+		client)
+	if err1 != nil {
+		return err1
+	}
+	p.Address = address
+	p.Client = client
+	p.Proxy = bind.NewBoundContract(address, parsed, client, client, client)
+	p.Events = events
+	return nil
+}
+
+// LoadContract loads the proxy and reads its implementation and admin
+// slots, the ProxyAdmin instance is bound when the admin is a contract.
+// The admin slot of a UUPS proxy is usually empty.
+func (p *ProxyContract) LoadContract(
+	address *common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	err := p.bind(*address, client)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	implementation, err1 := ReadSlot(ctx, client, *address,
+		ImplementationSlot, nil)
+	if err1 != nil {
+		return err1
+	}
+	if implementation == (common.Address{}) {
+		return fmt.Errorf("error: %s isn't an EIP-1967 proxy, its "+
+			"implementation slot is empty", address.Hex())
+	}
+	admin, err2 := ReadSlot(ctx, client, *address, AdminSlot, nil)
+	if err2 != nil {
+		return err2
+	}
+	p.Implementation = implementation
+	p.Admin = admin
+	if p.Kind == UUPSKind {
+		return nil
+	}
+	if admin == (common.Address{}) {
+		return fmt.Errorf("error: %s isn't a transparent proxy, its admin "+
+			"slot is empty, load it with --contract uups_proxy", address.Hex())
+	}
+	code, err3 := client.CodeAt(ctx, admin, nil)
+	if err3 != nil {
+		return err3
+	}
+	if len(code) != 0 {
+		parsed, err4 := ProxyAdminMetaData.GetAbi()
+		if err4 != nil {
+			return err4
+		}
+		p.AdminInstance = bind.NewBoundContract(admin, *parsed, client,
+			client, client)
+	}
+	return nil
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (p *ProxyContract) SetArgParser(parser *utils.ArgParser) {
+	p.ArgParser = parser
+}
+
+// PrintDeploymentData outputs to the terminal the address and
+// transaction of the deployed contract.
+func (p *ProxyContract) PrintDeploymentData() {
+	fmt.Printf("%s Contract successfully deployed at %s with implementation "+
+		"%s, see transaction here %s\n", p.name(), p.Address.Hex(),
+		p.Implementation.Hex(), p.LastTx.Hash().Hex())
+}
+
+// PrintLoadedContractData outs the success message of loading the
+// contract as well as the address it's loaded at.
+func (p *ProxyContract) PrintLoadedContractData() {
+	fmt.Printf("%s Contract successfully loaded at %s\n", p.name(),
+		p.Address.Hex())
+}
+
+// PrintContractDataAfterExecution print out whatever was saved in StrToPrint
+func (p *ProxyContract) PrintContractDataAfterExecution() {
+	fmt.Printf("%s", p.StrToPrint)
+}
+
+// WriteContract upgrades the proxy or changes its admin based on the
+// function name and arguments
+func (p *ProxyContract) WriteContract(
+	auth *bind.TransactOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "upgradeto":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		implementation, err1 := p.parseImplementation(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		tx, err2 := p.upgrade(auth, implementation, nil)
+		if err2 != nil {
+			return err2
+		}
+		p.LastTx = tx
+		p.StrToPrint = fmt.Sprintf("info: Upgraded %s (%s) to implementation "+
+			"%s\n", p.name(), p.Address.Hex(), implementation.Hex())
+	case "upgradetoandcall":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		implementation, err1 := p.parseImplementation(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		data, err2 := parseCallData(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := p.upgrade(auth, implementation, data)
+		if err3 != nil {
+			return err3
+		}
+		p.LastTx = tx
+		p.StrToPrint = fmt.Sprintf("info: Upgraded %s (%s) to implementation "+
+			"%s and called it with %s\n", p.name(), p.Address.Hex(),
+			implementation.Hex(), hexutil.Encode(data))
+	case "changeadmin":
+		if p.Kind == UUPSKind {
+			return fmt.Errorf("error: changeAdmin is only available on " +
+				"transparent proxies")
+		}
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		admin, err1 := p.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		if admin == (common.Address{}) {
+			return fmt.Errorf("error: the admin of a transparent proxy can't " +
+				"be the zero address")
+		}
+		tx, err2 := p.asAdmin(auth, "changeAdmin", admin)
+		if err2 != nil {
+			return err2
+		}
+		p.LastTx = tx
+		p.StrToPrint = fmt.Sprintf("info: Changed the admin of %s (%s) to "+
+			"%s\n", p.name(), p.Address.Hex(), admin.Hex())
+	default:
+		return nil
+	}
+	return nil
+}
+
+// parseImplementation parses the new implementation of an upgrade and
+// checks that it is a contract, the proxy would revert otherwise
+func (p *ProxyContract) parseImplementation(
+	arg string) (common.Address, error) {
+	implementation, err := p.ArgParser.ParseAddress(arg)
+	if err != nil {
+		return common.Address{}, err
+	}
+	code, err1 := p.Client.CodeAt(context.Background(), implementation, nil)
+	if err1 != nil {
+		return common.Address{}, err1
+	}
+	if len(code) == 0 {
+		return common.Address{}, fmt.Errorf("error: no contract code at "+
+			"implementation %s", implementation.Hex())
+	}
+	return implementation, nil
+}
+
+// upgrade sends upgradeTo, or upgradeToAndCall when there is call data,
+// through the admin of a transparent proxy. A UUPS proxy forwards them to
+// its implementation, which checks the sender.
+func (p *ProxyContract) upgrade(
+	auth *bind.TransactOpts,
+	implementation common.Address,
+	data []byte,
+) (*types.Transaction, error) {
+	method, params := "upgradeTo", []interface{}{implementation}
+	if data != nil {
+		method, params = "upgradeToAndCall", append(params, data)
+	}
+	if p.Kind == UUPSKind {
+		return p.Proxy.Transact(auth, method, params...)
+	}
+	return p.asAdmin(auth, method, params...)
+}
+
+// proxyAdminMethods maps the admin functions of a transparent proxy to
+// the ProxyAdmin functions forwarding them
+var proxyAdminMethods = map[string]string{
+	"upgradeTo":        "upgrade",
+	"upgradeToAndCall": "upgradeAndCall",
+	"changeAdmin":      "changeProxyAdmin",
+}
+
+// asAdmin calls an admin function of a transparent proxy, directly when
+// the sender is the admin or through the ProxyAdmin contract when the
+// admin is one. Calls of any other account would reach the implementation.
+func (p *ProxyContract) asAdmin(
+	auth *bind.TransactOpts,
+	method string,
+	params ...interface{},
+) (*types.Transaction, error) {
+	if auth.From == p.Admin {
+		return p.Proxy.Transact(auth, method, params...)
+	}
+	if p.AdminInstance != nil {
+		return p.AdminInstance.Transact(auth, proxyAdminMethods[method],
+			append([]interface{}{p.Address}, params...)...)
+	}
+	return nil, fmt.Errorf("error: only the proxy admin %s can call %s, not "+
+		"%s", p.Admin.Hex(), method, auth.From.Hex())
+}
+
+// QueryContract reads the implementation or admin slot of the proxy at
+// the block set in the call options
+func (p *ProxyContract) QueryContract(
+	opts *bind.CallOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	ctx, blockNumber := context.Background(), (*big.Int)(nil)
+	if opts != nil {
+		blockNumber = opts.BlockNumber
+		if opts.Context != nil {
+			ctx = opts.Context
+		}
+	}
+	switch funcName {
+	case "implementation":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		implementation, err1 := ReadSlot(ctx, p.Client, p.Address,
+			ImplementationSlot, blockNumber)
+		if err1 != nil {
+			return err1
+		}
+		p.Implementation = implementation
+		p.StrToPrint = fmt.Sprintf("info: Implementation: %s for %s (%s)\n",
+			implementation.Hex(), p.name(), p.Address.Hex())
+	case "admin":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		admin, err1 := ReadSlot(ctx, p.Client, p.Address, AdminSlot,
+			blockNumber)
+		if err1 != nil {
+			return err1
+		}
+		p.Admin = admin
+		description := admin.Hex()
+		if admin == (common.Address{}) {
+			description = "none"
+		} else {
+			code, err2 := p.Client.CodeAt(ctx, admin, blockNumber)
+			if err2 != nil {
+				return err2
+			}
+			if len(code) != 0 {
+				description += " (contract)"
+			}
+		}
+		p.StrToPrint = fmt.Sprintf("info: Admin: %s for %s (%s)\n",
+			description, p.name(), p.Address.Hex())
+	default:
+		return nil
+	}
+	return nil
+}
+
+// QueryEvents retrieves the Upgraded or AdminChanged events of the proxy
+// emitted within the block range of the filter options. The From filter
+// matches the implementation of Upgraded events.
+func (p *ProxyContract) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	var records []cc.EventRecord
+	switch eventName {
+	case "upgraded":
+		iterator, err := p.Events.FilterUpgraded(opts, filter.From)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, upgradedRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "adminchanged":
+		iterator, err := p.Events.FilterAdminChanged(opts)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, adminChangedRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	default:
+		return nil, nil
+	}
+	return records, nil
+}
+
+// WatchEvents subscribes to the Upgraded or AdminChanged events of the
+// proxy and forwards them decoded to the sink
+func (p *ProxyContract) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	switch eventName {
+	case "upgraded":
+		events := make(chan *TransparentUpgradeableProxyUpgraded)
+		sub, err := p.Events.WatchUpgraded(opts, events, filter.From)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- upgradedRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "adminchanged":
+		events := make(chan *TransparentUpgradeableProxyAdminChanged)
+		sub, err := p.Events.WatchAdminChanged(opts, events)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- adminChangedRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	default:
+		return nil, fmt.Errorf("error: unsupported event %s", eventName)
+	}
+}
+
+// upgradedRecord converts a decoded Upgraded event into an EventRecord
+func upgradedRecord(ev *TransparentUpgradeableProxyUpgraded) cc.EventRecord {
+	return cc.NewEventRecord("Upgraded", ev.Raw,
+		cc.EventArg{Name: "implementation", Value: ev.Implementation.Hex()})
+}
+
+// adminChangedRecord converts a decoded AdminChanged event into an
+// EventRecord
+func adminChangedRecord(ev *TransparentUpgradeableProxyAdminChanged) cc.EventRecord {
+	return cc.NewEventRecord("AdminChanged", ev.Raw,
+		cc.EventArg{Name: "previousAdmin", Value: ev.PreviousAdmin.Hex()},
+		cc.EventArg{Name: "newAdmin", Value: ev.NewAdmin.Hex()})
+}
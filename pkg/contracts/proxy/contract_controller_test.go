@@ -0,0 +1,423 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-evm-client/pkg/contracts/multicall3"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"testing"
+)
+
+type MockTransactor struct {
+	mock.Mock
+}
+
+func (m *MockTransactor) Transact(
+	opts *bind.TransactOpts,
+	method string,
+	params ...interface{},
+) (*types.Transaction, error) {
+	args := m.Called(opts, method, params)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+type MockClient struct {
+	mock.Mock
+}
+
+func (m *MockClient) StorageAt(
+	ctx context.Context,
+	account common.Address,
+	key common.Hash,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	args := m.Called(key, blockNumber)
+	return (args.Get(0)).([]byte), args.Error(1)
+}
+
+func (m *MockClient) CodeAt(
+	ctx context.Context,
+	contract common.Address,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	args := m.Called(contract, blockNumber)
+	return (args.Get(0)).([]byte), args.Error(1)
+}
+
+const (
+	adminAddress          = "0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"
+	implementationAddress = "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF"
+)
+
+func TestParseConstructorArguments(t *testing.T) {
+	tests := []struct {
+		testName      string
+		kind          string
+		contractArgs  []string
+		expectedArgs  proxyConstructorArgs
+		expectedError error
+	}{
+		{
+			testName:     "ParseConstructorArguments transparent with init data.",
+			contractArgs: []string{implementationAddress, adminAddress, "0x8129fc1c"},
+			expectedArgs: proxyConstructorArgs{
+				implementation: common.HexToAddress(implementationAddress),
+				admin:          common.HexToAddress(adminAddress),
+				data:           common.FromHex("0x8129fc1c"),
+			},
+		},
+		{
+			testName:     "ParseConstructorArguments transparent without init data.",
+			contractArgs: []string{implementationAddress, adminAddress},
+			expectedArgs: proxyConstructorArgs{
+				implementation: common.HexToAddress(implementationAddress),
+				admin:          common.HexToAddress(adminAddress),
+			},
+		},
+		{
+			testName:     "ParseConstructorArguments fail transparent without admin.",
+			contractArgs: []string{implementationAddress},
+			expectedError: errors.New("error: 1 arguments does not match " +
+				"required 2 or 3"),
+		},
+		{
+			testName: "ParseConstructorArguments fail zero admin.",
+			contractArgs: []string{implementationAddress,
+				"0x0000000000000000000000000000000000000000"},
+			expectedError: errors.New("error: the admin of a transparent " +
+				"proxy can't be the zero address"),
+		},
+		{
+			testName:     "ParseConstructorArguments fail invalid init data.",
+			contractArgs: []string{implementationAddress, adminAddress, "0xzz"},
+			expectedError: errors.New("error: \"0xzz\" is not valid hex call " +
+				"data"),
+		},
+		{
+			testName:     "ParseConstructorArguments fail uups proxy.",
+			kind:         UUPSKind,
+			contractArgs: []string{implementationAddress},
+			expectedError: errors.New("error: UUPS proxies can't be deployed, " +
+				"the contracts this tool deploys don't implement the upgrade " +
+				"functions a UUPS proxy forwards to its implementation, " +
+				"existing ones can be loaded with --contract uups_proxy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			p := ProxyContract{Kind: tt.kind}
+			err := p.ParseConstructorArguments(tt.contractArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, p.ConstructorArgs, tt.expectedArgs)
+		})
+	}
+}
+
+func TestWriteContractUpgradeToMethod(t *testing.T) {
+	implementation := common.HexToAddress(implementationAddress)
+	tests := []struct {
+		testName        string
+		kind            string
+		from            string
+		adminIsContract bool
+		code            []byte
+		expectedMethod  string
+		expectedParams  []interface{}
+		strToPrint      string
+		expectedError   error
+		expectedTx      *types.Transaction
+	}{
+		{
+			testName:       "WriteContract func UpgradeTo sent by the admin.",
+			from:           adminAddress,
+			code:           []byte{0x01},
+			expectedMethod: "upgradeTo",
+			expectedParams: []interface{}{implementation},
+			strToPrint: "info: Upgraded TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000) to " +
+				"implementation " + implementationAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName:        "WriteContract func UpgradeTo through the ProxyAdmin.",
+			from:            "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+			adminIsContract: true,
+			code:            []byte{0x01},
+			expectedMethod:  "upgrade",
+			expectedParams:  []interface{}{common.Address{}, implementation},
+			strToPrint: "info: Upgraded TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000) to " +
+				"implementation " + implementationAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName:       "WriteContract func UpgradeTo uups through the proxy.",
+			kind:           UUPSKind,
+			from:           "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+			code:           []byte{0x01},
+			expectedMethod: "upgradeTo",
+			expectedParams: []interface{}{implementation},
+			strToPrint: "info: Upgraded ERC1967Proxy " +
+				"(0x0000000000000000000000000000000000000000) to " +
+				"implementation " + implementationAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func UpgradeTo fail not the admin.",
+			from:     "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+			code:     []byte{0x01},
+			expectedError: errors.New("error: only the proxy admin " +
+				adminAddress + " can call upgradeTo, not " +
+				"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"),
+		},
+		{
+			testName: "WriteContract func UpgradeTo fail implementation not a contract.",
+			from:     adminAddress,
+			code:     []byte{},
+			expectedError: errors.New("error: no contract code at " +
+				"implementation " + implementationAddress),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{From: common.HexToAddress(tt.from)}
+			mClient := new(MockClient)
+			mClient.On("CodeAt", implementation, (*big.Int)(nil)).Return(
+				tt.code, nil)
+			mProxy := new(MockTransactor)
+			mProxy.On("Transact", auth, tt.expectedMethod,
+				tt.expectedParams).Return(tt.expectedTx, nil)
+			p := ProxyContract{Kind: tt.kind}
+			p.Client = mClient
+			p.Proxy = mProxy
+			p.Admin = common.HexToAddress(adminAddress)
+			if tt.adminIsContract {
+				mAdmin := new(MockTransactor)
+				mAdmin.On("Transact", auth, tt.expectedMethod,
+					tt.expectedParams).Return(tt.expectedTx, nil)
+				p.AdminInstance = mAdmin
+				p.Proxy = nil
+			}
+			err := p.WriteContract(auth, "upgradeto",
+				[]string{implementationAddress})
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, p.LastTx, tt.expectedTx)
+			assert.Equal(t, p.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestWriteContractChangeAdminMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		kind          string
+		funcArgs      []string
+		strToPrint    string
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName: "WriteContract func ChangeAdmin successful.",
+			funcArgs: []string{implementationAddress},
+			strToPrint: "info: Changed the admin of TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000) to " +
+				implementationAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func ChangeAdmin fail uups proxy.",
+			kind:     UUPSKind,
+			funcArgs: []string{implementationAddress},
+			expectedError: errors.New("error: changeAdmin is only available " +
+				"on transparent proxies"),
+		},
+		{
+			testName: "WriteContract func ChangeAdmin fail zero admin.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000"},
+			expectedError: errors.New("error: the admin of a transparent " +
+				"proxy can't be the zero address"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{From: common.HexToAddress(adminAddress)}
+			mProxy := new(MockTransactor)
+			mProxy.On("Transact", auth, "changeAdmin", []interface{}{
+				common.HexToAddress(tt.funcArgs[0])}).Return(tt.expectedTx, nil)
+			p := ProxyContract{Kind: tt.kind}
+			p.Proxy = mProxy
+			p.Admin = common.HexToAddress(adminAddress)
+			err := p.WriteContract(auth, "changeadmin", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, p.LastTx, tt.expectedTx)
+			assert.Equal(t, p.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestQueryContractSlotsMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		funcName      string
+		slot          string
+		value         []byte
+		code          []byte
+		strToPrint    string
+		expectedError error
+	}{
+		{
+			testName: "QueryContract func Implementation successful.",
+			funcName: "implementation",
+			slot:     ImplementationSlot,
+			value: common.LeftPadBytes(
+				common.FromHex(implementationAddress), 32),
+			strToPrint: "info: Implementation: " + implementationAddress +
+				" for TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName: "QueryContract func Admin contract.",
+			funcName: "admin",
+			slot:     AdminSlot,
+			value:    common.LeftPadBytes(common.FromHex(adminAddress), 32),
+			code:     []byte{0x01},
+			strToPrint: "info: Admin: " + adminAddress + " (contract) for " +
+				"TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName: "QueryContract func Admin not set.",
+			funcName: "admin",
+			slot:     AdminSlot,
+			value:    make([]byte, 32),
+			strToPrint: "info: Admin: none for TransparentUpgradeableProxy " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName:      "QueryContract func Implementation client failure.",
+			funcName:      "implementation",
+			slot:          ImplementationSlot,
+			value:         []byte{},
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			opts := &bind.CallOpts{BlockNumber: big.NewInt(10)}
+			mClient := new(MockClient)
+			mClient.On("StorageAt", common.HexToHash(tt.slot),
+				opts.BlockNumber).Return(tt.value, tt.expectedError)
+			mClient.On("CodeAt", common.HexToAddress(adminAddress),
+				opts.BlockNumber).Return(tt.code, nil)
+			p := ProxyContract{}
+			p.Client = mClient
+			err := p.QueryContract(opts, tt.funcName, []string{})
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, p.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestDeployContractSimulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+	admin, _, _, err1 := DeployProxyAdmin(auth, client)
+	assert.NoError(t, err1)
+	implementation, _, _, err2 := multicall3.DeployMulticall3(auth, client)
+	assert.NoError(t, err2)
+	upgrade, _, _, err3 := multicall3.DeployMulticall3(auth, client)
+	assert.NoError(t, err3)
+
+	p := ProxyContract{}
+	err4 := p.ParseConstructorArguments([]string{implementation.Hex(),
+		admin.Hex()})
+	assert.NoError(t, err4)
+	err5 := p.DeployContract(auth, client)
+	assert.NoError(t, err5)
+	ctx := context.Background()
+	implementationSlot, err6 := ReadSlot(ctx, client, p.Address,
+		ImplementationSlot, nil)
+	assert.NoError(t, err6)
+	assert.Equal(t, implementationSlot, implementation)
+	adminSlot, err7 := ReadSlot(ctx, client, p.Address, AdminSlot, nil)
+	assert.NoError(t, err7)
+	assert.Equal(t, adminSlot, admin)
+
+	// Calls of any account but the admin are delegated to the
+	// implementation
+	instance, err8 := multicall3.NewMulticall3Caller(p.Address, client)
+	assert.NoError(t, err8)
+	chainID, err9 := instance.GetChainId(nil)
+	assert.NoError(t, err9)
+	assert.Equal(t, chainID, big.NewInt(1337))
+
+	// The owner of the ProxyAdmin upgrades the loaded proxy through it
+	loaded := ProxyContract{}
+	err10 := loaded.LoadContract(&p.Address, client)
+	assert.NoError(t, err10)
+	assert.Equal(t, loaded.Implementation, implementation)
+	assert.Equal(t, loaded.Admin, admin)
+	err11 := loaded.WriteContract(auth, "upgradeto", []string{upgrade.Hex()})
+	assert.NoError(t, err11)
+	err12 := loaded.QueryContract(nil, "implementation", []string{})
+	assert.NoError(t, err12)
+	assert.Equal(t, loaded.Implementation, upgrade)
+}
+
+func TestLoadContractUUPSSimulated(t *testing.T) {
+	// A UUPS proxy only sets the implementation slot, the genesis stores
+	// it in an account standing for the proxy
+	proxyAddress := common.HexToAddress(adminAddress)
+	implementation := common.HexToAddress(implementationAddress)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		proxyAddress: {Code: []byte{0x00}, Balance: big.NewInt(0),
+			Storage: map[common.Hash]common.Hash{
+				common.HexToHash(ImplementationSlot): implementation.Hash(),
+			}},
+		implementation: {Code: []byte{0x00}, Balance: big.NewInt(0)},
+	}, 30000000)
+	client := &simulated_client.SimulatedClient{SimulatedBackend: backend}
+	defer client.Close()
+
+	transparent := ProxyContract{}
+	err := transparent.LoadContract(&proxyAddress, client)
+	assert.Equal(t, err.Error(), "error: "+proxyAddress.Hex()+" isn't a "+
+		"transparent proxy, its admin slot is empty, load it with "+
+		"--contract uups_proxy")
+
+	p := ProxyContract{Kind: UUPSKind}
+	err1 := p.LoadContract(&proxyAddress, client)
+	assert.NoError(t, err1)
+	assert.Equal(t, p.Implementation, implementation)
+	assert.Equal(t, p.Admin, common.Address{})
+	assert.Nil(t, p.AdminInstance)
+	err2 := p.QueryContract(nil, "admin", []string{})
+	assert.NoError(t, err2)
+	assert.Equal(t, p.StrToPrint, "info: Admin: none for ERC1967Proxy ("+
+		proxyAddress.Hex()+")\n")
+}
@@ -0,0 +1,57 @@
+package proxy
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// ImplementationSlot is the EIP-1967 storage slot of the implementation,
+// keccak256("eip1967.proxy.implementation") - 1
+const ImplementationSlot = "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"
+
+// AdminSlot is the EIP-1967 storage slot of the admin,
+// keccak256("eip1967.proxy.admin") - 1
+const AdminSlot = "0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"
+
+// UUPSProxyMetaData contains the ABI of a UUPS proxy, the EIP-1967 events
+// of the proxy and the upgrade functions it forwards to its implementation.
+// It has no bytecode, UUPS proxies are only loaded.
+var UUPSProxyMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+}
+
+// Bytecode returns the creation bytecode of the OpenZeppelin
+// TransparentUpgradeableProxy
+func Bytecode() []byte {
+	return common.FromHex(TransparentUpgradeableProxyMetaData.Bin)
+}
+
+// IStorageReader is the part of the blockchain client needed to read the
+// EIP-1967 slots of a proxy
+type IStorageReader interface {
+	StorageAt(
+		ctx context.Context,
+		account common.Address,
+		key common.Hash,
+		blockNumber *big.Int,
+	) ([]byte, error)
+}
+
+// ReadSlot reads the address stored in an EIP-1967 slot of the proxy, the
+// zero address means the slot isn't set
+func ReadSlot(
+	ctx context.Context,
+	client IStorageReader,
+	proxy common.Address,
+	slot string,
+	blockNumber *big.Int,
+) (common.Address, error) {
+	value, err := client.StorageAt(ctx, proxy, common.HexToHash(slot),
+		blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(value), nil
+}
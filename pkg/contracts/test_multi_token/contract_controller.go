@@ -0,0 +1,941 @@
+package test_multi_token
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// IInstance is the interface needed for these contract functions
+type IInstance interface {
+	Mint(
+		opts *bind.TransactOpts,
+		to common.Address,
+		id *big.Int,
+		amount *big.Int,
+		data []byte,
+	) (*types.Transaction, error)
+	MintBatch(
+		opts *bind.TransactOpts,
+		to common.Address,
+		ids []*big.Int,
+		amounts []*big.Int,
+		data []byte,
+	) (*types.Transaction, error)
+	SetApprovalForAll(
+		opts *bind.TransactOpts,
+		operator common.Address,
+		approved bool,
+	) (*types.Transaction, error)
+	SafeTransferFrom(
+		opts *bind.TransactOpts,
+		from common.Address,
+		to common.Address,
+		id *big.Int,
+		amount *big.Int,
+		data []byte,
+	) (*types.Transaction, error)
+	SafeBatchTransferFrom(
+		opts *bind.TransactOpts,
+		from common.Address,
+		to common.Address,
+		ids []*big.Int,
+		amounts []*big.Int,
+		data []byte,
+	) (*types.Transaction, error)
+	Uri(opts *bind.CallOpts, id *big.Int) (string, error)
+	BalanceOf(
+		opts *bind.CallOpts,
+		account common.Address,
+		id *big.Int,
+	) (*big.Int, error)
+	BalanceOfBatch(
+		opts *bind.CallOpts,
+		accounts []common.Address,
+		ids []*big.Int,
+	) ([]*big.Int, error)
+	IsApprovedForAll(
+		opts *bind.CallOpts,
+		account common.Address,
+		operator common.Address,
+	) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	FilterTransferSingle(
+		opts *bind.FilterOpts,
+		operator []common.Address,
+		from []common.Address,
+		to []common.Address,
+	) (*TestMultiTokenTransferSingleIterator, error)
+	FilterTransferBatch(
+		opts *bind.FilterOpts,
+		operator []common.Address,
+		from []common.Address,
+		to []common.Address,
+	) (*TestMultiTokenTransferBatchIterator, error)
+	FilterApprovalForAll(
+		opts *bind.FilterOpts,
+		account []common.Address,
+		operator []common.Address,
+	) (*TestMultiTokenApprovalForAllIterator, error)
+	FilterOwnershipTransferred(
+		opts *bind.FilterOpts,
+		previousOwner []common.Address,
+		newOwner []common.Address,
+	) (*TestMultiTokenOwnershipTransferredIterator, error)
+	WatchTransferSingle(
+		opts *bind.WatchOpts,
+		sink chan<- *TestMultiTokenTransferSingle,
+		operator []common.Address,
+		from []common.Address,
+		to []common.Address,
+	) (event.Subscription, error)
+	WatchTransferBatch(
+		opts *bind.WatchOpts,
+		sink chan<- *TestMultiTokenTransferBatch,
+		operator []common.Address,
+		from []common.Address,
+		to []common.Address,
+	) (event.Subscription, error)
+	WatchApprovalForAll(
+		opts *bind.WatchOpts,
+		sink chan<- *TestMultiTokenApprovalForAll,
+		account []common.Address,
+		operator []common.Address,
+	) (event.Subscription, error)
+	WatchOwnershipTransferred(
+		opts *bind.WatchOpts,
+		sink chan<- *TestMultiTokenOwnershipTransferred,
+		previousOwner []common.Address,
+		newOwner []common.Address,
+	) (event.Subscription, error)
+}
+
+// TestMultiTokenContract contains all the data needed to
+// deploy and interact with the TestMultiToken contract
+type TestMultiTokenContract struct {
+	cc.Contract
+	ConstructorArgs contractConstructorArgs
+	multiTokenQueriableContractData
+	Address    common.Address
+	LastTx     *types.Transaction
+	Instance   IInstance
+	StrToPrint string
+	// ArgParser validates the address arguments, nil uses strict defaults
+	ArgParser *utils.ArgParser
+}
+
+// contractConstructorArgs are the details needed to deploy
+// the TestMultiToken. These details are passed onto the
+// contract's constructor
+type contractConstructorArgs struct {
+	uri string
+}
+
+// multiTokenQueriableContractData is a struct that holds all the data
+// that can be queried from the contract, balances are stored per account
+// and then per token id
+type multiTokenQueriableContractData struct {
+	URI              map[string]string
+	BalanceOf        map[common.Address]map[string]*big.Int
+	IsApprovedForAll map[common.Address]map[common.Address]bool
+	Owner            common.Address
+}
+
+// ParseConstructorArguments parses the URI shared by every token type,
+// clients substitute `{id}` in it with the id of the token
+func (t *TestMultiTokenContract) ParseConstructorArguments(
+	contractArgs []string) error {
+	neededArgs := reflect.TypeOf(contractConstructorArgs{}).NumField()
+	recArgs := len(contractArgs)
+	if recArgs != neededArgs {
+		return fmt.Errorf("error: incorrect amount of arguments, args "+
+			"needed : %d != args received %d", neededArgs, recArgs)
+	}
+	t.ConstructorArgs = contractConstructorArgs{
+		uri: contractArgs[0],
+	}
+	return nil
+}
+
+// DeployContract deploys the test multi token contract and saves
+// its instance, tx of deployment and contract address
+func (t *TestMultiTokenContract) DeployContract(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+) error {
+	address, tx, instance, err := DeployTestMultiToken(
+		auth,
+		client,
+		t.ConstructorArgs.uri)
+	if err != nil {
+		return err
+	}
+	t.Address = address
+	t.LastTx = tx
+	t.Instance = instance
+	return nil
+}
+
+// LoadContract loads the test multi token contract and saves
+// its instance, contract address
+func (t *TestMultiTokenContract) LoadContract(
+	address *common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	instance, err := NewTestMultiToken(*address, client)
+	if err != nil {
+		return err
+	}
+	t.Instance = instance
+	t.Address = *address
+	// Instantiate empty maps
+	t.URI = map[string]string{}
+	t.BalanceOf = map[common.Address]map[string]*big.Int{}
+	t.IsApprovedForAll = map[common.Address]map[common.Address]bool{}
+	return nil
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (t *TestMultiTokenContract) SetArgParser(parser *utils.ArgParser) {
+	t.ArgParser = parser
+}
+
+// PrintDeploymentData outputs to the terminal the address and
+// transaction of the deployed contract.
+func (t *TestMultiTokenContract) PrintDeploymentData() {
+	fmt.Printf("Test Multi Token Contract successfully deployed at %s, "+
+		"see transaction here %s\n", t.Address.Hex(), t.LastTx.Hash().Hex())
+}
+
+// PrintLoadedContractData outs the success message of loading the
+// contract as well as the address it's loaded at.
+func (t *TestMultiTokenContract) PrintLoadedContractData() {
+	fmt.Printf("Test Multi Token Contract successfully loaded at %s\n",
+		t.Address.Hex())
+}
+
+// PrintContractDataAfterExecution print out whatever was saved in StrToPrint
+func (t *TestMultiTokenContract) PrintContractDataAfterExecution() {
+	fmt.Printf("%s", t.StrToPrint)
+}
+
+// parseBool converts the approved argument of setapprovalforall
+func parseBool(arg string) (bool, error) {
+	value, err := strconv.ParseBool(arg)
+	if err != nil {
+		return false, fmt.Errorf("error: %q is not a valid boolean", arg)
+	}
+	return value, nil
+}
+
+// parseData decodes the hex encoded data forwarded by the transfers and
+// mints to the receiver hooks of the recipient, it is empty when omitted
+func parseData(funcArgs []string, index int) ([]byte, error) {
+	if len(funcArgs) <= index {
+		return []byte{}, nil
+	}
+	arg := funcArgs[index]
+	if arg == "" || arg == "0x" {
+		return []byte{}, nil
+	}
+	data, err := hexutil.Decode(arg)
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not valid hex data", arg)
+	}
+	return data, nil
+}
+
+// validateOptionalData checks the amount of arguments of a function whose
+// last argument, the data forwarded to the recipient, can be omitted
+func validateOptionalData(funcArgs []string, required int) error {
+	if len(funcArgs) == required+1 {
+		return nil
+	}
+	return utils.ValidateLength(&funcArgs, required)
+}
+
+// parseIDs converts a comma separated list of token ids
+func parseIDs(arg string) ([]*big.Int, error) {
+	elements, err := utils.SplitList(arg)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]*big.Int, 0, len(elements))
+	for _, element := range elements {
+		id, err1 := utils.ParseTokenID(element)
+		if err1 != nil {
+			return nil, err1
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// parseAmounts converts a comma separated list of token amounts, the
+// tokens have no decimals so amounts are whole units
+func parseAmounts(arg string) ([]*big.Int, error) {
+	elements, err := utils.SplitList(arg)
+	if err != nil {
+		return nil, err
+	}
+	amounts := make([]*big.Int, 0, len(elements))
+	for _, element := range elements {
+		amount, err1 := utils.ParseTokenAmount(element, 0, "")
+		if err1 != nil {
+			return nil, err1
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts, nil
+}
+
+// parseAccounts converts a comma separated list of addresses
+func (t *TestMultiTokenContract) parseAccounts(arg string) (
+	[]common.Address, error) {
+	elements, err := utils.SplitList(arg)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]common.Address, 0, len(elements))
+	for _, element := range elements {
+		account, err1 := t.ArgParser.ParseAddress(element)
+		if err1 != nil {
+			return nil, err1
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// parseBatch converts the ids and amounts lists of the batch functions,
+// every id needs an amount
+func parseBatch(idsArg string, amountsArg string) (
+	[]*big.Int, []*big.Int, error) {
+	ids, err := parseIDs(idsArg)
+	if err != nil {
+		return nil, nil, err
+	}
+	amounts, err1 := parseAmounts(amountsArg)
+	if err1 != nil {
+		return nil, nil, err1
+	}
+	if len(ids) != len(amounts) {
+		return nil, nil, fmt.Errorf("error: %d ids does not match %d amounts",
+			len(ids), len(amounts))
+	}
+	return ids, amounts, nil
+}
+
+// formatList formats a list of integers as a comma separated string
+func formatList(values []*big.Int) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = value.String()
+	}
+	return strings.Join(formatted, ",")
+}
+
+// substituteID replaces `{id}` in a token URI with the lowercase hex id
+// padded to 64 characters, as clients are required to by ERC1155
+func substituteID(uri string, id *big.Int) string {
+	return strings.ReplaceAll(uri, "{id}", fmt.Sprintf("%064x", id))
+}
+
+// WriteContract executes write transaction which invokes a state
+// change in the TestMultiToken contract, this execution is based on
+// function name and arguments. Function arguments are verified for
+// length and then converted to fit the appropriate types. Ids and
+// amounts of the batch functions are comma separated lists.
+func (t *TestMultiTokenContract) WriteContract(
+	auth *bind.TransactOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "mint":
+		err := validateOptionalData(funcArgs, 3)
+		if err != nil {
+			return err
+		}
+		recipient, err1 := t.ArgParser.ParseRecipient(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		id, err2 := utils.ParseTokenID(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		amount, err3 := utils.ParseTokenAmount(funcArgs[2], 0, "")
+		if err3 != nil {
+			return err3
+		}
+		data, err4 := parseData(funcArgs, 3)
+		if err4 != nil {
+			return err4
+		}
+		tx, err5 := t.Instance.Mint(auth, recipient, id, amount, data)
+		if err5 != nil {
+			return err5
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Minted %d of token %d at "+
+			"TestMultiToken (%s) to address %s\n", amount, id, t.Address,
+			recipient)
+	case "mintbatch":
+		err := validateOptionalData(funcArgs, 3)
+		if err != nil {
+			return err
+		}
+		recipient, err1 := t.ArgParser.ParseRecipient(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		ids, amounts, err2 := parseBatch(funcArgs[1], funcArgs[2])
+		if err2 != nil {
+			return err2
+		}
+		data, err3 := parseData(funcArgs, 3)
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := t.Instance.MintBatch(auth, recipient, ids, amounts, data)
+		if err4 != nil {
+			return err4
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Minted %s of tokens %s at "+
+			"TestMultiToken (%s) to address %s\n", formatList(amounts),
+			formatList(ids), t.Address, recipient)
+	case "setapprovalforall":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		operator, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		approved, err2 := parseBool(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := t.Instance.SetApprovalForAll(auth, operator, approved)
+		if err3 != nil {
+			return err3
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Set approval for all tokens of "+
+			"operator %s to %t at TestMultiToken (%s)\n", operator, approved,
+			t.Address)
+	case "safetransferfrom":
+		err := validateOptionalData(funcArgs, 4)
+		if err != nil {
+			return err
+		}
+		from, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		recipient, err2 := t.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		id, err3 := utils.ParseTokenID(funcArgs[2])
+		if err3 != nil {
+			return err3
+		}
+		amount, err4 := utils.ParseTokenAmount(funcArgs[3], 0, "")
+		if err4 != nil {
+			return err4
+		}
+		data, err5 := parseData(funcArgs, 4)
+		if err5 != nil {
+			return err5
+		}
+		tx, err6 := t.Instance.SafeTransferFrom(auth, from, recipient, id,
+			amount, data)
+		if err6 != nil {
+			return err6
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Safely Transferred From %s %d of "+
+			"token %d at TestMultiToken (%s) to address %s\n", from, amount, id,
+			t.Address, recipient)
+	case "safebatchtransferfrom":
+		err := validateOptionalData(funcArgs, 4)
+		if err != nil {
+			return err
+		}
+		from, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		recipient, err2 := t.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		ids, amounts, err3 := parseBatch(funcArgs[2], funcArgs[3])
+		if err3 != nil {
+			return err3
+		}
+		data, err4 := parseData(funcArgs, 4)
+		if err4 != nil {
+			return err4
+		}
+		tx, err5 := t.Instance.SafeBatchTransferFrom(auth, from, recipient,
+			ids, amounts, data)
+		if err5 != nil {
+			return err5
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Safely Transferred From %s %s of "+
+			"tokens %s at TestMultiToken (%s) to address %s\n", from,
+			formatList(amounts), formatList(ids), t.Address, recipient)
+	default:
+		return nil
+	}
+	return nil
+}
+
+// setBalance stores the balance of a token id held by an account
+func (t *TestMultiTokenContract) setBalance(
+	account common.Address,
+	id *big.Int,
+	balance *big.Int,
+) {
+	// Since this is a nested map of data we must check if `account`
+	// has a map instantiated towards them, if not create one
+	if _, ok := t.BalanceOf[account]; !ok {
+		t.BalanceOf[account] = map[string]*big.Int{}
+	}
+	t.BalanceOf[account][id.String()] = balance
+}
+
+// QueryContract executes query functions which do not invoke a state
+// change in the TestMultiToken contract, this execution is based on
+// function name and arguments. Function arguments are verified for
+// length and then converted to fit the appropriate types.
+// The call options select the block and caller the view calls are
+// executed with. Data retrieved is then stored in
+// multiTokenQueriableContractData
+func (t *TestMultiTokenContract) QueryContract(
+	opts *bind.CallOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "owner":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		owner, err1 := t.Instance.Owner(opts)
+		if err1 != nil {
+			return err1
+		}
+		t.Owner = owner
+		t.StrToPrint = fmt.Sprintf("info: Contract Owner: %s for "+
+			"TestMultiToken (%s)\n", owner, t.Address)
+	case "uri":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		id, err1 := utils.ParseTokenID(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		uri, err2 := t.Instance.Uri(opts, id)
+		if err2 != nil {
+			return err2
+		}
+		t.URI[id.String()] = substituteID(uri, id)
+		t.StrToPrint = fmt.Sprintf("info: Token URI of token %d : %q for "+
+			"TestMultiToken (%s)\n", id, t.URI[id.String()], t.Address)
+	case "balanceof":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		account, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		id, err2 := utils.ParseTokenID(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		balance, err3 := t.Instance.BalanceOf(opts, account, id)
+		if err3 != nil {
+			return err3
+		}
+		t.setBalance(account, id, balance)
+		t.StrToPrint = fmt.Sprintf("info: Token Balance of %s for token %d "+
+			": %d for TestMultiToken (%s)\n", account, id, balance, t.Address)
+	case "balanceofbatch":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		accounts, err1 := t.parseAccounts(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		ids, err2 := parseIDs(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		// A single account is queried for each of the ids
+		if len(accounts) == 1 {
+			for len(accounts) < len(ids) {
+				accounts = append(accounts, accounts[0])
+			}
+		}
+		if len(accounts) != len(ids) {
+			return fmt.Errorf("error: %d accounts does not match %d ids",
+				len(accounts), len(ids))
+		}
+		balances, err3 := t.Instance.BalanceOfBatch(opts, accounts, ids)
+		if err3 != nil {
+			return err3
+		}
+		t.StrToPrint = ""
+		for i, balance := range balances {
+			t.setBalance(accounts[i], ids[i], balance)
+			t.StrToPrint += fmt.Sprintf("info: Token Balance of %s for token "+
+				"%d : %d for TestMultiToken (%s)\n", accounts[i], ids[i],
+				balance, t.Address)
+		}
+	case "isapprovedforall":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		account, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		operator, err2 := t.ArgParser.ParseAddress(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		approved, err3 := t.Instance.IsApprovedForAll(opts, account, operator)
+		if err3 != nil {
+			return err3
+		}
+		// Since this is a nested map of data we must check if `account`
+		// has a map instantiated towards them, if not create one
+		if _, ok := t.IsApprovedForAll[account]; !ok {
+			t.IsApprovedForAll[account] = map[common.Address]bool{}
+		}
+		t.IsApprovedForAll[account][operator] = approved
+		t.StrToPrint = fmt.Sprintf("info: Operator %s approved for all tokens "+
+			"of account %s : %t for TestMultiToken (%s)\n", operator, account,
+			approved, t.Address)
+	default:
+		return nil
+	}
+	return nil
+}
+
+// QueryContractMulticall executes several query functions of the
+// TestMultiToken contract atomically through the multicaller. The
+// function arguments are the token ids used by uri, one id each, the
+// balances are already batched by balanceofbatch.
+func (t *TestMultiTokenContract) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller cc.Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	parsed, err := TestMultiTokenMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	var calls []cc.ViewCall
+	withArgs := false
+	for _, funcName := range funcNames {
+		switch funcName {
+		case "owner":
+			calls = append(calls, cc.ViewCall{Target: t.Address, ABI: parsed,
+				Method: "owner"})
+		case "uri":
+			if len(funcArgs) == 0 {
+				return fmt.Errorf("error: %s can't be aggregated without "+
+					"arguments", funcName)
+			}
+			withArgs = true
+			for _, arg := range funcArgs {
+				id, err1 := utils.ParseTokenID(arg)
+				if err1 != nil {
+					return err1
+				}
+				calls = append(calls, cc.ViewCall{Target: t.Address,
+					ABI: parsed, Method: "uri", Args: []interface{}{id}})
+			}
+		default:
+			return fmt.Errorf("error: %s can't be aggregated", funcName)
+		}
+	}
+	if !withArgs {
+		err2 := utils.ValidateLength(&funcArgs, 0)
+		if err2 != nil {
+			return err2
+		}
+	}
+
+	blockNumber, outputs, err3 := multicaller.Aggregate(opts, calls)
+	if err3 != nil {
+		return err3
+	}
+	t.StrToPrint = fmt.Sprintf("info: Aggregated %d calls at block %d for "+
+		"TestMultiToken (%s)\n", len(calls), blockNumber, t.Address)
+	for i, call := range calls {
+		value := outputs[i][0]
+		switch call.Method {
+		case "owner":
+			t.Owner = value.(common.Address)
+			t.StrToPrint += fmt.Sprintf("info: Contract Owner: %s\n", t.Owner)
+		case "uri":
+			id := call.Args[0].(*big.Int)
+			t.URI[id.String()] = substituteID(value.(string), id)
+			t.StrToPrint += fmt.Sprintf("info: Token URI of token %d : %q\n",
+				id, t.URI[id.String()])
+		}
+	}
+	return nil
+}
+
+// QueryEvents retrieves the TransferSingle, TransferBatch, ApprovalForAll
+// and OwnershipTransferred events of the TestMultiToken contract within
+// the block range of the filter options. The transfers are filtered on
+// their from and to addresses, their operator is never filtered. The
+// decoded events are returned in the order they were emitted.
+func (t *TestMultiTokenContract) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	var records []cc.EventRecord
+	switch eventName {
+	case "transfersingle":
+		iterator, err := t.Instance.FilterTransferSingle(opts, nil,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, transferSingleRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "transferbatch":
+		iterator, err := t.Instance.FilterTransferBatch(opts, nil,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, transferBatchRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "approvalforall":
+		iterator, err := t.Instance.FilterApprovalForAll(opts, filter.From,
+			filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, approvalForAllRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "ownershiptransferred":
+		iterator, err := t.Instance.FilterOwnershipTransferred(opts,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records,
+				ownershipTransferredRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	default:
+		return nil, nil
+	}
+	return records, nil
+}
+
+// WatchEvents subscribes to the TransferSingle, TransferBatch,
+// ApprovalForAll or OwnershipTransferred events of the TestMultiToken
+// contract and forwards them decoded to the sink. The returned
+// subscription fails as soon as the underlying log subscription does, so
+// that the caller can resubscribe.
+func (t *TestMultiTokenContract) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	switch eventName {
+	case "transfersingle":
+		events := make(chan *TestMultiTokenTransferSingle)
+		sub, err := t.Instance.WatchTransferSingle(opts, events, nil,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- transferSingleRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "transferbatch":
+		events := make(chan *TestMultiTokenTransferBatch)
+		sub, err := t.Instance.WatchTransferBatch(opts, events, nil,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- transferBatchRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "approvalforall":
+		events := make(chan *TestMultiTokenApprovalForAll)
+		sub, err := t.Instance.WatchApprovalForAll(opts, events, filter.From,
+			filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- approvalForAllRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "ownershiptransferred":
+		events := make(chan *TestMultiTokenOwnershipTransferred)
+		sub, err := t.Instance.WatchOwnershipTransferred(opts, events,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- ownershipTransferredRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	default:
+		return nil, fmt.Errorf("error: unsupported event %s", eventName)
+	}
+}
+
+// transferSingleRecord converts a decoded TransferSingle event into an
+// EventRecord
+func transferSingleRecord(ev *TestMultiTokenTransferSingle) cc.EventRecord {
+	return cc.NewEventRecord("TransferSingle", ev.Raw,
+		cc.EventArg{Name: "operator", Value: ev.Operator.Hex()},
+		cc.EventArg{Name: "from", Value: ev.From.Hex()},
+		cc.EventArg{Name: "to", Value: ev.To.Hex()},
+		cc.EventArg{Name: "id", Value: ev.Id.String()},
+		cc.EventArg{Name: "value", Value: ev.Value.String()})
+}
+
+// transferBatchRecord converts a decoded TransferBatch event into an
+// EventRecord, the ids and values are comma separated
+func transferBatchRecord(ev *TestMultiTokenTransferBatch) cc.EventRecord {
+	return cc.NewEventRecord("TransferBatch", ev.Raw,
+		cc.EventArg{Name: "operator", Value: ev.Operator.Hex()},
+		cc.EventArg{Name: "from", Value: ev.From.Hex()},
+		cc.EventArg{Name: "to", Value: ev.To.Hex()},
+		cc.EventArg{Name: "ids", Value: formatList(ev.Ids)},
+		cc.EventArg{Name: "values", Value: formatList(ev.Values)})
+}
+
+// approvalForAllRecord converts a decoded ApprovalForAll event into an
+// EventRecord
+func approvalForAllRecord(ev *TestMultiTokenApprovalForAll) cc.EventRecord {
+	return cc.NewEventRecord("ApprovalForAll", ev.Raw,
+		cc.EventArg{Name: "account", Value: ev.Account.Hex()},
+		cc.EventArg{Name: "operator", Value: ev.Operator.Hex()},
+		cc.EventArg{Name: "approved", Value: strconv.FormatBool(ev.Approved)})
+}
+
+// ownershipTransferredRecord converts a decoded OwnershipTransferred
+// event into an EventRecord
+func ownershipTransferredRecord(
+	ev *TestMultiTokenOwnershipTransferred) cc.EventRecord {
+	return cc.NewEventRecord("OwnershipTransferred", ev.Raw,
+		cc.EventArg{Name: "previousOwner", Value: ev.PreviousOwner.Hex()},
+		cc.EventArg{Name: "newOwner", Value: ev.NewOwner.Hex()})
+}
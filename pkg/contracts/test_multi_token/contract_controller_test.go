@@ -0,0 +1,747 @@
+package test_multi_token
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	cc "go-evm-client/internal/contracts_template_interface"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+type MockContractInstance struct {
+	mock.Mock
+}
+
+func (m *MockContractInstance) Mint(
+	opts *bind.TransactOpts,
+	to common.Address,
+	id *big.Int,
+	amount *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, to, id, amount, data)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) MintBatch(
+	opts *bind.TransactOpts,
+	to common.Address,
+	ids []*big.Int,
+	amounts []*big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, to, ids, amounts, data)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SetApprovalForAll(
+	opts *bind.TransactOpts,
+	operator common.Address,
+	approved bool,
+) (*types.Transaction, error) {
+	args := m.Called(opts, operator, approved)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SafeTransferFrom(
+	opts *bind.TransactOpts,
+	from common.Address,
+	to common.Address,
+	id *big.Int,
+	amount *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, from, to, id, amount, data)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SafeBatchTransferFrom(
+	opts *bind.TransactOpts,
+	from common.Address,
+	to common.Address,
+	ids []*big.Int,
+	amounts []*big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, from, to, ids, amounts, data)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) Uri(
+	_ *bind.CallOpts,
+	id *big.Int,
+) (string, error) {
+	args := m.Called(nil, id)
+	return (args.Get(0)).(string), args.Error(1)
+}
+
+func (m *MockContractInstance) BalanceOf(
+	_ *bind.CallOpts,
+	account common.Address,
+	id *big.Int,
+) (*big.Int, error) {
+	args := m.Called(nil, account, id)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) BalanceOfBatch(
+	_ *bind.CallOpts,
+	accounts []common.Address,
+	ids []*big.Int,
+) ([]*big.Int, error) {
+	args := m.Called(nil, accounts, ids)
+	return (args.Get(0)).([]*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) IsApprovedForAll(
+	_ *bind.CallOpts,
+	account common.Address,
+	operator common.Address,
+) (bool, error) {
+	args := m.Called(nil, account, operator)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockContractInstance) Owner(_ *bind.CallOpts) (common.Address, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterTransferSingle(
+	opts *bind.FilterOpts,
+	operator []common.Address,
+	from []common.Address,
+	to []common.Address,
+) (*TestMultiTokenTransferSingleIterator, error) {
+	args := m.Called(opts, operator, from, to)
+	return (args.Get(0)).(*TestMultiTokenTransferSingleIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterTransferBatch(
+	opts *bind.FilterOpts,
+	operator []common.Address,
+	from []common.Address,
+	to []common.Address,
+) (*TestMultiTokenTransferBatchIterator, error) {
+	args := m.Called(opts, operator, from, to)
+	return (args.Get(0)).(*TestMultiTokenTransferBatchIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterApprovalForAll(
+	opts *bind.FilterOpts,
+	account []common.Address,
+	operator []common.Address,
+) (*TestMultiTokenApprovalForAllIterator, error) {
+	args := m.Called(opts, account, operator)
+	return (args.Get(0)).(*TestMultiTokenApprovalForAllIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterOwnershipTransferred(
+	opts *bind.FilterOpts,
+	previousOwner []common.Address,
+	newOwner []common.Address,
+) (*TestMultiTokenOwnershipTransferredIterator, error) {
+	args := m.Called(opts, previousOwner, newOwner)
+	return (args.Get(0)).(*TestMultiTokenOwnershipTransferredIterator),
+		args.Error(1)
+}
+
+func (m *MockContractInstance) WatchTransferSingle(
+	opts *bind.WatchOpts,
+	sink chan<- *TestMultiTokenTransferSingle,
+	operator []common.Address,
+	from []common.Address,
+	to []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, operator, from, to)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchTransferBatch(
+	opts *bind.WatchOpts,
+	sink chan<- *TestMultiTokenTransferBatch,
+	operator []common.Address,
+	from []common.Address,
+	to []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, operator, from, to)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchApprovalForAll(
+	opts *bind.WatchOpts,
+	sink chan<- *TestMultiTokenApprovalForAll,
+	account []common.Address,
+	operator []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, account, operator)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchOwnershipTransferred(
+	opts *bind.WatchOpts,
+	sink chan<- *TestMultiTokenOwnershipTransferred,
+	previousOwner []common.Address,
+	newOwner []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, previousOwner, newOwner)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+// newTransferBatchIterator creates an iterator which returns the given
+// TransferBatch logs as if they were retrieved from the node
+func newTransferBatchIterator(
+	t *testing.T,
+	logs ...types.Log,
+) *TestMultiTokenTransferBatchIterator {
+	parsed, err := abi.JSON(strings.NewReader(TestMultiTokenABI))
+	assert.NoError(t, err)
+	logsChan := make(chan types.Log, len(logs))
+	for _, log := range logs {
+		logsChan <- log
+	}
+	return &TestMultiTokenTransferBatchIterator{
+		contract: bind.NewBoundContract(common.Address{}, parsed, nil, nil, nil),
+		event:    "TransferBatch",
+		logs:     logsChan,
+		sub: event.NewSubscription(func(quit <-chan struct{}) error {
+			<-quit
+			return nil
+		}),
+		done: true,
+	}
+}
+
+const (
+	holderAddress   = "0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"
+	operatorAddress = "0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"
+)
+
+// bigInts converts integers to a list of big integers
+func bigInts(values ...int64) []*big.Int {
+	ints := make([]*big.Int, len(values))
+	for i, value := range values {
+		ints[i] = big.NewInt(value)
+	}
+	return ints
+}
+
+func TestParseConstructorArguments(t *testing.T) {
+	tests := []struct {
+		testName      string
+		contractArgs  []string
+		expectedArgs  contractConstructorArgs
+		expectedError error
+	}{
+		{
+			testName:     "ParseConstructorArguments successful.",
+			contractArgs: []string{"https://example.com/{id}.json"},
+			expectedArgs: contractConstructorArgs{
+				uri: "https://example.com/{id}.json",
+			},
+		},
+		{
+			testName:     "ParseConstructorArguments empty URI.",
+			contractArgs: []string{""},
+			expectedArgs: contractConstructorArgs{},
+		},
+		{
+			testName:     "ParseConstructorArguments fail missing URI.",
+			contractArgs: []string{},
+			expectedError: errors.New("error: incorrect amount of arguments, " +
+				"args needed : 1 != args received 0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			multiToken := TestMultiTokenContract{}
+			err := multiToken.ParseConstructorArguments(tt.contractArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, multiToken.ConstructorArgs, tt.expectedArgs)
+		})
+	}
+}
+
+func TestWriteContractMintBatchMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		ids           []*big.Int
+		amounts       []*big.Int
+		data          []byte
+		strToPrint    string
+		instanceError error
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName: "WriteContract func MintBatch successful all data " +
+				"returned.",
+			funcArgs: []string{holderAddress, "1,2,3", "10,20,30"},
+			ids:      bigInts(1, 2, 3),
+			amounts:  bigInts(10, 20, 30),
+			data:     []byte{},
+			strToPrint: "info: Minted 10,20,30 of tokens 1,2,3 at " +
+				"TestMultiToken (0x0000000000000000000000000000000000000000) " +
+				"to address " + holderAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func MintBatch spaces, hex ids and " +
+				"data.",
+			funcArgs: []string{holderAddress, "0x1, 0x2", "1e3, 5", "0xc0ffee"},
+			ids:      bigInts(1, 2),
+			amounts:  bigInts(1000, 5),
+			data:     []byte{0xc0, 0xff, 0xee},
+			strToPrint: "info: Minted 1000,5 of tokens 1,2 at " +
+				"TestMultiToken (0x0000000000000000000000000000000000000000) " +
+				"to address " + holderAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func MintBatch fail lengths mismatch.",
+			funcArgs: []string{holderAddress, "1,2,3", "10,20"},
+			expectedError: errors.New("error: 3 ids does not match 2 " +
+				"amounts"),
+		},
+		{
+			testName: "WriteContract func MintBatch fail empty element.",
+			funcArgs: []string{holderAddress, "1,,3", "10,20,30"},
+			expectedError: errors.New("error: \"1,,3\" is not a valid comma " +
+				"separated list"),
+		},
+		{
+			testName: "WriteContract func MintBatch fail invalid id.",
+			funcArgs: []string{holderAddress, "1,two", "10,20"},
+			expectedError: errors.New("error: \"two\" is not a valid token " +
+				"id"),
+		},
+		{
+			testName: "WriteContract func MintBatch fail fractional amount.",
+			funcArgs: []string{holderAddress, "1,2", "10,0.5"},
+			expectedError: errors.New("error: amount \"0.5\" has more " +
+				"precision than the token's 0 decimals"),
+		},
+		{
+			testName: "WriteContract func MintBatch fail arg len validation.",
+			funcArgs: []string{holderAddress, "1,2"},
+			expectedError: errors.New("error: 2 arguments does not match " +
+				"required 3"),
+		},
+		{
+			testName:      "WriteContract func MintBatch instance failure.",
+			funcArgs:      []string{holderAddress, "1,2,3", "10,20,30"},
+			ids:           bigInts(1, 2, 3),
+			amounts:       bigInts(10, 20, 30),
+			data:          []byte{},
+			instanceError: errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			mInstance := new(MockContractInstance)
+			mInstance.On("MintBatch", auth, common.HexToAddress(holderAddress),
+				tt.ids, tt.amounts, tt.data).Return(tt.expectedTx,
+				tt.instanceError)
+			multiToken := TestMultiTokenContract{}
+			multiToken.Instance = mInstance
+			err := multiToken.WriteContract(auth, "mintbatch", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, multiToken.LastTx, tt.expectedTx)
+			assert.Equal(t, multiToken.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestWriteContractSafeTransferFromMethod(t *testing.T) {
+	from := common.HexToAddress(holderAddress)
+	to := common.HexToAddress(operatorAddress)
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		data          []byte
+		strToPrint    string
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName: "WriteContract func SafeTransferFrom without data.",
+			funcArgs: []string{holderAddress, operatorAddress, "7", "25"},
+			data:     []byte{},
+			strToPrint: "info: Safely Transferred From " + holderAddress +
+				" 25 of token 7 at TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				operatorAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom with data.",
+			funcArgs: []string{holderAddress, operatorAddress, "7", "25",
+				"0xc0ffee"},
+			data: []byte{0xc0, 0xff, 0xee},
+			strToPrint: "info: Safely Transferred From " + holderAddress +
+				" 25 of token 7 at TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				operatorAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom fail zero " +
+				"address.",
+			funcArgs: []string{holderAddress,
+				"0x0000000000000000000000000000000000000000", "7", "25"},
+			expectedError: errors.New("error: refusing to use the zero " +
+				"address as recipient, use --force to override"),
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom fail arg len " +
+				"validation.",
+			funcArgs: []string{holderAddress, operatorAddress, "7"},
+			expectedError: errors.New("error: 3 arguments does not match " +
+				"required 4"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			mInstance := new(MockContractInstance)
+			mInstance.On("SafeTransferFrom", auth, from, to, big.NewInt(7),
+				big.NewInt(25), tt.data).Return(tt.expectedTx, nil)
+			multiToken := TestMultiTokenContract{}
+			multiToken.Instance = mInstance
+			err := multiToken.WriteContract(auth, "safetransferfrom",
+				tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				mInstance.AssertNumberOfCalls(t, "SafeTransferFrom", 1)
+			}
+			assert.Equal(t, multiToken.LastTx, tt.expectedTx)
+			assert.Equal(t, multiToken.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestQueryContractBalanceOfBatchMethod(t *testing.T) {
+	holder := common.HexToAddress(holderAddress)
+	operator := common.HexToAddress(operatorAddress)
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		accounts      []common.Address
+		ids           []*big.Int
+		balances      []*big.Int
+		strToPrint    string
+		expectedError error
+	}{
+		{
+			testName: "QueryContract func BalanceOfBatch successful.",
+			funcArgs: []string{holderAddress + "," + operatorAddress, "1,2"},
+			accounts: []common.Address{holder, operator},
+			ids:      bigInts(1, 2),
+			balances: bigInts(10, 0),
+			strToPrint: "info: Token Balance of " + holderAddress +
+				" for token 1 : 10 for TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Balance of " + operatorAddress +
+				" for token 2 : 0 for TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName: "QueryContract func BalanceOfBatch single account.",
+			funcArgs: []string{holderAddress, "1,2"},
+			accounts: []common.Address{holder, holder},
+			ids:      bigInts(1, 2),
+			balances: bigInts(10, 20),
+			strToPrint: "info: Token Balance of " + holderAddress +
+				" for token 1 : 10 for TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Balance of " + holderAddress +
+				" for token 2 : 20 for TestMultiToken " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName: "QueryContract func BalanceOfBatch fail lengths " +
+				"mismatch.",
+			funcArgs: []string{holderAddress + "," + operatorAddress,
+				"1,2,3"},
+			expectedError: errors.New("error: 2 accounts does not match 3 " +
+				"ids"),
+		},
+		{
+			testName: "QueryContract func BalanceOfBatch fail invalid " +
+				"account.",
+			funcArgs: []string{holderAddress + ",0x1234", "1,2"},
+			expectedError: errors.New("error: \"0x1234\" is not a valid hex " +
+				"address"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("BalanceOfBatch", nil, tt.accounts, tt.ids).Return(
+				tt.balances, nil)
+			multiToken := TestMultiTokenContract{}
+			multiToken.Instance = mInstance
+			multiToken.BalanceOf = map[common.Address]map[string]*big.Int{}
+			err := multiToken.QueryContract(nil, "balanceofbatch", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				for i, account := range tt.accounts {
+					assert.Equal(t, multiToken.BalanceOf[account][tt.ids[i].String()],
+						tt.balances[i])
+				}
+			}
+			assert.Equal(t, multiToken.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestQueryContractURIMethod(t *testing.T) {
+	tests := []struct {
+		testName    string
+		uri         string
+		expectedURI string
+	}{
+		{
+			testName: "QueryContract func URI substitutes the id.",
+			uri:      "https://example.com/{id}.json",
+			expectedURI: "https://example.com/" + strings.Repeat("0", 62) +
+				"ff.json",
+		},
+		{
+			testName:    "QueryContract func URI without id.",
+			uri:         "https://example.com/token.json",
+			expectedURI: "https://example.com/token.json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("Uri", nil, big.NewInt(255)).Return(tt.uri, nil)
+			multiToken := TestMultiTokenContract{}
+			multiToken.Instance = mInstance
+			multiToken.URI = map[string]string{}
+			err := multiToken.QueryContract(nil, "uri", []string{"255"})
+			assert.NoError(t, err)
+			assert.Equal(t, multiToken.URI["255"], tt.expectedURI)
+			assert.Equal(t, multiToken.StrToPrint, "info: Token URI of token "+
+				"255 : \""+tt.expectedURI+"\" for TestMultiToken "+
+				"(0x0000000000000000000000000000000000000000)\n")
+		})
+	}
+}
+
+type MockMulticaller struct {
+	mock.Mock
+}
+
+func (m *MockMulticaller) Aggregate(
+	opts *bind.CallOpts,
+	calls []cc.ViewCall,
+) (*big.Int, [][]interface{}, error) {
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	args := m.Called(opts, methods)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*big.Int), args.Get(1).([][]interface{}), args.Error(2)
+}
+
+func TestQueryContractMulticallMethod(t *testing.T) {
+	tests := []struct {
+		testName        string
+		funcNames       []string
+		funcArgs        []string
+		expectedMethods []string
+		outputs         [][]interface{}
+		strToPrint      string
+		expectedError   error
+	}{
+		{
+			testName:        "QueryContractMulticall owner and URIs successful.",
+			funcNames:       []string{"owner", "uri"},
+			funcArgs:        []string{"1", "2"},
+			expectedMethods: []string{"owner", "uri", "uri"},
+			outputs: [][]interface{}{{common.HexToAddress(holderAddress)},
+				{"ipfs://uri"}, {"ipfs://uri"}},
+			strToPrint: "info: Aggregated 3 calls at block 10 for " +
+				"TestMultiToken (0x0000000000000000000000000000000000000000)\n" +
+				"info: Contract Owner: " + holderAddress + "\n" +
+				"info: Token URI of token 1 : \"ipfs://uri\"\n" +
+				"info: Token URI of token 2 : \"ipfs://uri\"\n",
+		},
+		{
+			testName:      "QueryContractMulticall fail URI without ids.",
+			funcNames:     []string{"uri"},
+			expectedError: errors.New("error: uri can't be aggregated without arguments"),
+		},
+		{
+			testName:      "QueryContractMulticall fail not aggregatable.",
+			funcNames:     []string{"balanceof"},
+			funcArgs:      []string{holderAddress},
+			expectedError: errors.New("error: balanceof can't be aggregated"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			multicaller := new(MockMulticaller)
+			multicaller.On("Aggregate", (*bind.CallOpts)(nil),
+				tt.expectedMethods).Return(big.NewInt(10), tt.outputs, nil)
+			multiToken := TestMultiTokenContract{}
+			multiToken.URI = map[string]string{}
+			err := multiToken.QueryContractMulticall(nil, multicaller,
+				tt.funcNames, tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, multiToken.StrToPrint, tt.strToPrint)
+			multicaller.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQueryEventsTransferBatchMethod(t *testing.T) {
+	from := common.HexToAddress(holderAddress)
+	to := common.HexToAddress(operatorAddress)
+	parsed, err := abi.JSON(strings.NewReader(TestMultiTokenABI))
+	assert.NoError(t, err)
+	data, err1 := parsed.Events["TransferBatch"].Inputs.NonIndexed().Pack(
+		bigInts(1, 2), bigInts(10, 20))
+	assert.NoError(t, err1)
+	transferBatchLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("TransferBatch(address,address," +
+				"address,uint256[],uint256[])")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data:        data,
+		BlockNumber: 120,
+		TxHash:      common.HexToHash("0x01"),
+		Index:       3,
+	}
+	end := uint64(200)
+	tests := []struct {
+		testName        string
+		logs            []types.Log
+		filterError     error
+		expectedError   error
+		expectedRecords []string
+	}{
+		{
+			testName: "QueryEvents func TransferBatch successful all data " +
+				"returned.",
+			logs: []types.Log{transferBatchLog},
+			expectedRecords: []string{"TransferBatch block=120 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000001 " +
+				"logIndex=3 operator=" + holderAddress + " from=" +
+				holderAddress + " to=" + operatorAddress +
+				" ids=1,2 values=10,20"},
+		},
+		{
+			testName:        "QueryEvents func TransferBatch no events.",
+			logs:            []types.Log{},
+			expectedRecords: []string{},
+		},
+		{
+			testName:      "QueryEvents func TransferBatch instance failure.",
+			filterError:   errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			opts := &bind.FilterOpts{Start: 100, End: &end}
+			filter := cc.EventFilter{To: []common.Address{to}}
+			mInstance := new(MockContractInstance)
+			mInstance.On("FilterTransferBatch", opts,
+				([]common.Address)(nil), filter.From, filter.To).Return(
+				newTransferBatchIterator(t, tt.logs...), tt.filterError)
+			multiToken := TestMultiTokenContract{}
+			multiToken.Instance = mInstance
+			records, err2 := multiToken.QueryEvents(opts, "transferbatch",
+				filter)
+			if tt.expectedError != nil {
+				assert.Equal(t, err2.Error(), tt.expectedError.Error())
+				assert.Nil(t, records)
+				return
+			}
+			assert.NoError(t, err2)
+			printed := []string{}
+			for _, record := range records {
+				printed = append(printed, record.String())
+			}
+			assert.Equal(t, printed, tt.expectedRecords)
+		})
+	}
+}
+
+func TestDeployContractSimulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+
+	multiToken := TestMultiTokenContract{}
+	err1 := multiToken.ParseConstructorArguments([]string{
+		"https://example.com/{id}.json"})
+	assert.NoError(t, err1)
+	err2 := multiToken.DeployContract(auth, client)
+	assert.NoError(t, err2)
+	multiToken.BalanceOf = map[common.Address]map[string]*big.Int{}
+
+	// The deployer owns the contract and mints to itself
+	err3 := multiToken.WriteContract(auth, "mint", []string{auth.From.Hex(),
+		"1", "100"})
+	assert.NoError(t, err3)
+	err4 := multiToken.WriteContract(auth, "mintbatch", []string{
+		auth.From.Hex(), "2,3", "5,6"})
+	assert.NoError(t, err4)
+	err5 := multiToken.WriteContract(auth, "safetransferfrom", []string{
+		auth.From.Hex(), holderAddress, "1", "40"})
+	assert.NoError(t, err5)
+	err6 := multiToken.WriteContract(auth, "safebatchtransferfrom",
+		[]string{auth.From.Hex(), holderAddress, "2,3", "1,6"})
+	assert.NoError(t, err6)
+
+	err7 := multiToken.QueryContract(nil, "balanceofbatch", []string{
+		auth.From.Hex(), "1,2,3"})
+	assert.NoError(t, err7)
+	assert.Equal(t, multiToken.BalanceOf[auth.From]["1"], big.NewInt(60))
+	assert.Equal(t, multiToken.BalanceOf[auth.From]["2"], big.NewInt(4))
+	assert.Equal(t, multiToken.BalanceOf[auth.From]["3"].Sign(), 0)
+	err8 := multiToken.QueryContract(nil, "balanceofbatch", []string{
+		holderAddress, "1,2,3"})
+	assert.NoError(t, err8)
+	assert.Equal(t, multiToken.BalanceOf[common.HexToAddress(holderAddress)],
+		map[string]*big.Int{"1": big.NewInt(40), "2": big.NewInt(1),
+			"3": big.NewInt(6)})
+}
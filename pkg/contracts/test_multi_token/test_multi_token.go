@@ -0,0 +1,1170 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package test_multi_token
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// TestMultiTokenMetaData contains all meta data concerning the TestMultiToken contract.
+var TestMultiTokenMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"uri_\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"}],\"name\":\"balanceOfBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"mintBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"uri\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620034e8380380620034e883398181016040528101906200003791906200027f565b80600290816200004891906200051b565b5033600360006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff1602179055503373ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a35062000602565b6000604051905090565b600080fd5b600080fd5b600080fd5b600080fd5b6000601f19601f8301169050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b62000155826200010a565b810181811067ffffffffffffffff821117156200017757620001766200011b565b5b80604052505050565b60006200018c620000ec565b90506200019a82826200014a565b919050565b600067ffffffffffffffff821115620001bd57620001bc6200011b565b5b620001c8826200010a565b9050602081019050919050565b60005b83811015620001f5578082015181840152602081019050620001d8565b60008484015250505050565b60006200021862000212846200019f565b62000180565b90508281526020810184848401111562000237576200023662000105565b5b62000244848285620001d5565b509392505050565b600082601f83011262000264576200026362000100565b5b81516200027684826020860162000201565b91505092915050565b600060208284031215620002985762000297620000f6565b5b600082015167ffffffffffffffff811115620002b957620002b8620000fb565b5b620002c7848285016200024c565b91505092915050565b600081519050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b600060028204905060018216806200032357607f821691505b602082108103620003395762000338620002db565b5b50919050565b60008190508160005260206000209050919050565b60006020601f8301049050919050565b600082821b905092915050565b600060088302620003a37fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8262000364565b620003af868362000364565b95508019841693508086168417925050509392505050565b6000819050919050565b6000819050919050565b6000620003fc620003f6620003f084620003c7565b620003d1565b620003c7565b9050919050565b6000819050919050565b6200041883620003db565b62000430620004278262000403565b84845462000371565b825550505050565b600090565b6200044762000438565b620004548184846200040d565b505050565b5b818110156200047c57620004706000826200043d565b6001810190506200045a565b5050565b601f821115620004cb5762000495816200033f565b620004a08462000354565b81016020851015620004b0578190505b620004c8620004bf8562000354565b83018262000459565b50505b505050565b600082821c905092915050565b6000620004f060001984600802620004d0565b1980831691505092915050565b60006200050b8383620004dd565b9150826002028217905092915050565b6200052682620002d0565b67ffffffffffffffff8111156200054257620005416200011b565b5b6200054e82546200030a565b6200055b82828562000480565b600060209050601f8311600181146200059357600084156200057e578287015190505b6200058a8582620004fd565b865550620005fa565b601f198416620005a3866200033f565b60005b82811015620005cd57848901518255600182019150602085019450602081019050620005a6565b86831015620005ed5784890151620005e9601f891682620004dd565b8355505b6001600288020188555050505b505050505050565b612ed680620006126000396000f3fe608060405234801561001057600080fd5b50600436106100ce5760003560e01c8063715018a61161008c578063a22cb46511610066578063a22cb4651461020f578063e985e9c51461022b578063f242432a1461025b578063f2fde38b14610277576100ce565b8063715018a6146101cb578063731133e9146101d55780638da5cb5b146101f1576100ce565b8062fdd58e146100d357806301ffc9a7146101035780630e89341c146101335780631f7fdffa146101635780632eb2c2d61461017f5780634e1273f41461019b575b600080fd5b6100ed60048036038101906100e89190611974565b610293565b6040516100fa91906119c3565b60405180910390f35b61011d60048036038101906101189190611a36565b61035b565b60405161012a9190611a7e565b60405180910390f35b61014d60048036038101906101489190611a99565b61044a565b60405161015a9190611b56565b60405180910390f35b61017d60048036038101906101789190611d75565b6104de565b005b61019960048036038101906101949190611e30565b610713565b005b6101b560048036038101906101b09190611fc2565b610935565b6040516101c291906120f8565b60405180910390f35b6101d3610b1d565b005b6101ef60048036038101906101ea919061211a565b610c6e565b005b6101f9610e0b565b60405161020691906121ac565b60405180910390f35b610229600480360381019061022491906121f3565b610e35565b005b61024560048036038101906102409190612233565b610fa0565b6040516102529190611a7e565b60405180910390f35b61027560048036038101906102709190612273565b611034565b005b610291600480360381019061028c919061230a565b6111c0565b005b60008073ffffffffffffffffffffffffffffffffffffffff168373ffffffffffffffffffffffffffffffffffffffff1603610303576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016102fa906123a9565b60405180910390fd5b60008083815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054905092915050565b60006301ffc9a760e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff191614806103f4575063d9b67a2660e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916145b806104435750630e89341c60e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916827bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916145b9050919050565b606060028054610459906123f8565b80601f0160208091040260200160405190810160405280929190818152602001828054610485906123f8565b80156104d25780601f106104a7576101008083540402835291602001916104d2565b820191906000526020600020905b8154815290600101906020018083116104b557829003601f168201915b50505050509050919050565b3373ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff161461056e576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161056590612475565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff16036105dd576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105d490612507565b60405180910390fd5b8151835114610621576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161061890612599565b60405180910390fd5b60005b835181101561067f5761066c85858381518110610644576106436125b9565b5b602002602001015185848151811061065f5761065e6125b9565b5b602002602001015161137f565b808061067790612617565b915050610624565b508373ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb86866040516106f792919061265f565b60405180910390a461070d60008585858561147b565b50505050565b8151835114610757576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161074e90612599565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff16036107c6576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107bd90612708565b60405180910390fd5b3373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff16148061080657506108058533610fa0565b5b610845576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161083c9061279a565b60405180910390fd5b60005b83518110156108a2576108918686868481518110610869576108686125b9565b5b6020026020010151868581518110610884576108836125b9565b5b602002602001015161161f565b8061089b90612617565b9050610848565b508373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb868660405161091992919061265f565b60405180910390a461092e858585858561147b565b5050505050565b6060815183511461097b576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016109729061282c565b60405180910390fd5b6000835167ffffffffffffffff81111561099857610997611b7d565b5b6040519080825280602002602001820160405280156109c65781602001602082028036833780820191505090505b50905060005b8451811015610b1257600073ffffffffffffffffffffffffffffffffffffffff16858281518110610a00576109ff6125b9565b5b602002602001015173ffffffffffffffffffffffffffffffffffffffff1603610a5e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a55906128be565b60405180910390fd5b600080858381518110610a7457610a736125b9565b5b602002602001015181526020019081526020016000206000868381518110610a9f57610a9e6125b9565b5b602002602001015173ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002054828281518110610af557610af46125b9565b5b60200260200101818152505080610b0b90612617565b90506109cc565b508091505092915050565b3373ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614610bad576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ba490612475565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a36000600360006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b3373ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614610cfe576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610cf590612475565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff1603610d6d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d6490612507565b60405180910390fd5b610d7884848461137f565b8373ffffffffffffffffffffffffffffffffffffffff16600073ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f628686604051610def9291906128de565b60405180910390a4610e05600085858585611728565b50505050565b6000600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b8173ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff1603610ea3576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610e9a90612979565b60405180910390fd5b80600160003373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060006101000a81548160ff0219169083151502179055508173ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c3183604051610f949190611a7e565b60405180910390a35050565b6000600160008473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060009054906101000a900460ff16905092915050565b600073ffffffffffffffffffffffffffffffffffffffff168473ffffffffffffffffffffffffffffffffffffffff16036110a3576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161109a90612708565b60405180910390fd5b3373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff1614806110e357506110e28533610fa0565b5b611122576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161111990612a0b565b60405180910390fd5b61112e8585858561161f565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff163373ffffffffffffffffffffffffffffffffffffffff167fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f6286866040516111a49291906128de565b60405180910390a46111b98585858585611728565b5050505050565b3373ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1614611250576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161124790612475565b60405180910390fd5b600073ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff16036112bf576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016112b690612a9d565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff16600360009054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a380600360006101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b60008160008085815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff168152602001908152602001600020546113dc9190612abd565b905081811015611421576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161141890612b3d565b60405180910390fd5b8060008085815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000208190555050505050565b6000843b90506000810361148f5750611618565b8473ffffffffffffffffffffffffffffffffffffffff1663bc197c8133888787876040518663ffffffff1660e01b81526004016114d0959493929190612bb2565b6020604051808303816000875af192505050801561150c57506040513d601f19601f820116820180604052508101906115099190612c2f565b60015b61158e573d806000811461153c576040519150601f19603f3d011682016040523d82523d6000602084013e611541565b606091505b506000815103611586576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161157d90612cce565b60405180910390fd5b805181602001fd5b63bc197c8160e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916817bffffffffffffffffffffffffffffffffffffffffffffffffffffffff191614611615576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161160c90612d60565b60405180910390fd5b50505b5050505050565b8060008084815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020016000205410156116b1576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016116a890612df2565b60405180910390fd5b8060008084815260200190815260200160002060008673ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff16815260200190815260200160002060008282546117109190612e12565b9250508190555061172283838361137f565b50505050565b6000843b90506000810361173c57506118c5565b8473ffffffffffffffffffffffffffffffffffffffff1663f23a6e6133888787876040518663ffffffff1660e01b815260040161177d959493929190612e46565b6020604051808303816000875af19250505080156117b957506040513d601f19601f820116820180604052508101906117b69190612c2f565b60015b61183b573d80600081146117e9576040519150601f19603f3d011682016040523d82523d6000602084013e6117ee565b606091505b506000815103611833576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161182a90612cce565b60405180910390fd5b805181602001fd5b63f23a6e6160e01b7bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916817bffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916146118c2576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016118b990612d60565b60405180910390fd5b50505b5050505050565b6000604051905090565b600080fd5b600080fd5b600073ffffffffffffffffffffffffffffffffffffffff82169050919050565b600061190b826118e0565b9050919050565b61191b81611900565b811461192657600080fd5b50565b60008135905061193881611912565b92915050565b6000819050919050565b6119518161193e565b811461195c57600080fd5b50565b60008135905061196e81611948565b92915050565b6000806040838503121561198b5761198a6118d6565b5b600061199985828601611929565b92505060206119aa8582860161195f565b9150509250929050565b6119bd8161193e565b82525050565b60006020820190506119d860008301846119b4565b92915050565b60007fffffffff0000000000000000000000000000000000000000000000000000000082169050919050565b611a13816119de565b8114611a1e57600080fd5b50565b600081359050611a3081611a0a565b92915050565b600060208284031215611a4c57611a4b6118d6565b5b6000611a5a84828501611a21565b91505092915050565b60008115159050919050565b611a7881611a63565b82525050565b6000602082019050611a936000830184611a6f565b92915050565b600060208284031215611aaf57611aae6118d6565b5b6000611abd8482850161195f565b91505092915050565b600081519050919050565b600082825260208201905092915050565b60005b83811015611b00578082015181840152602081019050611ae5565b60008484015250505050565b6000601f19601f8301169050919050565b6000611b2882611ac6565b611b328185611ad1565b9350611b42818560208601611ae2565b611b4b81611b0c565b840191505092915050565b60006020820190508181036000830152611b708184611b1d565b905092915050565b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b611bb582611b0c565b810181811067ffffffffffffffff82111715611bd457611bd3611b7d565b5b80604052505050565b6000611be76118cc565b9050611bf38282611bac565b919050565b600067ffffffffffffffff821115611c1357611c12611b7d565b5b602082029050602081019050919050565b600080fd5b6000611c3c611c3784611bf8565b611bdd565b90508083825260208201905060208402830185811115611c5f57611c5e611c24565b5b835b81811015611c885780611c74888261195f565b845260208401935050602081019050611c61565b5050509392505050565b600082601f830112611ca757611ca6611b78565b5b8135611cb7848260208601611c29565b91505092915050565b600080fd5b600067ffffffffffffffff821115611ce057611cdf611b7d565b5b611ce982611b0c565b9050602081019050919050565b82818337600083830152505050565b6000611d18611d1384611cc5565b611bdd565b905082815260208101848484011115611d3457611d33611cc0565b5b611d3f848285611cf6565b509392505050565b600082601f830112611d5c57611d5b611b78565b5b8135611d6c848260208601611d05565b91505092915050565b60008060008060808587031215611d8f57611d8e6118d6565b5b6000611d9d87828801611929565b945050602085013567ffffffffffffffff811115611dbe57611dbd6118db565b5b611dca87828801611c92565b935050604085013567ffffffffffffffff811115611deb57611dea6118db565b5b611df787828801611c92565b925050606085013567ffffffffffffffff811115611e1857611e176118db565b5b611e2487828801611d47565b91505092959194509250565b600080600080600060a08688031215611e4c57611e4b6118d6565b5b6000611e5a88828901611929565b9550506020611e6b88828901611929565b945050604086013567ffffffffffffffff811115611e8c57611e8b6118db565b5b611e9888828901611c92565b935050606086013567ffffffffffffffff811115611eb957611eb86118db565b5b611ec588828901611c92565b925050608086013567ffffffffffffffff811115611ee657611ee56118db565b5b611ef288828901611d47565b9150509295509295909350565b600067ffffffffffffffff821115611f1a57611f19611b7d565b5b602082029050602081019050919050565b6000611f3e611f3984611eff565b611bdd565b90508083825260208201905060208402830185811115611f6157611f60611c24565b5b835b81811015611f8a5780611f768882611929565b845260208401935050602081019050611f63565b5050509392505050565b600082601f830112611fa957611fa8611b78565b5b8135611fb9848260208601611f2b565b91505092915050565b60008060408385031215611fd957611fd86118d6565b5b600083013567ffffffffffffffff811115611ff757611ff66118db565b5b61200385828601611f94565b925050602083013567ffffffffffffffff811115612024576120236118db565b5b61203085828601611c92565b9150509250929050565b600081519050919050565b600082825260208201905092915050565b6000819050602082019050919050565b61206f8161193e565b82525050565b60006120818383612066565b60208301905092915050565b6000602082019050919050565b60006120a58261203a565b6120af8185612045565b93506120ba83612056565b8060005b838110156120eb5781516120d28882612075565b97506120dd8361208d565b9250506001810190506120be565b5085935050505092915050565b60006020820190508181036000830152612112818461209a565b905092915050565b60008060008060808587031215612134576121336118d6565b5b600061214287828801611929565b94505060206121538782880161195f565b93505060406121648782880161195f565b925050606085013567ffffffffffffffff811115612185576121846118db565b5b61219187828801611d47565b91505092959194509250565b6121a681611900565b82525050565b60006020820190506121c1600083018461219d565b92915050565b6121d081611a63565b81146121db57600080fd5b50565b6000813590506121ed816121c7565b92915050565b6000806040838503121561220a576122096118d6565b5b600061221885828601611929565b9250506020612229858286016121de565b9150509250929050565b6000806040838503121561224a576122496118d6565b5b600061225885828601611929565b925050602061226985828601611929565b9150509250929050565b600080600080600060a0868803121561228f5761228e6118d6565b5b600061229d88828901611929565b95505060206122ae88828901611929565b94505060406122bf8882890161195f565b93505060606122d08882890161195f565b925050608086013567ffffffffffffffff8111156122f1576122f06118db565b5b6122fd88828901611d47565b9150509295509295909350565b6000602082840312156123205761231f6118d6565b5b600061232e84828501611929565b91505092915050565b7f455243313135353a2062616c616e636520717565727920666f7220746865207a60008201527f65726f2061646472657373000000000000000000000000000000000000000000602082015250565b6000612393602b83611ad1565b915061239e82612337565b604082019050919050565b600060208201905081810360008301526123c281612386565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b6000600282049050600182168061241057607f821691505b602082108103612423576124226123c9565b5b50919050565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572600082015250565b600061245f602083611ad1565b915061246a82612429565b602082019050919050565b6000602082019050818103600083015261248e81612452565b9050919050565b7f455243313135353a206d696e7420746f20746865207a65726f2061646472657360008201527f7300000000000000000000000000000000000000000000000000000000000000602082015250565b60006124f1602183611ad1565b91506124fc82612495565b604082019050919050565b60006020820190508181036000830152612520816124e4565b9050919050565b7f455243313135353a2069647320616e6420616d6f756e7473206c656e6774682060008201527f6d69736d61746368000000000000000000000000000000000000000000000000602082015250565b6000612583602883611ad1565b915061258e82612527565b604082019050919050565b600060208201905081810360008301526125b281612576565b9050919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b60006126228261193e565b91507fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203612654576126536125e8565b5b600182019050919050565b60006040820190508181036000830152612679818561209a565b9050818103602083015261268d818461209a565b90509392505050565b7f455243313135353a207472616e7366657220746f20746865207a65726f20616460008201527f6472657373000000000000000000000000000000000000000000000000000000602082015250565b60006126f2602583611ad1565b91506126fd82612696565b604082019050919050565b60006020820190508181036000830152612721816126e5565b9050919050565b7f455243313135353a207472616e736665722063616c6c6572206973206e6f742060008201527f6f776e6572206e6f7220617070726f7665640000000000000000000000000000602082015250565b6000612784603283611ad1565b915061278f82612728565b604082019050919050565b600060208201905081810360008301526127b381612777565b9050919050565b7f455243313135353a206163636f756e747320616e6420696473206c656e67746860008201527f206d69736d617463680000000000000000000000000000000000000000000000602082015250565b6000612816602983611ad1565b9150612821826127ba565b604082019050919050565b6000602082019050818103600083015261284581612809565b9050919050565b7f455243313135353a2062617463682062616c616e636520717565727920666f7260008201527f20746865207a65726f2061646472657373000000000000000000000000000000602082015250565b60006128a8603183611ad1565b91506128b38261284c565b604082019050919050565b600060208201905081810360008301526128d78161289b565b9050919050565b60006040820190506128f360008301856119b4565b61290060208301846119b4565b9392505050565b7f455243313135353a2073657474696e6720617070726f76616c2073746174757360008201527f20666f722073656c660000000000000000000000000000000000000000000000602082015250565b6000612963602983611ad1565b915061296e82612907565b604082019050919050565b6000602082019050818103600083015261299281612956565b9050919050565b7f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260008201527f20617070726f7665640000000000000000000000000000000000000000000000602082015250565b60006129f5602983611ad1565b9150612a0082612999565b604082019050919050565b60006020820190508181036000830152612a24816129e8565b9050919050565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160008201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b6000612a87602683611ad1565b9150612a9282612a2b565b604082019050919050565b60006020820190508181036000830152612ab681612a7a565b9050919050565b6000612ac88261193e565b9150612ad38361193e565b9250828201905080821115612aeb57612aea6125e8565b5b92915050565b7f536166654d6174683a206164646974696f6e206f766572666c6f770000000000600082015250565b6000612b27601b83611ad1565b9150612b3282612af1565b602082019050919050565b60006020820190508181036000830152612b5681612b1a565b9050919050565b600081519050919050565b600082825260208201905092915050565b6000612b8482612b5d565b612b8e8185612b68565b9350612b9e818560208601611ae2565b612ba781611b0c565b840191505092915050565b600060a082019050612bc7600083018861219d565b612bd4602083018761219d565b8181036040830152612be6818661209a565b90508181036060830152612bfa818561209a565b90508181036080830152612c0e8184612b79565b90509695505050505050565b600081519050612c2981611a0a565b92915050565b600060208284031215612c4557612c446118d6565b5b6000612c5384828501612c1a565b91505092915050565b7f455243313135353a207472616e7366657220746f206e6f6e204552433131353560008201527f526563656976657220696d706c656d656e746572000000000000000000000000602082015250565b6000612cb8603483611ad1565b9150612cc382612c5c565b604082019050919050565b60006020820190508181036000830152612ce781612cab565b9050919050565b7f455243313135353a204552433131353552656365697665722072656a6563746560008201527f6420746f6b656e73000000000000000000000000000000000000000000000000602082015250565b6000612d4a602883611ad1565b9150612d5582612cee565b604082019050919050565b60006020820190508181036000830152612d7981612d3d565b9050919050565b7f455243313135353a20696e73756666696369656e742062616c616e636520666f60008201527f72207472616e7366657200000000000000000000000000000000000000000000602082015250565b6000612ddc602a83611ad1565b9150612de782612d80565b604082019050919050565b60006020820190508181036000830152612e0b81612dcf565b9050919050565b6000612e1d8261193e565b9150612e288361193e565b9250828203905081811115612e4057612e3f6125e8565b5b92915050565b600060a082019050612e5b600083018861219d565b612e68602083018761219d565b612e7560408301866119b4565b612e8260608301856119b4565b8181036080830152612e948184612b79565b9050969550505050505056fea264697066735822122020cd74209398276d7987572cfd266655e8de0c6565ae68c528892ecc2715d67364736f6c63430008150033",
+}
+
+// TestMultiTokenABI is the input ABI used to generate the binding from.
+// Deprecated: Use TestMultiTokenMetaData.ABI instead.
+var TestMultiTokenABI = TestMultiTokenMetaData.ABI
+
+// TestMultiTokenBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use TestMultiTokenMetaData.Bin instead.
+var TestMultiTokenBin = TestMultiTokenMetaData.Bin
+
+// DeployTestMultiToken deploys a new Ethereum contract, binding an instance of TestMultiToken to it.
+func DeployTestMultiToken(auth *bind.TransactOpts, backend bind.ContractBackend, uri_ string) (common.Address, *types.Transaction, *TestMultiToken, error) {
+	parsed, err := TestMultiTokenMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(TestMultiTokenBin), backend, uri_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &TestMultiToken{TestMultiTokenCaller: TestMultiTokenCaller{contract: contract}, TestMultiTokenTransactor: TestMultiTokenTransactor{contract: contract}, TestMultiTokenFilterer: TestMultiTokenFilterer{contract: contract}}, nil
+}
+
+// TestMultiToken is an auto generated Go binding around an Ethereum contract.
+type TestMultiToken struct {
+	TestMultiTokenCaller     // Read-only binding to the contract
+	TestMultiTokenTransactor // Write-only binding to the contract
+	TestMultiTokenFilterer   // Log filterer for contract events
+}
+
+// TestMultiTokenCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TestMultiTokenCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TestMultiTokenTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TestMultiTokenTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TestMultiTokenFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TestMultiTokenFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TestMultiTokenSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TestMultiTokenSession struct {
+	Contract     *TestMultiToken   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TestMultiTokenCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TestMultiTokenCallerSession struct {
+	Contract *TestMultiTokenCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// TestMultiTokenTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TestMultiTokenTransactorSession struct {
+	Contract     *TestMultiTokenTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// TestMultiTokenRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TestMultiTokenRaw struct {
+	Contract *TestMultiToken // Generic contract binding to access the raw methods on
+}
+
+// TestMultiTokenCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TestMultiTokenCallerRaw struct {
+	Contract *TestMultiTokenCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TestMultiTokenTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TestMultiTokenTransactorRaw struct {
+	Contract *TestMultiTokenTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTestMultiToken creates a new instance of TestMultiToken, bound to a specific deployed contract.
+func NewTestMultiToken(address common.Address, backend bind.ContractBackend) (*TestMultiToken, error) {
+	contract, err := bindTestMultiToken(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiToken{TestMultiTokenCaller: TestMultiTokenCaller{contract: contract}, TestMultiTokenTransactor: TestMultiTokenTransactor{contract: contract}, TestMultiTokenFilterer: TestMultiTokenFilterer{contract: contract}}, nil
+}
+
+// NewTestMultiTokenCaller creates a new read-only instance of TestMultiToken, bound to a specific deployed contract.
+func NewTestMultiTokenCaller(address common.Address, caller bind.ContractCaller) (*TestMultiTokenCaller, error) {
+	contract, err := bindTestMultiToken(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenCaller{contract: contract}, nil
+}
+
+// NewTestMultiTokenTransactor creates a new write-only instance of TestMultiToken, bound to a specific deployed contract.
+func NewTestMultiTokenTransactor(address common.Address, transactor bind.ContractTransactor) (*TestMultiTokenTransactor, error) {
+	contract, err := bindTestMultiToken(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenTransactor{contract: contract}, nil
+}
+
+// NewTestMultiTokenFilterer creates a new log filterer instance of TestMultiToken, bound to a specific deployed contract.
+func NewTestMultiTokenFilterer(address common.Address, filterer bind.ContractFilterer) (*TestMultiTokenFilterer, error) {
+	contract, err := bindTestMultiToken(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenFilterer{contract: contract}, nil
+}
+
+// bindTestMultiToken binds a generic wrapper to an already deployed contract.
+func bindTestMultiToken(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(TestMultiTokenABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TestMultiToken *TestMultiTokenRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TestMultiToken.Contract.TestMultiTokenCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TestMultiToken *TestMultiTokenRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.TestMultiTokenTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TestMultiToken *TestMultiTokenRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.TestMultiTokenTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TestMultiToken *TestMultiTokenCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TestMultiToken.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TestMultiToken *TestMultiTokenTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TestMultiToken *TestMultiTokenTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_TestMultiToken *TestMultiTokenCaller) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "balanceOf", account, id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_TestMultiToken *TestMultiTokenSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _TestMultiToken.Contract.BalanceOf(&_TestMultiToken.CallOpts, account, id)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_TestMultiToken *TestMultiTokenCallerSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _TestMultiToken.Contract.BalanceOf(&_TestMultiToken.CallOpts, account, id)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_TestMultiToken *TestMultiTokenCaller) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "balanceOfBatch", accounts, ids)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_TestMultiToken *TestMultiTokenSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _TestMultiToken.Contract.BalanceOfBatch(&_TestMultiToken.CallOpts, accounts, ids)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_TestMultiToken *TestMultiTokenCallerSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _TestMultiToken.Contract.BalanceOfBatch(&_TestMultiToken.CallOpts, accounts, ids)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_TestMultiToken *TestMultiTokenCaller) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "isApprovedForAll", account, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_TestMultiToken *TestMultiTokenSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _TestMultiToken.Contract.IsApprovedForAll(&_TestMultiToken.CallOpts, account, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_TestMultiToken *TestMultiTokenCallerSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _TestMultiToken.Contract.IsApprovedForAll(&_TestMultiToken.CallOpts, account, operator)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_TestMultiToken *TestMultiTokenCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_TestMultiToken *TestMultiTokenSession) Owner() (common.Address, error) {
+	return _TestMultiToken.Contract.Owner(&_TestMultiToken.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_TestMultiToken *TestMultiTokenCallerSession) Owner() (common.Address, error) {
+	return _TestMultiToken.Contract.Owner(&_TestMultiToken.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
+func (_TestMultiToken *TestMultiTokenCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
+func (_TestMultiToken *TestMultiTokenSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _TestMultiToken.Contract.SupportsInterface(&_TestMultiToken.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) pure returns(bool)
+func (_TestMultiToken *TestMultiTokenCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _TestMultiToken.Contract.SupportsInterface(&_TestMultiToken.CallOpts, interfaceId)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_TestMultiToken *TestMultiTokenCaller) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var out []interface{}
+	err := _TestMultiToken.contract.Call(opts, &out, "uri", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_TestMultiToken *TestMultiTokenSession) Uri(arg0 *big.Int) (string, error) {
+	return _TestMultiToken.Contract.Uri(&_TestMultiToken.CallOpts, arg0)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_TestMultiToken *TestMultiTokenCallerSession) Uri(arg0 *big.Int) (string, error) {
+	return _TestMultiToken.Contract.Uri(&_TestMultiToken.CallOpts, arg0)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+//
+// Solidity: function mint(address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) Mint(opts *bind.TransactOpts, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "mint", to, id, amount, data)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+//
+// Solidity: function mint(address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenSession) Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.Mint(&_TestMultiToken.TransactOpts, to, id, amount, data)
+}
+
+// Mint is a paid mutator transaction binding the contract method 0x731133e9.
+//
+// Solidity: function mint(address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) Mint(to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.Mint(&_TestMultiToken.TransactOpts, to, id, amount, data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x1f7fdffa.
+//
+// Solidity: function mintBatch(address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) MintBatch(opts *bind.TransactOpts, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "mintBatch", to, ids, amounts, data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x1f7fdffa.
+//
+// Solidity: function mintBatch(address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenSession) MintBatch(to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.MintBatch(&_TestMultiToken.TransactOpts, to, ids, amounts, data)
+}
+
+// MintBatch is a paid mutator transaction binding the contract method 0x1f7fdffa.
+//
+// Solidity: function mintBatch(address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) MintBatch(to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.MintBatch(&_TestMultiToken.TransactOpts, to, ids, amounts, data)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_TestMultiToken *TestMultiTokenTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_TestMultiToken *TestMultiTokenSession) RenounceOwnership() (*types.Transaction, error) {
+	return _TestMultiToken.Contract.RenounceOwnership(&_TestMultiToken.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _TestMultiToken.Contract.RenounceOwnership(&_TestMultiToken.TransactOpts)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SafeBatchTransferFrom(&_TestMultiToken.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SafeBatchTransferFrom(&_TestMultiToken.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SafeTransferFrom(&_TestMultiToken.TransactOpts, from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SafeTransferFrom(&_TestMultiToken.TransactOpts, from, to, id, amount, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_TestMultiToken *TestMultiTokenSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SetApprovalForAll(&_TestMultiToken.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.SetApprovalForAll(&_TestMultiToken.TransactOpts, operator, approved)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_TestMultiToken *TestMultiTokenTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _TestMultiToken.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_TestMultiToken *TestMultiTokenSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.TransferOwnership(&_TestMultiToken.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_TestMultiToken *TestMultiTokenTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _TestMultiToken.Contract.TransferOwnership(&_TestMultiToken.TransactOpts, newOwner)
+}
+
+// TestMultiTokenApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the TestMultiToken contract.
+type TestMultiTokenApprovalForAllIterator struct {
+	Event *TestMultiTokenApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TestMultiTokenApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TestMultiTokenApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TestMultiTokenApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TestMultiTokenApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TestMultiTokenApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TestMultiTokenApprovalForAll represents a ApprovalForAll event raised by the TestMultiToken contract.
+type TestMultiTokenApprovalForAll struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_TestMultiToken *TestMultiTokenFilterer) FilterApprovalForAll(opts *bind.FilterOpts, account []common.Address, operator []common.Address) (*TestMultiTokenApprovalForAllIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.FilterLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenApprovalForAllIterator{contract: _TestMultiToken.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_TestMultiToken *TestMultiTokenFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *TestMultiTokenApprovalForAll, account []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.WatchLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TestMultiTokenApprovalForAll)
+				if err := _TestMultiToken.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_TestMultiToken *TestMultiTokenFilterer) ParseApprovalForAll(log types.Log) (*TestMultiTokenApprovalForAll, error) {
+	event := new(TestMultiTokenApprovalForAll)
+	if err := _TestMultiToken.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TestMultiTokenOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the TestMultiToken contract.
+type TestMultiTokenOwnershipTransferredIterator struct {
+	Event *TestMultiTokenOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TestMultiTokenOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TestMultiTokenOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TestMultiTokenOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TestMultiTokenOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TestMultiTokenOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TestMultiTokenOwnershipTransferred represents a OwnershipTransferred event raised by the TestMultiToken contract.
+type TestMultiTokenOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_TestMultiToken *TestMultiTokenFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*TestMultiTokenOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenOwnershipTransferredIterator{contract: _TestMultiToken.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_TestMultiToken *TestMultiTokenFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *TestMultiTokenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TestMultiTokenOwnershipTransferred)
+				if err := _TestMultiToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_TestMultiToken *TestMultiTokenFilterer) ParseOwnershipTransferred(log types.Log) (*TestMultiTokenOwnershipTransferred, error) {
+	event := new(TestMultiTokenOwnershipTransferred)
+	if err := _TestMultiToken.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TestMultiTokenTransferBatchIterator is returned from FilterTransferBatch and is used to iterate over the raw logs and unpacked data for TransferBatch events raised by the TestMultiToken contract.
+type TestMultiTokenTransferBatchIterator struct {
+	Event *TestMultiTokenTransferBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TestMultiTokenTransferBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TestMultiTokenTransferBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TestMultiTokenTransferBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TestMultiTokenTransferBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TestMultiTokenTransferBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TestMultiTokenTransferBatch represents a TransferBatch event raised by the TestMultiToken contract.
+type TestMultiTokenTransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferBatch is a free log retrieval operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_TestMultiToken *TestMultiTokenFilterer) FilterTransferBatch(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*TestMultiTokenTransferBatchIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.FilterLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenTransferBatchIterator{contract: _TestMultiToken.contract, event: "TransferBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBatch is a free log subscription operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_TestMultiToken *TestMultiTokenFilterer) WatchTransferBatch(opts *bind.WatchOpts, sink chan<- *TestMultiTokenTransferBatch, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.WatchLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TestMultiTokenTransferBatch)
+				if err := _TestMultiToken.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferBatch is a log parse operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_TestMultiToken *TestMultiTokenFilterer) ParseTransferBatch(log types.Log) (*TestMultiTokenTransferBatch, error) {
+	event := new(TestMultiTokenTransferBatch)
+	if err := _TestMultiToken.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TestMultiTokenTransferSingleIterator is returned from FilterTransferSingle and is used to iterate over the raw logs and unpacked data for TransferSingle events raised by the TestMultiToken contract.
+type TestMultiTokenTransferSingleIterator struct {
+	Event *TestMultiTokenTransferSingle // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TestMultiTokenTransferSingleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TestMultiTokenTransferSingle)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TestMultiTokenTransferSingle)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TestMultiTokenTransferSingleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TestMultiTokenTransferSingleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TestMultiTokenTransferSingle represents a TransferSingle event raised by the TestMultiToken contract.
+type TestMultiTokenTransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferSingle is a free log retrieval operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_TestMultiToken *TestMultiTokenFilterer) FilterTransferSingle(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*TestMultiTokenTransferSingleIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.FilterLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TestMultiTokenTransferSingleIterator{contract: _TestMultiToken.contract, event: "TransferSingle", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferSingle is a free log subscription operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_TestMultiToken *TestMultiTokenFilterer) WatchTransferSingle(opts *bind.WatchOpts, sink chan<- *TestMultiTokenTransferSingle, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _TestMultiToken.contract.WatchLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TestMultiTokenTransferSingle)
+				if err := _TestMultiToken.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferSingle is a log parse operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_TestMultiToken *TestMultiTokenFilterer) ParseTransferSingle(log types.Log) (*TestMultiTokenTransferSingle, error) {
+	event := new(TestMultiTokenTransferSingle)
+	if err := _TestMultiToken.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
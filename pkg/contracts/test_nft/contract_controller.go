@@ -0,0 +1,867 @@
+package test_nft
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	cc "go-evm-client/internal/contracts_template_interface"
+	utils "go-evm-client/internal/utils"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// IInstance is the interface needed for these contract functions
+type IInstance interface {
+	Mint(
+		opts *bind.TransactOpts,
+		to common.Address,
+		tokenId *big.Int,
+	) (*types.Transaction, error)
+	Approve(
+		opts *bind.TransactOpts,
+		to common.Address,
+		tokenId *big.Int,
+	) (*types.Transaction, error)
+	SetApprovalForAll(
+		opts *bind.TransactOpts,
+		operator common.Address,
+		approved bool,
+	) (*types.Transaction, error)
+	TransferFrom(
+		opts *bind.TransactOpts,
+		from common.Address,
+		to common.Address,
+		tokenId *big.Int,
+	) (*types.Transaction, error)
+	SafeTransferFrom(
+		opts *bind.TransactOpts,
+		from common.Address,
+		to common.Address,
+		tokenId *big.Int,
+	) (*types.Transaction, error)
+	SafeTransferFrom0(
+		opts *bind.TransactOpts,
+		from common.Address,
+		to common.Address,
+		tokenId *big.Int,
+		data []byte,
+	) (*types.Transaction, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error)
+	GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error)
+	IsApprovedForAll(
+		opts *bind.CallOpts,
+		owner common.Address,
+		operator common.Address,
+	) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	FilterTransfer(
+		opts *bind.FilterOpts,
+		from []common.Address,
+		to []common.Address,
+		tokenId []*big.Int,
+	) (*TestNFTTransferIterator, error)
+	FilterApproval(
+		opts *bind.FilterOpts,
+		owner []common.Address,
+		approved []common.Address,
+		tokenId []*big.Int,
+	) (*TestNFTApprovalIterator, error)
+	FilterApprovalForAll(
+		opts *bind.FilterOpts,
+		owner []common.Address,
+		operator []common.Address,
+	) (*TestNFTApprovalForAllIterator, error)
+	FilterOwnershipTransferred(
+		opts *bind.FilterOpts,
+		previousOwner []common.Address,
+		newOwner []common.Address,
+	) (*TestNFTOwnershipTransferredIterator, error)
+	WatchTransfer(
+		opts *bind.WatchOpts,
+		sink chan<- *TestNFTTransfer,
+		from []common.Address,
+		to []common.Address,
+		tokenId []*big.Int,
+	) (event.Subscription, error)
+	WatchApproval(
+		opts *bind.WatchOpts,
+		sink chan<- *TestNFTApproval,
+		owner []common.Address,
+		approved []common.Address,
+		tokenId []*big.Int,
+	) (event.Subscription, error)
+	WatchApprovalForAll(
+		opts *bind.WatchOpts,
+		sink chan<- *TestNFTApprovalForAll,
+		owner []common.Address,
+		operator []common.Address,
+	) (event.Subscription, error)
+	WatchOwnershipTransferred(
+		opts *bind.WatchOpts,
+		sink chan<- *TestNFTOwnershipTransferred,
+		previousOwner []common.Address,
+		newOwner []common.Address,
+	) (event.Subscription, error)
+}
+
+// TestNFTContract contains all the data needed to
+// deploy and interact with the TestNFT contract
+type TestNFTContract struct {
+	cc.Contract
+	ConstructorArgs contractConstructorArgs
+	nftQueriableContractData
+	Address    common.Address
+	LastTx     *types.Transaction
+	Instance   IInstance
+	StrToPrint string
+	// ArgParser validates the address arguments, nil uses strict defaults
+	ArgParser *utils.ArgParser
+}
+
+// contractConstructorArgs are the details needed to deploy
+// the TestNFT. These details are passed onto the
+// contract's constructor
+type contractConstructorArgs struct {
+	name    string
+	symbol  string
+	baseURI string
+}
+
+// nftQueriableContractData is a struct that holds all the data
+// that can be queried from the contract
+type nftQueriableContractData struct {
+	Name             string
+	Symbol           string
+	BalanceOf        map[common.Address]*big.Int
+	OwnerOf          map[string]common.Address
+	TokenURI         map[string]string
+	GetApproved      map[string]common.Address
+	IsApprovedForAll map[common.Address]map[common.Address]bool
+	Owner            common.Address
+}
+
+// ParseConstructorArguments parses the name, symbol and base URI of the
+// collection, the base URI may be empty in which case the tokens have no
+// URI
+func (t *TestNFTContract) ParseConstructorArguments(
+	contractArgs []string) error {
+	neededArgs := reflect.TypeOf(contractConstructorArgs{}).NumField()
+	recArgs := len(contractArgs)
+	if recArgs != neededArgs {
+		return fmt.Errorf("error: incorrect amount of arguments, args "+
+			"needed : %d != args received %d", neededArgs, recArgs)
+	}
+	t.ConstructorArgs = contractConstructorArgs{
+		name:    contractArgs[0],
+		symbol:  contractArgs[1],
+		baseURI: contractArgs[2],
+	}
+	return nil
+}
+
+// DeployContract deploys the test NFT contract and saves
+// its instance, tx of deployment and contract address
+func (t *TestNFTContract) DeployContract(
+	auth *bind.TransactOpts,
+	client eth_rpc_client.IEthClient,
+) error {
+	address, tx, instance, err := DeployTestNFT(
+		auth,
+		client,
+		t.ConstructorArgs.name,
+		t.ConstructorArgs.symbol,
+		t.ConstructorArgs.baseURI)
+	if err != nil {
+		return err
+	}
+	t.Address = address
+	t.LastTx = tx
+	t.Instance = instance
+	return nil
+}
+
+// LoadContract loads the test NFT contract and saves
+// its instance, contract address
+func (t *TestNFTContract) LoadContract(
+	address *common.Address,
+	client eth_rpc_client.IEthClient,
+) error {
+	instance, err := NewTestNFT(*address, client)
+	if err != nil {
+		return err
+	}
+	t.Instance = instance
+	t.Address = *address
+	// Instantiate empty maps
+	t.BalanceOf = map[common.Address]*big.Int{}
+	t.OwnerOf = map[string]common.Address{}
+	t.TokenURI = map[string]string{}
+	t.GetApproved = map[string]common.Address{}
+	t.IsApprovedForAll = map[common.Address]map[common.Address]bool{}
+	return nil
+}
+
+// SetArgParser sets the parser used to validate the function arguments
+func (t *TestNFTContract) SetArgParser(parser *utils.ArgParser) {
+	t.ArgParser = parser
+}
+
+// PrintDeploymentData outputs to the terminal the address and
+// transaction of the deployed contract.
+func (t *TestNFTContract) PrintDeploymentData() {
+	fmt.Printf("Test NFT Contract successfully deployed at %s, "+
+		"see transaction here %s\n", t.Address.Hex(), t.LastTx.Hash().Hex())
+}
+
+// PrintLoadedContractData outs the success message of loading the
+// contract as well as the address it's loaded at.
+func (t *TestNFTContract) PrintLoadedContractData() {
+	fmt.Printf("Test NFT Contract successfully loaded at %s\n",
+		t.Address.Hex())
+}
+
+// PrintContractDataAfterExecution print out whatever was saved in StrToPrint
+func (t *TestNFTContract) PrintContractDataAfterExecution() {
+	fmt.Printf("%s", t.StrToPrint)
+}
+
+// parseBool converts the approved argument of setapprovalforall
+func parseBool(arg string) (bool, error) {
+	value, err := strconv.ParseBool(arg)
+	if err != nil {
+		return false, fmt.Errorf("error: %q is not a valid boolean", arg)
+	}
+	return value, nil
+}
+
+// parseData decodes the hex encoded data forwarded by safetransferfrom to
+// the onERC721Received hook of the recipient
+func parseData(arg string) ([]byte, error) {
+	if arg == "" || arg == "0x" {
+		return []byte{}, nil
+	}
+	data, err := hexutil.Decode(arg)
+	if err != nil {
+		return nil, fmt.Errorf("error: %q is not valid hex data", arg)
+	}
+	return data, nil
+}
+
+// WriteContract executes write transaction which invokes a state
+// change in the TestNFT contract, this execution is based on
+// function name and arguments. Function arguments are verified for
+// length and then converted to fit the appropriate types.
+func (t *TestNFTContract) WriteContract(
+	auth *bind.TransactOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "mint":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		recipient, err1 := t.ArgParser.ParseRecipient(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		tokenId, err2 := utils.ParseTokenID(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := t.Instance.Mint(auth, recipient, tokenId)
+		if err3 != nil {
+			return err3
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Minted token %d at TestNFT (%s) "+
+			"to address %s\n", tokenId, t.Address, recipient)
+	case "approve":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		approved, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		tokenId, err2 := utils.ParseTokenID(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := t.Instance.Approve(auth, approved, tokenId)
+		if err3 != nil {
+			return err3
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Approved token %d at TestNFT (%s) "+
+			"to address %s\n", tokenId, t.Address, approved)
+	case "setapprovalforall":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		operator, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		approved, err2 := parseBool(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tx, err3 := t.Instance.SetApprovalForAll(auth, operator, approved)
+		if err3 != nil {
+			return err3
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Set approval for all tokens of "+
+			"operator %s to %t at TestNFT (%s)\n", operator, approved, t.Address)
+	case "transferfrom":
+		err := utils.ValidateLength(&funcArgs, 3)
+		if err != nil {
+			return err
+		}
+		from, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		recipient, err2 := t.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tokenId, err3 := utils.ParseTokenID(funcArgs[2])
+		if err3 != nil {
+			return err3
+		}
+		tx, err4 := t.Instance.TransferFrom(auth, from, recipient, tokenId)
+		if err4 != nil {
+			return err4
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Transferred From %s token %d at "+
+			"TestNFT (%s) to address %s\n", from, tokenId, t.Address, recipient)
+	case "safetransferfrom":
+		// The data forwarded to the receiver contract is optional
+		if len(funcArgs) != 4 {
+			err := utils.ValidateLength(&funcArgs, 3)
+			if err != nil {
+				return err
+			}
+		}
+		from, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		recipient, err2 := t.ArgParser.ParseRecipient(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		tokenId, err3 := utils.ParseTokenID(funcArgs[2])
+		if err3 != nil {
+			return err3
+		}
+		var tx *types.Transaction
+		var err4 error
+		if len(funcArgs) == 4 {
+			data, err5 := parseData(funcArgs[3])
+			if err5 != nil {
+				return err5
+			}
+			tx, err4 = t.Instance.SafeTransferFrom0(auth, from, recipient,
+				tokenId, data)
+		} else {
+			tx, err4 = t.Instance.SafeTransferFrom(auth, from, recipient,
+				tokenId)
+		}
+		if err4 != nil {
+			return err4
+		}
+		t.LastTx = tx
+		t.StrToPrint = fmt.Sprintf("info: Safely Transferred From %s token "+
+			"%d at TestNFT (%s) to address %s\n", from, tokenId, t.Address,
+			recipient)
+	default:
+		return nil
+	}
+	return nil
+}
+
+// QueryContract executes query functions which do not invoke a state
+// change in the TestNFT contract, this execution is based on
+// function name and arguments. Function arguments are verified for
+// length and then converted to fit the appropriate types.
+// The call options select the block and caller the view calls are
+// executed with. Data retrieved is then stored in nftQueriableContractData
+func (t *TestNFTContract) QueryContract(
+	opts *bind.CallOpts,
+	funcName string,
+	funcArgs []string,
+) error {
+	switch funcName {
+	case "name":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		name, err1 := t.Instance.Name(opts)
+		if err1 != nil {
+			return err1
+		}
+		t.Name = name
+		t.StrToPrint = fmt.Sprintf("info: Token Name: %s for TestNFT (%s)\n",
+			name, t.Address)
+	case "symbol":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		symbol, err1 := t.Instance.Symbol(opts)
+		if err1 != nil {
+			return err1
+		}
+		t.Symbol = symbol
+		t.StrToPrint = fmt.Sprintf("info: Token Symbol: %s for TestNFT (%s)\n",
+			symbol, t.Address)
+	case "owner":
+		err := utils.ValidateLength(&funcArgs, 0)
+		if err != nil {
+			return err
+		}
+		owner, err1 := t.Instance.Owner(opts)
+		if err1 != nil {
+			return err1
+		}
+		t.Owner = owner
+		t.StrToPrint = fmt.Sprintf("info: Contract Owner: %s for TestNFT (%s)"+
+			"\n", owner, t.Address)
+	case "balanceof":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		account, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		balance, err2 := t.Instance.BalanceOf(opts, account)
+		if err2 != nil {
+			return err2
+		}
+		t.BalanceOf[account] = balance
+		t.StrToPrint = fmt.Sprintf("info: Token Balance of %s : %d for "+
+			"TestNFT (%s)\n", account, balance, t.Address)
+	case "ownerof":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		tokenId, err1 := utils.ParseTokenID(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		owner, err2 := t.Instance.OwnerOf(opts, tokenId)
+		if err2 != nil {
+			return err2
+		}
+		t.OwnerOf[tokenId.String()] = owner
+		t.StrToPrint = fmt.Sprintf("info: Owner of token %d : %s for TestNFT "+
+			"(%s)\n", tokenId, owner, t.Address)
+	case "tokenuri":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		tokenId, err1 := utils.ParseTokenID(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		uri, err2 := t.Instance.TokenURI(opts, tokenId)
+		if err2 != nil {
+			return err2
+		}
+		t.TokenURI[tokenId.String()] = uri
+		t.StrToPrint = fmt.Sprintf("info: Token URI of token %d : %q for "+
+			"TestNFT (%s)\n", tokenId, uri, t.Address)
+	case "getapproved":
+		err := utils.ValidateLength(&funcArgs, 1)
+		if err != nil {
+			return err
+		}
+		tokenId, err1 := utils.ParseTokenID(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		approved, err2 := t.Instance.GetApproved(opts, tokenId)
+		if err2 != nil {
+			return err2
+		}
+		t.GetApproved[tokenId.String()] = approved
+		t.StrToPrint = fmt.Sprintf("info: Approved address of token %d : %s "+
+			"for TestNFT (%s)\n", tokenId, approved, t.Address)
+	case "isapprovedforall":
+		err := utils.ValidateLength(&funcArgs, 2)
+		if err != nil {
+			return err
+		}
+		owner, err1 := t.ArgParser.ParseAddress(funcArgs[0])
+		if err1 != nil {
+			return err1
+		}
+		operator, err2 := t.ArgParser.ParseAddress(funcArgs[1])
+		if err2 != nil {
+			return err2
+		}
+		approved, err3 := t.Instance.IsApprovedForAll(opts, owner, operator)
+		if err3 != nil {
+			return err3
+		}
+		// Since this is a nested map of data we must check if `owner`
+		// has a map instantiated towards them, if not create one
+		if _, ok := t.IsApprovedForAll[owner]; !ok {
+			t.IsApprovedForAll[owner] = map[common.Address]bool{}
+		}
+		t.IsApprovedForAll[owner][operator] = approved
+		t.StrToPrint = fmt.Sprintf("info: Operator %s approved for all tokens "+
+			"of owner %s : %t for TestNFT (%s)\n", operator, owner, approved,
+			t.Address)
+	default:
+		return nil
+	}
+	return nil
+}
+
+// erc721ViewMethods maps the query function names which can be aggregated
+// to the names of the view functions in the contract ABI
+var erc721ViewMethods = map[string]string{
+	"name":      "name",
+	"symbol":    "symbol",
+	"owner":     "owner",
+	"balanceof": "balanceOf",
+	"ownerof":   "ownerOf",
+	"tokenuri":  "tokenURI",
+}
+
+// QueryContractMulticall executes several query functions of the TestNFT
+// contract atomically through the multicaller. The function arguments are
+// used by balanceof, one account each, or by ownerof and tokenuri, one
+// token id each, therefore only one kind of argument can be aggregated at
+// a time. Ids of tokens which don't exist make the whole aggregation fail.
+func (t *TestNFTContract) QueryContractMulticall(
+	opts *bind.CallOpts,
+	multicaller cc.Multicaller,
+	funcNames []string,
+	funcArgs []string,
+) error {
+	parsed, err := TestNFTMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	var calls []cc.ViewCall
+	argsFuncNames := []string{}
+	for _, funcName := range funcNames {
+		method, ok := erc721ViewMethods[funcName]
+		if !ok {
+			return fmt.Errorf("error: %s can't be aggregated", funcName)
+		}
+		if funcName != "balanceof" && funcName != "ownerof" &&
+			funcName != "tokenuri" {
+			calls = append(calls, cc.ViewCall{Target: t.Address, ABI: parsed,
+				Method: method})
+			continue
+		}
+		if len(argsFuncNames) != 0 &&
+			(funcName == "balanceof") != (argsFuncNames[0] == "balanceof") {
+			return fmt.Errorf("error: %s and %s can't be aggregated together, "+
+				"they take different arguments", argsFuncNames[0], funcName)
+		}
+		argsFuncNames = append(argsFuncNames, funcName)
+		if len(funcArgs) == 0 {
+			return fmt.Errorf("error: %s can't be aggregated without "+
+				"arguments", funcName)
+		}
+		for _, arg := range funcArgs {
+			var value interface{}
+			var err1 error
+			if funcName == "balanceof" {
+				value, err1 = t.ArgParser.ParseAddress(arg)
+			} else {
+				value, err1 = utils.ParseTokenID(arg)
+			}
+			if err1 != nil {
+				return err1
+			}
+			calls = append(calls, cc.ViewCall{Target: t.Address, ABI: parsed,
+				Method: method, Args: []interface{}{value}})
+		}
+	}
+	if len(argsFuncNames) == 0 {
+		err2 := utils.ValidateLength(&funcArgs, 0)
+		if err2 != nil {
+			return err2
+		}
+	}
+
+	blockNumber, outputs, err3 := multicaller.Aggregate(opts, calls)
+	if err3 != nil {
+		return err3
+	}
+	t.StrToPrint = fmt.Sprintf("info: Aggregated %d calls at block %d for "+
+		"TestNFT (%s)\n", len(calls), blockNumber, t.Address)
+	for i, call := range calls {
+		value := outputs[i][0]
+		switch call.Method {
+		case "name":
+			t.Name = value.(string)
+			t.StrToPrint += fmt.Sprintf("info: Token Name: %s\n", t.Name)
+		case "symbol":
+			t.Symbol = value.(string)
+			t.StrToPrint += fmt.Sprintf("info: Token Symbol: %s\n", t.Symbol)
+		case "owner":
+			t.Owner = value.(common.Address)
+			t.StrToPrint += fmt.Sprintf("info: Contract Owner: %s\n", t.Owner)
+		case "balanceOf":
+			account := call.Args[0].(common.Address)
+			t.BalanceOf[account] = value.(*big.Int)
+			t.StrToPrint += fmt.Sprintf("info: Token Balance of %s : %d\n",
+				account, t.BalanceOf[account])
+		case "ownerOf":
+			tokenId := call.Args[0].(*big.Int)
+			t.OwnerOf[tokenId.String()] = value.(common.Address)
+			t.StrToPrint += fmt.Sprintf("info: Owner of token %d : %s\n",
+				tokenId, t.OwnerOf[tokenId.String()])
+		case "tokenURI":
+			tokenId := call.Args[0].(*big.Int)
+			t.TokenURI[tokenId.String()] = value.(string)
+			t.StrToPrint += fmt.Sprintf("info: Token URI of token %d : %q\n",
+				tokenId, t.TokenURI[tokenId.String()])
+		}
+	}
+	return nil
+}
+
+// QueryEvents retrieves the Transfer, Approval, ApprovalForAll and
+// OwnershipTransferred events of the TestNFT contract within the block
+// range of the filter options. The filter addresses are matched against the
+// indexed event arguments and the decoded events are returned in the order
+// they were emitted.
+func (t *TestNFTContract) QueryEvents(
+	opts *bind.FilterOpts,
+	eventName string,
+	filter cc.EventFilter,
+) ([]cc.EventRecord, error) {
+	var records []cc.EventRecord
+	switch eventName {
+	case "transfer":
+		iterator, err := t.Instance.FilterTransfer(opts, filter.From,
+			filter.To, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, transferRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "approval":
+		iterator, err := t.Instance.FilterApproval(opts, filter.From,
+			filter.To, nil)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, approvalRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "approvalforall":
+		iterator, err := t.Instance.FilterApprovalForAll(opts, filter.From,
+			filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records, approvalForAllRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	case "ownershiptransferred":
+		iterator, err := t.Instance.FilterOwnershipTransferred(opts,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		defer iterator.Close()
+		for iterator.Next() {
+			records = append(records,
+				ownershipTransferredRecord(iterator.Event))
+		}
+		if iterator.Error() != nil {
+			return nil, iterator.Error()
+		}
+	default:
+		return nil, nil
+	}
+	return records, nil
+}
+
+// WatchEvents subscribes to the Transfer, Approval, ApprovalForAll or
+// OwnershipTransferred events of the TestNFT contract and forwards them
+// decoded to the sink. The returned subscription fails as soon as the
+// underlying log subscription does, so that the caller can resubscribe.
+func (t *TestNFTContract) WatchEvents(
+	opts *bind.WatchOpts,
+	eventName string,
+	filter cc.EventFilter,
+	sink chan<- cc.EventRecord,
+) (event.Subscription, error) {
+	switch eventName {
+	case "transfer":
+		events := make(chan *TestNFTTransfer)
+		sub, err := t.Instance.WatchTransfer(opts, events, filter.From,
+			filter.To, nil)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- transferRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "approval":
+		events := make(chan *TestNFTApproval)
+		sub, err := t.Instance.WatchApproval(opts, events, filter.From,
+			filter.To, nil)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- approvalRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "approvalforall":
+		events := make(chan *TestNFTApprovalForAll)
+		sub, err := t.Instance.WatchApprovalForAll(opts, events, filter.From,
+			filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- approvalForAllRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	case "ownershiptransferred":
+		events := make(chan *TestNFTOwnershipTransferred)
+		sub, err := t.Instance.WatchOwnershipTransferred(opts, events,
+			filter.From, filter.To)
+		if err != nil {
+			return nil, err
+		}
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			defer sub.Unsubscribe()
+			for {
+				select {
+				case ev := <-events:
+					select {
+					case sink <- ownershipTransferredRecord(ev):
+					case <-quit:
+						return nil
+					}
+				case err1 := <-sub.Err():
+					return err1
+				case <-quit:
+					return nil
+				}
+			}
+		}), nil
+	default:
+		return nil, fmt.Errorf("error: unsupported event %s", eventName)
+	}
+}
+
+// transferRecord converts a decoded Transfer event into an EventRecord
+func transferRecord(ev *TestNFTTransfer) cc.EventRecord {
+	return cc.NewEventRecord("Transfer", ev.Raw,
+		cc.EventArg{Name: "from", Value: ev.From.Hex()},
+		cc.EventArg{Name: "to", Value: ev.To.Hex()},
+		cc.EventArg{Name: "tokenId", Value: ev.TokenId.String()})
+}
+
+// approvalRecord converts a decoded Approval event into an EventRecord
+func approvalRecord(ev *TestNFTApproval) cc.EventRecord {
+	return cc.NewEventRecord("Approval", ev.Raw,
+		cc.EventArg{Name: "owner", Value: ev.Owner.Hex()},
+		cc.EventArg{Name: "approved", Value: ev.Approved.Hex()},
+		cc.EventArg{Name: "tokenId", Value: ev.TokenId.String()})
+}
+
+// approvalForAllRecord converts a decoded ApprovalForAll event into an
+// EventRecord
+func approvalForAllRecord(ev *TestNFTApprovalForAll) cc.EventRecord {
+	return cc.NewEventRecord("ApprovalForAll", ev.Raw,
+		cc.EventArg{Name: "owner", Value: ev.Owner.Hex()},
+		cc.EventArg{Name: "operator", Value: ev.Operator.Hex()},
+		cc.EventArg{Name: "approved", Value: strconv.FormatBool(ev.Approved)})
+}
+
+// ownershipTransferredRecord converts a decoded OwnershipTransferred
+// event into an EventRecord
+func ownershipTransferredRecord(
+	ev *TestNFTOwnershipTransferred) cc.EventRecord {
+	return cc.NewEventRecord("OwnershipTransferred", ev.Raw,
+		cc.EventArg{Name: "previousOwner", Value: ev.PreviousOwner.Hex()},
+		cc.EventArg{Name: "newOwner", Value: ev.NewOwner.Hex()})
+}
@@ -0,0 +1,777 @@
+package test_nft
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	cc "go-evm-client/internal/contracts_template_interface"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+type MockContractInstance struct {
+	mock.Mock
+}
+
+func (m *MockContractInstance) Mint(
+	opts *bind.TransactOpts,
+	to common.Address,
+	tokenId *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, to, tokenId)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) Approve(
+	opts *bind.TransactOpts,
+	to common.Address,
+	tokenId *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, to, tokenId)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SetApprovalForAll(
+	opts *bind.TransactOpts,
+	operator common.Address,
+	approved bool,
+) (*types.Transaction, error) {
+	args := m.Called(opts, operator, approved)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) TransferFrom(
+	opts *bind.TransactOpts,
+	from common.Address,
+	to common.Address,
+	tokenId *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, from, to, tokenId)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SafeTransferFrom(
+	opts *bind.TransactOpts,
+	from common.Address,
+	to common.Address,
+	tokenId *big.Int,
+) (*types.Transaction, error) {
+	args := m.Called(opts, from, to, tokenId)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) SafeTransferFrom0(
+	opts *bind.TransactOpts,
+	from common.Address,
+	to common.Address,
+	tokenId *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	args := m.Called(opts, from, to, tokenId, data)
+	return (args.Get(0)).(*types.Transaction), args.Error(1)
+}
+
+func (m *MockContractInstance) Name(_ *bind.CallOpts) (string, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(string), args.Error(1)
+}
+
+func (m *MockContractInstance) Symbol(_ *bind.CallOpts) (string, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(string), args.Error(1)
+}
+
+func (m *MockContractInstance) BalanceOf(
+	_ *bind.CallOpts,
+	owner common.Address,
+) (*big.Int, error) {
+	args := m.Called(nil, owner)
+	return (args.Get(0)).(*big.Int), args.Error(1)
+}
+
+func (m *MockContractInstance) OwnerOf(
+	_ *bind.CallOpts,
+	tokenId *big.Int,
+) (common.Address, error) {
+	args := m.Called(nil, tokenId)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
+func (m *MockContractInstance) TokenURI(
+	_ *bind.CallOpts,
+	tokenId *big.Int,
+) (string, error) {
+	args := m.Called(nil, tokenId)
+	return (args.Get(0)).(string), args.Error(1)
+}
+
+func (m *MockContractInstance) GetApproved(
+	_ *bind.CallOpts,
+	tokenId *big.Int,
+) (common.Address, error) {
+	args := m.Called(nil, tokenId)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
+func (m *MockContractInstance) IsApprovedForAll(
+	_ *bind.CallOpts,
+	owner common.Address,
+	operator common.Address,
+) (bool, error) {
+	args := m.Called(nil, owner, operator)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockContractInstance) Owner(_ *bind.CallOpts) (common.Address, error) {
+	args := m.Called(nil)
+	return (args.Get(0)).(common.Address), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterTransfer(
+	opts *bind.FilterOpts,
+	from []common.Address,
+	to []common.Address,
+	tokenId []*big.Int,
+) (*TestNFTTransferIterator, error) {
+	args := m.Called(opts, from, to, tokenId)
+	return (args.Get(0)).(*TestNFTTransferIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterApproval(
+	opts *bind.FilterOpts,
+	owner []common.Address,
+	approved []common.Address,
+	tokenId []*big.Int,
+) (*TestNFTApprovalIterator, error) {
+	args := m.Called(opts, owner, approved, tokenId)
+	return (args.Get(0)).(*TestNFTApprovalIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterApprovalForAll(
+	opts *bind.FilterOpts,
+	owner []common.Address,
+	operator []common.Address,
+) (*TestNFTApprovalForAllIterator, error) {
+	args := m.Called(opts, owner, operator)
+	return (args.Get(0)).(*TestNFTApprovalForAllIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) FilterOwnershipTransferred(
+	opts *bind.FilterOpts,
+	previousOwner []common.Address,
+	newOwner []common.Address,
+) (*TestNFTOwnershipTransferredIterator, error) {
+	args := m.Called(opts, previousOwner, newOwner)
+	return (args.Get(0)).(*TestNFTOwnershipTransferredIterator), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchTransfer(
+	opts *bind.WatchOpts,
+	sink chan<- *TestNFTTransfer,
+	from []common.Address,
+	to []common.Address,
+	tokenId []*big.Int,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, from, to, tokenId)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchApproval(
+	opts *bind.WatchOpts,
+	sink chan<- *TestNFTApproval,
+	owner []common.Address,
+	approved []common.Address,
+	tokenId []*big.Int,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, owner, approved, tokenId)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchApprovalForAll(
+	opts *bind.WatchOpts,
+	sink chan<- *TestNFTApprovalForAll,
+	owner []common.Address,
+	operator []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, owner, operator)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+func (m *MockContractInstance) WatchOwnershipTransferred(
+	opts *bind.WatchOpts,
+	sink chan<- *TestNFTOwnershipTransferred,
+	previousOwner []common.Address,
+	newOwner []common.Address,
+) (event.Subscription, error) {
+	args := m.Called(opts, sink, previousOwner, newOwner)
+	return (args.Get(0)).(event.Subscription), args.Error(1)
+}
+
+// newTransferIterator creates an iterator which returns the given
+// Transfer logs as if they were retrieved from the node
+func newTransferIterator(t *testing.T, logs ...types.Log) *TestNFTTransferIterator {
+	parsed, err := abi.JSON(strings.NewReader(TestNFTABI))
+	assert.NoError(t, err)
+	logsChan := make(chan types.Log, len(logs))
+	for _, log := range logs {
+		logsChan <- log
+	}
+	return &TestNFTTransferIterator{
+		contract: bind.NewBoundContract(common.Address{}, parsed, nil, nil, nil),
+		event:    "Transfer",
+		logs:     logsChan,
+		sub: event.NewSubscription(func(quit <-chan struct{}) error {
+			<-quit
+			return nil
+		}),
+		done: true,
+	}
+}
+
+const (
+	holderAddress   = "0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"
+	operatorAddress = "0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"
+)
+
+func TestParseConstructorArguments(t *testing.T) {
+	tests := []struct {
+		testName      string
+		contractArgs  []string
+		expectedArgs  contractConstructorArgs
+		expectedError error
+	}{
+		{
+			testName:     "ParseConstructorArguments successful.",
+			contractArgs: []string{"MintSwapNFT", "MSN", "https://example.com/"},
+			expectedArgs: contractConstructorArgs{
+				name:    "MintSwapNFT",
+				symbol:  "MSN",
+				baseURI: "https://example.com/",
+			},
+		},
+		{
+			testName:     "ParseConstructorArguments empty base URI.",
+			contractArgs: []string{"MintSwapNFT", "MSN", ""},
+			expectedArgs: contractConstructorArgs{
+				name:   "MintSwapNFT",
+				symbol: "MSN",
+			},
+		},
+		{
+			testName:     "ParseConstructorArguments fail missing base URI.",
+			contractArgs: []string{"MintSwapNFT", "MSN"},
+			expectedError: errors.New("error: incorrect amount of arguments, " +
+				"args needed : 3 != args received 2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			nft := TestNFTContract{}
+			err := nft.ParseConstructorArguments(tt.contractArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, nft.ConstructorArgs, tt.expectedArgs)
+		})
+	}
+}
+
+func TestWriteContractMintMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		tokenId       *big.Int
+		strToPrint    string
+		instanceError error
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName: "WriteContract func Mint successful all data returned.",
+			funcArgs: []string{holderAddress, "42"},
+			tokenId:  big.NewInt(42),
+			strToPrint: "info: Minted token 42 at TestNFT " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				holderAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func Mint hex token id.",
+			funcArgs: []string{holderAddress, "0x2a"},
+			tokenId:  big.NewInt(42),
+			strToPrint: "info: Minted token 42 at TestNFT " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				holderAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func Mint fail negative token id.",
+			funcArgs: []string{holderAddress, "-1"},
+			expectedError: errors.New("error: \"-1\" is not a valid token " +
+				"id"),
+		},
+		{
+			testName: "WriteContract func Mint fail token id out of range.",
+			funcArgs: []string{holderAddress, "0x1" + strings.Repeat("0", 64)},
+			expectedError: errors.New("error: token id \"0x1" +
+				strings.Repeat("0", 64) + "\" exceeds the maximum uint256 " +
+				"value"),
+		},
+		{
+			testName: "WriteContract func Mint fail zero address.",
+			funcArgs: []string{"0x0000000000000000000000000000000000000000",
+				"42"},
+			expectedError: errors.New("error: refusing to use the zero " +
+				"address as recipient, use --force to override"),
+		},
+		{
+			testName: "WriteContract func Mint fail arg len validation.",
+			funcArgs: []string{holderAddress},
+			expectedError: errors.New("error: 1 arguments does not match " +
+				"required 2"),
+		},
+		{
+			testName:      "WriteContract func Mint instance failure.",
+			funcArgs:      []string{holderAddress, "42"},
+			tokenId:       big.NewInt(42),
+			instanceError: errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			mInstance := new(MockContractInstance)
+			mInstance.On("Mint", auth, common.HexToAddress(holderAddress),
+				tt.tokenId).Return(tt.expectedTx, tt.instanceError)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			err := nft.WriteContract(auth, "mint", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, nft.LastTx, tt.expectedTx)
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestWriteContractSetApprovalForAllMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		approved      bool
+		strToPrint    string
+		expectedError error
+		expectedTx    *types.Transaction
+	}{
+		{
+			testName: "WriteContract func SetApprovalForAll approve.",
+			funcArgs: []string{operatorAddress, "true"},
+			approved: true,
+			strToPrint: "info: Set approval for all tokens of operator " +
+				operatorAddress + " to true at TestNFT " +
+				"(0x0000000000000000000000000000000000000000)\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SetApprovalForAll revoke.",
+			funcArgs: []string{operatorAddress, "false"},
+			approved: false,
+			strToPrint: "info: Set approval for all tokens of operator " +
+				operatorAddress + " to false at TestNFT " +
+				"(0x0000000000000000000000000000000000000000)\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SetApprovalForAll fail invalid bool.",
+			funcArgs: []string{operatorAddress, "yes"},
+			expectedError: errors.New("error: \"yes\" is not a valid " +
+				"boolean"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			mInstance := new(MockContractInstance)
+			mInstance.On("SetApprovalForAll", auth,
+				common.HexToAddress(operatorAddress), tt.approved).Return(
+				tt.expectedTx, nil)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			err := nft.WriteContract(auth, "setapprovalforall", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, nft.LastTx, tt.expectedTx)
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestWriteContractSafeTransferFromMethod(t *testing.T) {
+	from := common.HexToAddress(holderAddress)
+	to := common.HexToAddress(operatorAddress)
+	tests := []struct {
+		testName       string
+		funcArgs       []string
+		expectedMethod string
+		strToPrint     string
+		expectedError  error
+		expectedTx     *types.Transaction
+	}{
+		{
+			testName:       "WriteContract func SafeTransferFrom without data.",
+			funcArgs:       []string{holderAddress, operatorAddress, "7"},
+			expectedMethod: "SafeTransferFrom",
+			strToPrint: "info: Safely Transferred From " + holderAddress +
+				" token 7 at TestNFT " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				operatorAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom with data.",
+			funcArgs: []string{holderAddress, operatorAddress, "7",
+				"0xc0ffee"},
+			expectedMethod: "SafeTransferFrom0",
+			strToPrint: "info: Safely Transferred From " + holderAddress +
+				" token 7 at TestNFT " +
+				"(0x0000000000000000000000000000000000000000) to address " +
+				operatorAddress + "\n",
+			expectedTx: &types.Transaction{},
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom fail invalid data.",
+			funcArgs: []string{holderAddress, operatorAddress, "7", "c0ffee"},
+			expectedError: errors.New("error: \"c0ffee\" is not valid hex " +
+				"data"),
+		},
+		{
+			testName: "WriteContract func SafeTransferFrom fail arg len " +
+				"validation.",
+			funcArgs: []string{holderAddress, operatorAddress},
+			expectedError: errors.New("error: 2 arguments does not match " +
+				"required 3"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			auth := &bind.TransactOpts{}
+			mInstance := new(MockContractInstance)
+			mInstance.On("SafeTransferFrom", auth, from, to,
+				big.NewInt(7)).Return(tt.expectedTx, nil)
+			mInstance.On("SafeTransferFrom0", auth, from, to, big.NewInt(7),
+				[]byte{0xc0, 0xff, 0xee}).Return(tt.expectedTx, nil)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			err := nft.WriteContract(auth, "safetransferfrom", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				mInstance.AssertNumberOfCalls(t, tt.expectedMethod, 1)
+			}
+			assert.Equal(t, nft.LastTx, tt.expectedTx)
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestQueryContractOwnerOfMethod(t *testing.T) {
+	tests := []struct {
+		testName      string
+		funcArgs      []string
+		owner         common.Address
+		instanceError error
+		strToPrint    string
+		expectedError error
+	}{
+		{
+			testName: "QueryContract func OwnerOf successful.",
+			funcArgs: []string{"7"},
+			owner:    common.HexToAddress(holderAddress),
+			strToPrint: "info: Owner of token 7 : " + holderAddress +
+				" for TestNFT (0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName:      "QueryContract func OwnerOf fail invalid token id.",
+			funcArgs:      []string{"seven"},
+			expectedError: errors.New("error: \"seven\" is not a valid token id"),
+		},
+		{
+			testName: "QueryContract func OwnerOf nonexistent token.",
+			funcArgs: []string{"7"},
+			instanceError: errors.New("execution reverted: ERC721: owner " +
+				"query for nonexistent token"),
+			expectedError: errors.New("execution reverted: ERC721: owner " +
+				"query for nonexistent token"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("OwnerOf", nil, big.NewInt(7)).Return(tt.owner,
+				tt.instanceError)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			nft.OwnerOf = map[string]common.Address{}
+			err := nft.QueryContract(nil, "ownerof", tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, nft.OwnerOf["7"], tt.owner)
+			}
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+func TestQueryContractTokenURIMethod(t *testing.T) {
+	tests := []struct {
+		testName   string
+		uri        string
+		strToPrint string
+	}{
+		{
+			testName: "QueryContract func TokenURI successful.",
+			uri:      "https://example.com/7",
+			strToPrint: "info: Token URI of token 7 : \"https://example.com/7\" " +
+				"for TestNFT (0x0000000000000000000000000000000000000000)\n",
+		},
+		{
+			testName: "QueryContract func TokenURI without base URI.",
+			uri:      "",
+			strToPrint: "info: Token URI of token 7 : \"\" for TestNFT " +
+				"(0x0000000000000000000000000000000000000000)\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mInstance := new(MockContractInstance)
+			mInstance.On("TokenURI", nil, big.NewInt(7)).Return(tt.uri, nil)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			nft.TokenURI = map[string]string{}
+			err := nft.QueryContract(nil, "tokenuri", []string{"7"})
+			assert.NoError(t, err)
+			assert.Equal(t, nft.TokenURI["7"], tt.uri)
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+		})
+	}
+}
+
+type MockMulticaller struct {
+	mock.Mock
+}
+
+func (m *MockMulticaller) Aggregate(
+	opts *bind.CallOpts,
+	calls []cc.ViewCall,
+) (*big.Int, [][]interface{}, error) {
+	methods := make([]string, len(calls))
+	for i, call := range calls {
+		methods[i] = call.Method
+	}
+	args := m.Called(opts, methods)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*big.Int), args.Get(1).([][]interface{}), args.Error(2)
+}
+
+func TestQueryContractMulticallMethod(t *testing.T) {
+	tests := []struct {
+		testName        string
+		funcNames       []string
+		funcArgs        []string
+		expectedMethods []string
+		outputs         [][]interface{}
+		strToPrint      string
+		expectedError   error
+	}{
+		{
+			testName:        "QueryContractMulticall owners and URIs successful.",
+			funcNames:       []string{"name", "ownerof", "tokenuri"},
+			funcArgs:        []string{"1", "2"},
+			expectedMethods: []string{"name", "ownerOf", "ownerOf", "tokenURI", "tokenURI"},
+			outputs: [][]interface{}{{"MintSwapNFT"},
+				{common.HexToAddress(holderAddress)},
+				{common.HexToAddress(operatorAddress)},
+				{"https://example.com/1"}, {"https://example.com/2"}},
+			strToPrint: "info: Aggregated 5 calls at block 10 for TestNFT " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Name: MintSwapNFT\n" +
+				"info: Owner of token 1 : " + holderAddress + "\n" +
+				"info: Owner of token 2 : " + operatorAddress + "\n" +
+				"info: Token URI of token 1 : \"https://example.com/1\"\n" +
+				"info: Token URI of token 2 : \"https://example.com/2\"\n",
+		},
+		{
+			testName:        "QueryContractMulticall balanceOf many holders successful.",
+			funcNames:       []string{"balanceof"},
+			funcArgs:        []string{holderAddress, operatorAddress},
+			expectedMethods: []string{"balanceOf", "balanceOf"},
+			outputs:         [][]interface{}{{big.NewInt(3)}, {big.NewInt(0)}},
+			strToPrint: "info: Aggregated 2 calls at block 10 for TestNFT " +
+				"(0x0000000000000000000000000000000000000000)\n" +
+				"info: Token Balance of " + holderAddress + " : 3\n" +
+				"info: Token Balance of " + operatorAddress + " : 0\n",
+		},
+		{
+			testName:  "QueryContractMulticall fail balanceOf with ownerOf.",
+			funcNames: []string{"balanceof", "ownerof"},
+			funcArgs:  []string{holderAddress},
+			expectedError: errors.New("error: balanceof and ownerof can't be " +
+				"aggregated together, they take different arguments"),
+		},
+		{
+			testName:      "QueryContractMulticall fail not aggregatable.",
+			funcNames:     []string{"getapproved"},
+			funcArgs:      []string{"1"},
+			expectedError: errors.New("error: getapproved can't be aggregated"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			multicaller := new(MockMulticaller)
+			multicaller.On("Aggregate", (*bind.CallOpts)(nil),
+				tt.expectedMethods).Return(big.NewInt(10), tt.outputs, nil)
+			nft := TestNFTContract{}
+			nft.BalanceOf = map[common.Address]*big.Int{}
+			nft.OwnerOf = map[string]common.Address{}
+			nft.TokenURI = map[string]string{}
+			err := nft.QueryContractMulticall(nil, multicaller, tt.funcNames,
+				tt.funcArgs)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, nft.StrToPrint, tt.strToPrint)
+			multicaller.AssertExpectations(t)
+		})
+	}
+}
+
+func TestQueryEventsTransferMethod(t *testing.T) {
+	from := common.HexToAddress(holderAddress)
+	to := common.HexToAddress(operatorAddress)
+	transferLog := types.Log{
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+			common.BigToHash(big.NewInt(42)),
+		},
+		BlockNumber: 120,
+		TxHash:      common.HexToHash("0x01"),
+		Index:       3,
+	}
+	end := uint64(200)
+	tests := []struct {
+		testName        string
+		logs            []types.Log
+		filterError     error
+		expectedError   error
+		expectedRecords []string
+	}{
+		{
+			testName: "QueryEvents func Transfer successful all data returned.",
+			logs:     []types.Log{transferLog},
+			expectedRecords: []string{"Transfer block=120 tx=" +
+				"0x0000000000000000000000000000000000000000000000000000000000000001 " +
+				"logIndex=3 from=" + holderAddress + " to=" + operatorAddress +
+				" tokenId=42"},
+		},
+		{
+			testName:        "QueryEvents func Transfer no events.",
+			logs:            []types.Log{},
+			expectedRecords: []string{},
+		},
+		{
+			testName:      "QueryEvents func Transfer instance failure.",
+			filterError:   errors.New("error: something bad happened"),
+			expectedError: errors.New("error: something bad happened"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			opts := &bind.FilterOpts{Start: 100, End: &end}
+			filter := cc.EventFilter{From: []common.Address{from}}
+			mInstance := new(MockContractInstance)
+			mInstance.On("FilterTransfer", opts, filter.From, filter.To,
+				([]*big.Int)(nil)).Return(newTransferIterator(t, tt.logs...),
+				tt.filterError)
+			nft := TestNFTContract{}
+			nft.Instance = mInstance
+			records, err := nft.QueryEvents(opts, "transfer", filter)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, records)
+				return
+			}
+			assert.NoError(t, err)
+			printed := []string{}
+			for _, record := range records {
+				printed = append(printed, record.String())
+			}
+			assert.Equal(t, printed, tt.expectedRecords)
+		})
+	}
+}
+
+func TestDeployContractSimulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+
+	nft := TestNFTContract{}
+	err1 := nft.ParseConstructorArguments([]string{"Test NFT", "TNFT",
+		"https://example.com/"})
+	assert.NoError(t, err1)
+	err2 := nft.DeployContract(auth, client)
+	assert.NoError(t, err2)
+	nft.BalanceOf = map[common.Address]*big.Int{}
+	nft.OwnerOf = map[string]common.Address{}
+	nft.TokenURI = map[string]string{}
+
+	// The deployer owns the contract and mints to itself
+	err3 := nft.QueryContract(nil, "owner", []string{})
+	assert.NoError(t, err3)
+	assert.Equal(t, nft.Owner, auth.From)
+	err4 := nft.WriteContract(auth, "mint", []string{auth.From.Hex(), "7"})
+	assert.NoError(t, err4)
+	err5 := nft.QueryContract(nil, "tokenuri", []string{"7"})
+	assert.NoError(t, err5)
+	assert.Equal(t, nft.TokenURI["7"], "https://example.com/7")
+
+	err6 := nft.WriteContract(auth, "transferfrom", []string{
+		auth.From.Hex(), holderAddress, "7"})
+	assert.NoError(t, err6)
+	err7 := nft.QueryContract(nil, "ownerof", []string{"7"})
+	assert.NoError(t, err7)
+	assert.Equal(t, nft.OwnerOf["7"], common.HexToAddress(holderAddress))
+	err8 := nft.QueryContract(nil, "balanceof", []string{auth.From.Hex()})
+	assert.NoError(t, err8)
+	assert.Equal(t, nft.BalanceOf[auth.From].Sign(), 0)
+	err9 := nft.QueryContract(nil, "balanceof", []string{holderAddress})
+	assert.NoError(t, err9)
+	assert.Equal(t, nft.BalanceOf[common.HexToAddress(holderAddress)],
+		big.NewInt(1))
+}
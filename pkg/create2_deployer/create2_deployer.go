@@ -0,0 +1,172 @@
+package create2_deployer
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go-evm-client/pkg/eth_rpc_client"
+	"math/big"
+	"strings"
+)
+
+// FactoryAddress is the address of the deterministic deployment proxy, the
+// CREATE2 factory found at the same address on most chains. It deploys the
+// init code following a 32 byte salt in the call data.
+const FactoryAddress = "0x4e59b44847b379578588920cA78FbF26c0B4956C"
+
+// factorySigner is the keyless account of the presigned factory deployment,
+// nobody knows its private key so it can only ever send that transaction
+const factorySigner = "0x3fAB184622Dc19b6109349B94811493BF2a45362"
+
+// factoryDeploymentTx is the presigned factory deployment, it is signed
+// without a chain id so that it is valid on every chain
+const factoryDeploymentTx = "0xf8a58085174876e800830186a08080b853604580600e6" +
+	"00039806000f350fe7fffffffffffffffffffffffffffffffffffffffffffffffffff" +
+	"ffffffffffffe03601600081602082378035828234f58015156039578182fd5b80825" +
+	"25050506014600cf31ba022222222222222222222222222222222222222222222222" +
+	"22222222222222222a0222222222222222222222222222222222222222222222222222" +
+	"2222222222222"
+
+// Create2Deployer deploys contracts through the CREATE2 factory, the
+// address of a deployment only depends on the factory, the salt and the
+// init code so it is the same on every chain
+type Create2Deployer struct {
+	Factory common.Address
+	Salt    [32]byte
+	client  eth_rpc_client.IEthClient
+}
+
+// NewCreate2Deployer creates a deployer using the factory at its standard
+// address and the given salt
+func NewCreate2Deployer(
+	client eth_rpc_client.IEthClient,
+	salt [32]byte,
+) *Create2Deployer {
+	return &Create2Deployer{
+		Factory: common.HexToAddress(FactoryAddress),
+		Salt:    salt,
+		client:  client,
+	}
+}
+
+// ParseSalt converts the salt flag to the 32 byte salt, hex values are
+// left padded while any other text is hashed
+func ParseSalt(salt string) ([32]byte, error) {
+	var parsed [32]byte
+	if strings.HasPrefix(salt, "0x") || strings.HasPrefix(salt, "0X") {
+		value := salt[2:]
+		if len(value) == 0 || len(value) > 64 || len(value)%2 != 0 ||
+			!isHex(value) {
+			return parsed, fmt.Errorf("error: %q is not a valid salt, hex "+
+				"salts need an even number of at most 64 hex digits", salt)
+		}
+		copy(parsed[:], common.LeftPadBytes(common.FromHex(salt), 32))
+		return parsed, nil
+	}
+	if len(salt) == 0 {
+		return parsed, fmt.Errorf("error: the salt can't be empty")
+	}
+	copy(parsed[:], crypto.Keccak256([]byte(salt)))
+	return parsed, nil
+}
+
+// isHex checks that the string only contains hex digits
+func isHex(value string) bool {
+	for _, c := range value {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ComputeAddress predicts the address the init code is deployed at
+func (d *Create2Deployer) ComputeAddress(initCode []byte) common.Address {
+	return crypto.CreateAddress2(d.Factory, d.Salt, crypto.Keccak256(initCode))
+}
+
+// Deploy sends the init code to the factory and returns the address the
+// contract will be deployed at once the transaction is mined
+func (d *Create2Deployer) Deploy(
+	auth *bind.TransactOpts,
+	initCode []byte,
+) (common.Address, *types.Transaction, error) {
+	factory := bind.NewBoundContract(d.Factory, abi.ABI{}, nil, d.client,
+		nil)
+	tx, err := factory.RawTransact(auth, append(d.Salt[:], initCode...))
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return d.ComputeAddress(initCode), tx, nil
+}
+
+// EnsureFactory deploys the factory when it is missing on the chain. The
+// keyless signer of the presigned deployment is first funded with its gas
+// by the account of the options, whose nonce is then incremented, and both
+// transactions are waited for.
+func (d *Create2Deployer) EnsureFactory(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+) error {
+	code, err := d.client.CodeAt(ctx, d.Factory, nil)
+	if err != nil {
+		return err
+	}
+	if len(code) != 0 {
+		return nil
+	}
+	deployment := new(types.Transaction)
+	err1 := deployment.UnmarshalBinary(common.FromHex(factoryDeploymentTx))
+	if err1 != nil {
+		return err1
+	}
+	signer := common.HexToAddress(factorySigner)
+	cost := new(big.Int).Mul(deployment.GasPrice(),
+		new(big.Int).SetUint64(deployment.Gas()))
+	balance, err2 := d.client.BalanceAt(ctx, signer, nil)
+	if err2 != nil {
+		return err2
+	}
+	fmt.Printf("info: CREATE2 factory missing at %s, deploying it\n",
+		d.Factory.Hex())
+	if balance.Cmp(cost) < 0 {
+		fundOpts := *auth
+		fundOpts.Value = new(big.Int).Sub(cost, balance)
+		fundOpts.GasLimit = 21000
+		fundOpts.Context = ctx
+		funding, err3 := bind.NewBoundContract(signer, abi.ABI{}, nil,
+			d.client, nil).Transfer(&fundOpts)
+		if err3 != nil {
+			return fmt.Errorf("error: failed to fund the factory deployer "+
+				"%s: %v", signer.Hex(), err3)
+		}
+		if auth.Nonce != nil {
+			auth.Nonce = new(big.Int).Add(auth.Nonce, big.NewInt(1))
+		}
+		receipt, err4 := bind.WaitMined(ctx, d.client, funding)
+		if err4 != nil {
+			return err4
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("error: funding the factory deployer failed "+
+				"in transaction %s", funding.Hash().Hex())
+		}
+	}
+	err5 := d.client.SendTransaction(ctx, deployment)
+	if err5 != nil {
+		return fmt.Errorf("error: failed to deploy the CREATE2 factory, the "+
+			"node may refuse transactions without replay protection: %v",
+			err5)
+	}
+	address, err6 := bind.WaitDeployed(ctx, d.client, deployment)
+	if err6 != nil {
+		return err6
+	}
+	fmt.Printf("info: CREATE2 factory deployed at %s, see transaction here "+
+		"%s\n", address.Hex(), deployment.Hash().Hex())
+	return nil
+}
@@ -0,0 +1,162 @@
+package create2_deployer
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	cc "go-evm-client/internal/contracts_template_interface"
+	ftt "go-evm-client/pkg/contracts/fast_test_token"
+	"go-evm-client/pkg/simulated_client"
+	"math/big"
+	"testing"
+)
+
+func TestCreate2DeployerComputeAddress(t *testing.T) {
+	tests := []struct {
+		testName        string
+		factory         string
+		salt            string
+		initCode        string
+		expectedAddress string
+	}{
+		{
+			testName:        "ComputeAddress zero factory and salt.",
+			factory:         "0x0000000000000000000000000000000000000000",
+			salt:            "0x00",
+			initCode:        "0x00",
+			expectedAddress: "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38",
+		},
+		{
+			testName:        "ComputeAddress zero salt.",
+			factory:         "0xdeadbeef00000000000000000000000000000000",
+			salt:            "0x00",
+			initCode:        "0x00",
+			expectedAddress: "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3",
+		},
+		{
+			testName:        "ComputeAddress padded salt.",
+			factory:         "0xdeadbeef00000000000000000000000000000000",
+			salt:            "0x000000000000000000000000feed000000000000000000000000000000000000",
+			initCode:        "0x00",
+			expectedAddress: "0xD04116cDd17beBE565EB2422F2497E06cC1C9833",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			salt, err := ParseSalt(tt.salt)
+			assert.NoError(t, err)
+			deployer := NewCreate2Deployer(nil, salt)
+			deployer.Factory = common.HexToAddress(tt.factory)
+			address := deployer.ComputeAddress(common.FromHex(tt.initCode))
+			assert.Equal(t, address.Hex(), tt.expectedAddress)
+		})
+	}
+}
+
+func TestParseSalt(t *testing.T) {
+	tests := []struct {
+		testName      string
+		salt          string
+		expectedSalt  common.Hash
+		expectedError error
+	}{
+		{
+			testName:     "ParseSalt hex value left padded.",
+			salt:         "0x01",
+			expectedSalt: common.HexToHash("0x01"),
+		},
+		{
+			testName:     "ParseSalt text hashed.",
+			salt:         "mysalt",
+			expectedSalt: crypto.Keccak256Hash([]byte("mysalt")),
+		},
+		{
+			testName: "ParseSalt fail hex too long.",
+			salt:     "0x" + common.Bytes2Hex(make([]byte, 33)),
+			expectedError: errors.New("error: \"0x" +
+				common.Bytes2Hex(make([]byte, 33)) + "\" is not a valid " +
+				"salt, hex salts need an even number of at most 64 hex digits"),
+		},
+		{
+			testName: "ParseSalt fail invalid hex.",
+			salt:     "0xzz",
+			expectedError: errors.New("error: \"0xzz\" is not a valid salt, " +
+				"hex salts need an even number of at most 64 hex digits"),
+		},
+		{
+			testName:      "ParseSalt fail empty.",
+			salt:          "",
+			expectedError: errors.New("error: the salt can't be empty"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			salt, err := ParseSalt(tt.salt)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, common.Hash(salt), tt.expectedSalt)
+		})
+	}
+}
+
+func TestFactoryDeploymentTx(t *testing.T) {
+	// The presigned deployment must recover the keyless signer, whose
+	// first contract is the factory
+	deployment := new(types.Transaction)
+	err := deployment.UnmarshalBinary(common.FromHex(factoryDeploymentTx))
+	assert.NoError(t, err)
+	signer, err1 := types.Sender(types.HomesteadSigner{}, deployment)
+	assert.NoError(t, err1)
+	assert.Equal(t, signer, common.HexToAddress(factorySigner))
+	assert.Equal(t, crypto.CreateAddress(signer, 0),
+		common.HexToAddress(FactoryAddress))
+}
+
+func TestDeployContractCreate2Simulated(t *testing.T) {
+	client, auth, err := simulated_client.NewSimulatedClient()
+	assert.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	owner := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+
+	// The keyless signer is funded and sends the presigned deployment
+	deployer := NewCreate2Deployer(client, [32]byte{0x01})
+	err1 := deployer.EnsureFactory(ctx, auth)
+	assert.NoError(t, err1)
+	factoryCode, err2 := client.CodeAt(ctx, deployer.Factory, nil)
+	assert.NoError(t, err2)
+	assert.NotEmpty(t, factoryCode)
+
+	token := &ftt.FastTestTokenContract{}
+	contract := cc.Contract{IContract: token}
+	err3 := contract.DeployContractCreate2([]string{owner.Hex()}, auth,
+		client, deployer)
+	assert.NoError(t, err3)
+	initCode, err4 := token.DeploymentCode()
+	assert.NoError(t, err4)
+	predicted := deployer.ComputeAddress(initCode)
+	assert.Equal(t, token.Address, predicted)
+	code, err5 := client.CodeAt(ctx, predicted, nil)
+	assert.NoError(t, err5)
+	assert.NotEmpty(t, code)
+
+	// The owner, not the factory, receives the supply
+	supply, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+	balance, err6 := token.Instance.BalanceOf(nil, owner)
+	assert.NoError(t, err6)
+	assert.Equal(t, balance, supply)
+
+	// A second deployment of the same init code loads the existing token
+	again := &ftt.FastTestTokenContract{}
+	contract = cc.Contract{IContract: again}
+	err7 := contract.DeployContractCreate2([]string{owner.Hex()}, auth,
+		client, deployer)
+	assert.NoError(t, err7)
+	assert.Equal(t, again.Address, predicted)
+}
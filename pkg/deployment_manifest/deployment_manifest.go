@@ -0,0 +1,116 @@
+package deployment_manifest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"os"
+	"time"
+)
+
+// Record is a line of the deployment manifest, it is written once the
+// transaction deploying a contract was sent
+type Record struct {
+	Time     time.Time      `json:"time"`
+	ChainId  uint64         `json:"chainId"`
+	Contract string         `json:"contract"`
+	Address  common.Address `json:"address"`
+	Deployer common.Address `json:"deployer"`
+	TxHash   common.Hash    `json:"txHash"`
+}
+
+// Manifest appends the contracts deployed on a chain to a JSON lines file
+// and finds them again, the file can be shared by several chains
+type Manifest struct {
+	path    string
+	chainId uint64
+}
+
+// NewManifest creates the manifest of the chain kept in the file at the
+// path, the file is created with the first record
+func NewManifest(path string, chainId uint64) *Manifest {
+	return &Manifest{path: path, chainId: chainId}
+}
+
+// Path returns the path of the manifest file
+func (m *Manifest) Path() string {
+	return m.path
+}
+
+// Append writes the deployment of a contract on the chain of the manifest
+// as a single line at the end of the file
+func (m *Manifest) Append(
+	contract string,
+	address common.Address,
+	deployer common.Address,
+	txHash common.Hash,
+) error {
+	line, err := json.Marshal(&Record{
+		Time:     time.Now().UTC(),
+		ChainId:  m.chainId,
+		Contract: contract,
+		Address:  address,
+		Deployer: deployer,
+		TxHash:   txHash,
+	})
+	if err != nil {
+		return fmt.Errorf("error: failed to encode the deployment record: %v",
+			err)
+	}
+	file, err1 := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0644)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to open the deployment manifest: %v",
+			err1)
+	}
+	defer file.Close()
+	_, err2 := file.Write(append(line, '\n'))
+	if err2 != nil {
+		return fmt.Errorf("error: failed to write the deployment manifest: %v",
+			err2)
+	}
+	return nil
+}
+
+// FindDeployment returns the hash of the transaction which last deployed
+// a contract at the address on the chain of the manifest, a missing file
+// holds no deployment
+func (m *Manifest) FindDeployment(
+	address common.Address,
+) (common.Hash, bool, error) {
+	file, err := os.Open(m.path)
+	if os.IsNotExist(err) {
+		return common.Hash{}, false, nil
+	}
+	if err != nil {
+		return common.Hash{}, false, fmt.Errorf("error: failed to open the "+
+			"deployment manifest: %v", err)
+	}
+	defer file.Close()
+	var txHash common.Hash
+	found := false
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := &Record{}
+		err1 := json.Unmarshal(scanner.Bytes(), record)
+		if err1 != nil {
+			return common.Hash{}, false, fmt.Errorf("error: line %d of the "+
+				"deployment manifest %s is corrupted: %v", line, m.path, err1)
+		}
+		// A later deployment at the same address, such as on a restarted
+		// development chain, replaces the earlier ones
+		if record.ChainId == m.chainId && record.Address == address {
+			txHash, found = record.TxHash, true
+		}
+	}
+	err2 := scanner.Err()
+	if err2 != nil {
+		return common.Hash{}, false, fmt.Errorf("error: failed to read the "+
+			"deployment manifest: %v", err2)
+	}
+	return txHash, found, nil
+}
@@ -0,0 +1,96 @@
+package deployment_manifest
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	deployer = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	token    = common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	other    = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+)
+
+// tempManifest creates a manifest of the chain in a temporary directory
+func tempManifest(t *testing.T, chainId uint64) (*Manifest, func()) {
+	dir, err := ioutil.TempDir("", "manifest")
+	assert.NoError(t, err)
+	return NewManifest(filepath.Join(dir, "deployments.jsonl"), chainId),
+		func() { os.RemoveAll(dir) }
+}
+
+func TestManifestFindDeployment(t *testing.T) {
+	manifest, cleanup := tempManifest(t, 1337)
+	defer cleanup()
+	_, found, err := manifest.FindDeployment(token)
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// The same address on another chain and a redeployment on a restarted
+	// chain are both recorded in the same file
+	otherChain := NewManifest(manifest.Path(), 1)
+	assert.NoError(t, otherChain.Append("fast_test_token", token, deployer,
+		common.HexToHash("0x01")))
+	assert.NoError(t, manifest.Append("fast_test_token", token, deployer,
+		common.HexToHash("0x02")))
+	assert.NoError(t, manifest.Append("multicall3", other, deployer,
+		common.HexToHash("0x03")))
+	assert.NoError(t, manifest.Append("fast_test_token", token, deployer,
+		common.HexToHash("0x04")))
+
+	tests := []struct {
+		testName      string
+		manifest      *Manifest
+		address       common.Address
+		expectedHash  common.Hash
+		expectedFound bool
+	}{
+		{
+			testName:      "FindDeployment latest deployment of the chain.",
+			manifest:      manifest,
+			address:       token,
+			expectedHash:  common.HexToHash("0x04"),
+			expectedFound: true,
+		},
+		{
+			testName:      "FindDeployment deployment of another chain.",
+			manifest:      otherChain,
+			address:       token,
+			expectedHash:  common.HexToHash("0x01"),
+			expectedFound: true,
+		},
+		{
+			testName:      "FindDeployment address not deployed on the chain.",
+			manifest:      otherChain,
+			address:       other,
+			expectedFound: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			txHash, found, err1 := tt.manifest.FindDeployment(tt.address)
+			assert.NoError(t, err1)
+			assert.Equal(t, tt.expectedFound, found)
+			assert.Equal(t, tt.expectedHash, txHash)
+		})
+	}
+}
+
+func TestManifestCorrupted(t *testing.T) {
+	manifest, cleanup := tempManifest(t, 1337)
+	defer cleanup()
+	assert.NoError(t, manifest.Append("fast_test_token", token, deployer,
+		common.HexToHash("0x01")))
+	file, err := os.OpenFile(manifest.Path(), os.O_APPEND|os.O_WRONLY, 0644)
+	assert.NoError(t, err)
+	_, err1 := file.WriteString("\n{\"chainId\": \n")
+	assert.NoError(t, err1)
+	file.Close()
+	_, _, err2 := manifest.FindDeployment(token)
+	assert.EqualError(t, err2, "error: line 3 of the deployment manifest "+
+		manifest.Path()+" is corrupted: unexpected end of JSON input")
+}
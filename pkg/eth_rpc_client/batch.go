@@ -0,0 +1,164 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+)
+
+// DefaultBatchSize is the number of requests sent in a single JSON-RPC
+// batch when no batch size is given, most nodes accept at least this many
+const DefaultBatchSize = 100
+
+// BatchCall sends the requests in JSON-RPC batches of at most batchSize
+// requests. The result or error of every request is stored in its batch
+// element, the returned error is only set when a whole batch failed.
+func (e *EthRpcClient) BatchCall(
+	ctx context.Context,
+	elems []rpc.BatchElem,
+	batchSize int,
+) error {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	for start := 0; start < len(elems); start += batchSize {
+		end := start + batchSize
+		if end > len(elems) {
+			end = len(elems)
+		}
+		err := e.RpcClient.BatchCallContext(ctx, elems[start:end])
+		if err != nil {
+			return fmt.Errorf("error: batch of requests %d to %d failed: %v",
+				start, end-1, err)
+		}
+	}
+	return nil
+}
+
+// BatchCallContract executes the calls with eth_call at the given block,
+// a nil block means latest. It returns the output and error of every call
+// in the order of the calls.
+func (e *EthRpcClient) BatchCallContract(
+	ctx context.Context,
+	msgs []ethereum.CallMsg,
+	blockNumber *big.Int,
+	batchSize int,
+) ([][]byte, []error, error) {
+	outputs := make([]hexutil.Bytes, len(msgs))
+	elems := make([]rpc.BatchElem, len(msgs))
+	for i, msg := range msgs {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{toCallArg(msg), toBlockNumArg(blockNumber)},
+			Result: &outputs[i],
+		}
+	}
+	err := e.BatchCall(ctx, elems, batchSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	results := make([][]byte, len(msgs))
+	errs := make([]error, len(msgs))
+	for i := range elems {
+		results[i], errs[i] = outputs[i], elems[i].Error
+	}
+	return results, errs, nil
+}
+
+// BatchBalanceAt retrieves the native balances of the accounts with
+// eth_getBalance at the given block, a nil block means latest
+func (e *EthRpcClient) BatchBalanceAt(
+	ctx context.Context,
+	accounts []common.Address,
+	blockNumber *big.Int,
+	batchSize int,
+) ([]*big.Int, []error, error) {
+	balances := make([]hexutil.Big, len(accounts))
+	elems := make([]rpc.BatchElem, len(accounts))
+	for i, account := range accounts {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getBalance",
+			Args:   []interface{}{account, toBlockNumArg(blockNumber)},
+			Result: &balances[i],
+		}
+	}
+	err := e.BatchCall(ctx, elems, batchSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	results := make([]*big.Int, len(accounts))
+	errs := make([]error, len(accounts))
+	for i := range elems {
+		errs[i] = elems[i].Error
+		if errs[i] == nil {
+			results[i] = balances[i].ToInt()
+		}
+	}
+	return results, errs, nil
+}
+
+// BatchTransactionReceipt retrieves the receipts of the transactions with
+// eth_getTransactionReceipt, the error of a transaction which isn't mined
+// yet is ethereum.NotFound
+func (e *EthRpcClient) BatchTransactionReceipt(
+	ctx context.Context,
+	hashes []common.Hash,
+	batchSize int,
+) ([]*types.Receipt, []error, error) {
+	receipts := make([]*types.Receipt, len(hashes))
+	elems := make([]rpc.BatchElem, len(hashes))
+	for i, hash := range hashes {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{hash},
+			Result: &receipts[i],
+		}
+	}
+	err := e.BatchCall(ctx, elems, batchSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	errs := make([]error, len(hashes))
+	for i := range elems {
+		errs[i] = elems[i].Error
+		if errs[i] == nil && receipts[i] == nil {
+			errs[i] = ethereum.NotFound
+		}
+	}
+	return receipts, errs, nil
+}
+
+// toCallArg converts a call message into the arguments of eth_call
+func toCallArg(msg ethereum.CallMsg) interface{} {
+	arg := map[string]interface{}{
+		"from": msg.From,
+		"to":   msg.To,
+	}
+	if len(msg.Data) > 0 {
+		arg["data"] = hexutil.Bytes(msg.Data)
+	}
+	if msg.Value != nil {
+		arg["value"] = (*hexutil.Big)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		arg["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil {
+		arg["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	return arg
+}
+
+// toBlockNumArg converts a block number into its JSON-RPC form, nil
+// meaning the latest block
+func toBlockNumArg(number *big.Int) string {
+	if number == nil {
+		return "latest"
+	}
+	return hexutil.EncodeBig(number)
+}
@@ -0,0 +1,115 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestEthRpcClientBatchBalanceAt(t *testing.T) {
+	accounts := []common.Address{
+		common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"),
+		common.HexToAddress("0xfd6f5A60D2D8b12039F906D112f10Fb66F881087"),
+		common.HexToAddress("0x0000000000000000000000000000000000000001"),
+	}
+	tests := []struct {
+		testName         string
+		batchSize        int
+		responses        map[string]string
+		batchError       error
+		expectedBatches  []int
+		expectedBalances []*big.Int
+		expectedErrors   []error
+		expectedError    error
+	}{
+		{
+			testName:  "BatchBalanceAt successful in two batches.",
+			batchSize: 2,
+			responses: map[string]string{
+				"eth_getBalance:" + accounts[0].Hex(): `"0x64"`,
+				"eth_getBalance:" + accounts[1].Hex(): `"0x0"`,
+				"eth_getBalance:" + accounts[2].Hex(): `"0xde0b6b3a7640000"`,
+			},
+			expectedBatches: []int{2, 1},
+			expectedBalances: []*big.Int{big.NewInt(100), big.NewInt(0),
+				big.NewInt(1000000000000000000)},
+			expectedErrors: []error{nil, nil, nil},
+		},
+		{
+			testName:  "BatchBalanceAt single request failure.",
+			batchSize: 0,
+			responses: map[string]string{
+				"eth_getBalance:" + accounts[0].Hex(): `"0x64"`,
+				"eth_getBalance:" + accounts[2].Hex(): `"0x1"`,
+			},
+			expectedBatches:  []int{3},
+			expectedBalances: []*big.Int{big.NewInt(100), nil, big.NewInt(1)},
+			expectedErrors: []error{nil, errors.New("error: eth_getBalance:" +
+				accounts[1].Hex() + " failed"), nil},
+		},
+		{
+			testName:        "BatchBalanceAt whole batch failure.",
+			batchSize:       2,
+			responses:       map[string]string{},
+			batchError:      errors.New("error: 413 Request Entity Too Large"),
+			expectedBatches: []int{2},
+			expectedError: errors.New("error: batch of requests 0 to 1 " +
+				"failed: error: 413 Request Entity Too Large"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			rpcClientConn := new(MockedRpcClient)
+			for _, size := range tt.expectedBatches {
+				rpcClientConn.On("BatchCallContext", context.Background(),
+					size).Return(tt.responses, tt.batchError).Once()
+			}
+			ethRpcClient := EthRpcClient{RpcClient: rpcClientConn}
+			balances, errs, err := ethRpcClient.BatchBalanceAt(
+				context.Background(), accounts, big.NewInt(10), tt.batchSize)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, balances)
+				return
+			}
+			assert.NoError(t, err)
+			for i, balance := range balances {
+				if tt.expectedBalances[i] == nil {
+					assert.Nil(t, balance)
+					continue
+				}
+				assert.Equal(t, balance.String(), tt.expectedBalances[i].String())
+			}
+			assert.Equal(t, errs, tt.expectedErrors)
+			rpcClientConn.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEthRpcClientBatchTransactionReceipt(t *testing.T) {
+	mined := common.HexToHash("0x01")
+	pending := common.HexToHash("0x02")
+	rpcClientConn := new(MockedRpcClient)
+	rpcClientConn.On("BatchCallContext", context.Background(), 2).Return(
+		map[string]string{
+			"eth_getTransactionReceipt:" + mined.Hex(): `{"status":"0x1",` +
+				`"cumulativeGasUsed":"0x5208","logs":[],"logsBloom":"0x` +
+				strings.Repeat("0", 512) + `","transactionHash":"` +
+				mined.Hex() + `","gasUsed":"0x5208","blockNumber":"0xa"}`,
+			"eth_getTransactionReceipt:" + pending.Hex(): `null`,
+		}, nil)
+	ethRpcClient := EthRpcClient{RpcClient: rpcClientConn}
+	receipts, errs, err := ethRpcClient.BatchTransactionReceipt(
+		context.Background(), []common.Hash{mined, pending}, 10)
+	assert.NoError(t, err)
+	assert.NoError(t, errs[0])
+	assert.Equal(t, receipts[0].BlockNumber, big.NewInt(10))
+	assert.Equal(t, receipts[0].Status, uint64(1))
+	assert.Equal(t, errs[1], ethereum.NotFound)
+	assert.Nil(t, receipts[1])
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	ea "go-evm-client/pkg/eth_account"
 	"math/big"
+	"strconv"
+	"strings"
 )
 
 // IEthClient is the interface specification to what is needed for
@@ -17,27 +22,110 @@ type IEthClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	BlockNumber(ctx context.Context) (uint64, error)
 	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	TransactionByHash(
+		ctx context.Context,
+		hash common.Hash,
+	) (tx *types.Transaction, isPending bool, err error)
+	TransactionReceipt(
+		ctx context.Context,
+		txHash common.Hash,
+	) (*types.Receipt, error)
+	BalanceAt(
+		ctx context.Context,
+		account common.Address,
+		blockNumber *big.Int,
+	) (*big.Int, error)
+	StorageAt(
+		ctx context.Context,
+		account common.Address,
+		key common.Hash,
+		blockNumber *big.Int,
+	) ([]byte, error)
 	Close()
 }
 
-// EthRpcClient contains the connected client as well
-// as the RawUrl for future use.
+// IRpcClient is the interface specification of the raw JSON-RPC client,
+// it is used for the requests that IEthClient doesn't cover
+type IRpcClient interface {
+	CallContext(
+		ctx context.Context,
+		result interface{},
+		method string,
+		args ...interface{},
+	) error
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+	Close()
+}
+
+// EthRpcClient contains the connected client, the raw JSON-RPC client
+// it wraps as well as the RawUrl for future use.
 type EthRpcClient struct {
 	EthClient IEthClient
+	RpcClient IRpcClient
 	RawUrl    string
 }
 
 // dialClient makes it easier to test by keeping it outside CreateClient
-var dialClient = ethclient.Dial
+var dialClient = rpc.Dial
 
 // CreateClient given the url of the rpc it attempts to establish
-// a connection with the node.
+// a connection with the node. Several urls can be given separated by
+// commas, requests are then spread over the nodes according to
+// DefaultEndpointConfig. Calls made through the client are bounded
+// by a timeout and retried on transient failures according to
+// DefaultRetryConfig.
 func CreateClient(RawUrl string) (*EthRpcClient, error) {
-	ethConnection, err := dialClient(RawUrl)
+	urls := strings.Split(RawUrl, ",")
+	if len(urls) > 1 {
+		return createMultiClient(RawUrl, urls)
+	}
+	rpcConnection, err := dialClient(RawUrl)
 	if err != nil {
 		return nil, err
 	}
-	return &EthRpcClient{ethConnection, RawUrl}, err
+	return &EthRpcClient{
+		EthClient: NewRetryEthClient(ethclient.NewClient(rpcConnection),
+			DefaultRetryConfig),
+		RpcClient: NewRetryRpcClient(rpcConnection, DefaultRetryConfig),
+		RawUrl:    RawUrl,
+	}, err
+}
+
+// createMultiClient connects to every url and spreads the requests over
+// the healthy ones, urls which can't be dialled are left out
+func createMultiClient(RawUrl string, urls []string) (*EthRpcClient, error) {
+	var endpoints []*endpoint
+	for _, url := range urls {
+		url = strings.TrimSpace(url)
+		rpcConnection, err := dialClient(url)
+		if err != nil {
+			fmt.Printf("warning: failed to connect to endpoint %s: %v\n",
+				url, err)
+			continue
+		}
+		endpoints = append(endpoints, &endpoint{
+			url:       url,
+			client:    ethclient.NewClient(rpcConnection),
+			rpcClient: rpcConnection,
+		})
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("error: failed to connect to any of the "+
+			"%d endpoints", len(urls))
+	}
+	pool, err1 := newEndpointPool(context.Background(), endpoints,
+		DefaultEndpointConfig)
+	if err1 != nil {
+		return nil, err1
+	}
+	return &EthRpcClient{
+		EthClient: NewRetryEthClient(&multiEthClient{pool},
+			DefaultRetryConfig),
+		RpcClient: NewRetryRpcClient(&multiRpcClient{pool},
+			DefaultRetryConfig),
+		RawUrl: RawUrl,
+	}, nil
 }
 
 // CloseClient closes the connection with the client
@@ -112,4 +200,54 @@ func (e *EthRpcClient) VerifyContractExistsAtAddress(
 		return false
 	}
 	return true
-}
\ No newline at end of file
+}
+
+// GetDataForCall builds the options used by view calls so that they can
+// be executed at a given block and on behalf of a given caller. The block
+// can be a number, a block hash or one of the tags latest, pending and
+// safe, an empty block means latest.
+func (e *EthRpcClient) GetDataForCall(
+	ctx context.Context,
+	block string,
+	from common.Address,
+) (*bind.CallOpts, error) {
+	callOpts := &bind.CallOpts{From: from, Context: ctx}
+	block = strings.ToLower(strings.TrimSpace(block))
+	switch {
+	case block == "" || block == "latest":
+		return callOpts, nil
+	case block == "pending":
+		callOpts.Pending = true
+		return callOpts, nil
+	case block == "safe" || block == "finalized":
+		var head struct {
+			Number *hexutil.Big `json:"number"`
+		}
+		err := e.RpcClient.CallContext(ctx, &head, "eth_getBlockByNumber",
+			block, false)
+		if err != nil {
+			return nil, fmt.Errorf("error: failed to retrieve the %s block, "+
+				"the node may not support this tag: %v", block, err)
+		}
+		if head.Number == nil {
+			return nil, fmt.Errorf("error: node returned no %s block", block)
+		}
+		callOpts.BlockNumber = head.Number.ToInt()
+		return callOpts, nil
+	case len(block) == 66 && strings.HasPrefix(block, "0x"):
+		header, err := e.EthClient.HeaderByHash(ctx, common.HexToHash(block))
+		if err != nil {
+			return nil, fmt.Errorf("error: failed to retrieve block %s: %v",
+				block, err)
+		}
+		callOpts.BlockNumber = header.Number
+		return callOpts, nil
+	}
+	number, err := strconv.ParseUint(block, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error: invalid block %q, expected a number, "+
+			"a block hash, latest, pending or safe", block)
+	}
+	callOpts.BlockNumber = new(big.Int).SetUint64(number)
+	return callOpts, nil
+}
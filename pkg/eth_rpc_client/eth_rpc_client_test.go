@@ -3,12 +3,15 @@ package eth_rpc_client
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	ea "go-evm-client/pkg/eth_account"
@@ -37,11 +40,13 @@ func (m *MockedEthClient) PendingCodeAt(ctx context.Context, account common.Addr
 }
 
 func (m *MockedEthClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
-	panic("implement me")
+	args := m.Called(ctx)
+	return (args.Get(0)).(*big.Int), args.Error(1)
 }
 
 func (m *MockedEthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-	panic("implement me")
+	args := m.Called(ctx)
+	return (args.Get(0)).(*big.Int), args.Error(1)
 }
 
 func (m *MockedEthClient) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
@@ -49,6 +54,25 @@ func (m *MockedEthClient) EstimateGas(ctx context.Context, call ethereum.CallMsg
 }
 
 func (m *MockedEthClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	args := m.Called(ctx, tx)
+	return args.Error(0)
+}
+
+func (m *MockedEthClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	args := m.Called(ctx, hash)
+	return (args.Get(0)).(*types.Transaction), args.Bool(1), args.Error(2)
+}
+
+func (m *MockedEthClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	args := m.Called(ctx, txHash)
+	return (args.Get(0)).(*types.Receipt), args.Error(1)
+}
+
+func (m *MockedEthClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	panic("implement me")
+}
+
+func (m *MockedEthClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
 	panic("implement me")
 }
 
@@ -79,34 +103,82 @@ func (m *MockedEthClient) PendingNonceAt(
 	return (args.Get(0)).(uint64), args.Error(1)
 }
 
+func (m *MockedEthClient) HeaderByHash(
+	ctx context.Context,
+	hash common.Hash,
+) (*types.Header, error) {
+	args := m.Called(ctx, hash)
+	return (args.Get(0)).(*types.Header), args.Error(1)
+}
 
 func (m *MockedEthClient) Close() {
 }
 
+type MockedRpcClient struct {
+	mock.Mock
+}
+
+func (m *MockedRpcClient) CallContext(
+	ctx context.Context,
+	result interface{},
+	method string,
+	args ...interface{},
+) error {
+	mArgs := m.Called(ctx, method, args)
+	// Copy the mocked JSON response into the result like the real client
+	if mArgs.Get(0) != nil {
+		_ = json.Unmarshal([]byte((mArgs.Get(0)).(string)), result)
+	}
+	return mArgs.Error(1)
+}
+
+func (m *MockedRpcClient) BatchCallContext(
+	ctx context.Context,
+	b []rpc.BatchElem,
+) error {
+	mArgs := m.Called(ctx, len(b))
+	// Answer every request with the mocked JSON response of its method
+	// and first argument, or with the mocked error if there is none
+	responses := (mArgs.Get(0)).(map[string]string)
+	for i := range b {
+		key := b[i].Method + ":" + fmt.Sprint(b[i].Args[0])
+		if response, ok := responses[key]; ok {
+			_ = json.Unmarshal([]byte(response), b[i].Result)
+		} else {
+			b[i].Error = errors.New("error: " + key + " failed")
+		}
+	}
+	return mArgs.Error(1)
+}
+
+func (m *MockedRpcClient) Close() {
+}
+
 func TestCreateClient(t *testing.T) {
 	tests := []struct {
 		testName			 string
 		url 					 string
 		expectedError  error
-		dialClientFunc func(string) (*ethclient.Client, error)
+		dialClientFunc func(string) (*rpc.Client, error)
 		expectedUrl 	 string
-		expectedClient *ethclient.Client
+		expectedClient IEthClient
 	}{
 		{
 			testName: "CreateClient successfull all data returned.",
 			url: "http://127.0.0.1:8545/",
 			expectedError: nil,
-			dialClientFunc: func(_ string) (*ethclient.Client, error) {
-				return &ethclient.Client{}, nil
+			dialClientFunc: func(_ string) (*rpc.Client, error) {
+				return &rpc.Client{}, nil
 			},
 			expectedUrl: "http://127.0.0.1:8545/",
-			expectedClient: &ethclient.Client{},
+			expectedClient: NewRetryEthClient(ethclient.NewClient(&rpc.Client{}),
+				DefaultRetryConfig),
 		},
 		{
 			testName: "CreateClient failed url not valid.",
 			url: "127.0.0.1:8545/",
 			expectedError: errors.New("rpc url invalid"),
-			dialClientFunc: func(_ string) (*ethclient.Client, error) {
+			dialClientFunc: func(_ string) (*rpc.Client, error) {
 				return nil, errors.New("rpc url invalid")
 			},
 			expectedUrl: "",
@@ -174,7 +246,8 @@ func TestEthRpcClientLoadBlockChainState(t *testing.T) {
 			ethClientConn.On("BlockNumber", currContext).Return(tt.blockNumber,
 					tt.expectedErrorBlock)
 
-			ethRpcClient := EthRpcClient{ethClientConn, "http://127.0.0.1:8545/"}
+			ethRpcClient := EthRpcClient{EthClient: ethClientConn,
+				RawUrl: "http://127.0.0.1:8545/"}
 			bchState, err := ethRpcClient.LoadBlockChainState(currContext)
 			if bchState != nil {
 				assert.NoError(t, err)
@@ -269,7 +342,8 @@ func TestEthRpcClientGetDataForTransaction(t *testing.T) {
 
 			newKeyedTransactionWithChainID = tt.newFunc
 
-			ethRpcClient := EthRpcClient{ethClientConn, "http://127.0.0.1:8545/"}
+			ethRpcClient := EthRpcClient{EthClient: ethClientConn,
+				RawUrl: "http://127.0.0.1:8545/"}
 			authState, err := ethRpcClient.GetDataForTransaction(currContext,
 				tt.userAccount, tt.chainId, tt.gasLimit, tt.gasPrice)
 			if authState != nil {
@@ -289,4 +363,108 @@ func TestEthRpcClientGetDataForTransaction(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestEthRpcClientGetDataForCall(t *testing.T) {
+	blockHash := "0x59e9d0e8c2f3c6e2bf1a3e8a3e6ee1b2fcdf59b0de9b2c70b9ef2b6c8ad6e1c4"
+	tests := []struct {
+		testName	string
+		block	string
+		headerNumber *big.Int
+		headerError error
+		rpcResponse interface{}
+		rpcError error
+		expectedError error
+		expectedBlockNumber *big.Int
+		expectedPending bool
+	}{
+		{
+			testName: "GetDataForCall latest block by default.",
+			block: "",
+			expectedError: nil,
+			expectedBlockNumber: nil,
+			expectedPending: false,
+		},
+		{
+			testName: "GetDataForCall pending block.",
+			block: "pending",
+			expectedError: nil,
+			expectedBlockNumber: nil,
+			expectedPending: true,
+		},
+		{
+			testName: "GetDataForCall decimal block number.",
+			block: "1500",
+			expectedError: nil,
+			expectedBlockNumber: big.NewInt(1500),
+		},
+		{
+			testName: "GetDataForCall hex block number.",
+			block: "0x5dc",
+			expectedError: nil,
+			expectedBlockNumber: big.NewInt(1500),
+		},
+		{
+			testName: "GetDataForCall block hash.",
+			block: blockHash,
+			headerNumber: big.NewInt(42),
+			expectedError: nil,
+			expectedBlockNumber: big.NewInt(42),
+		},
+		{
+			testName: "GetDataForCall unknown block hash.",
+			block: blockHash,
+			headerNumber: nil,
+			headerError: errors.New("not found"),
+			expectedError: errors.New("error: failed to retrieve block " +
+				blockHash + ": not found"),
+		},
+		{
+			testName: "GetDataForCall safe block.",
+			block: "safe",
+			rpcResponse: `{"number":"0x2a"}`,
+			expectedError: nil,
+			expectedBlockNumber: big.NewInt(42),
+		},
+		{
+			testName: "GetDataForCall safe block unsupported.",
+			block: "safe",
+			rpcResponse: nil,
+			rpcError: errors.New("invalid block tag"),
+			expectedError: errors.New("error: failed to retrieve the safe " +
+				"block, the node may not support this tag: invalid block tag"),
+		},
+		{
+			testName: "GetDataForCall invalid block.",
+			block: "yesterday",
+			expectedError: errors.New("error: invalid block \"yesterday\", " +
+				"expected a number, a block hash, latest, pending or safe"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			currContext := context.Background()
+			from := common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+			ethClientConn := new(MockedEthClient)
+			ethClientConn.On("HeaderByHash", currContext, common.HexToHash(
+				tt.block)).Return(&types.Header{Number: tt.headerNumber},
+				tt.headerError)
+			rpcClientConn := new(MockedRpcClient)
+			rpcClientConn.On("CallContext", currContext, "eth_getBlockByNumber",
+				[]interface{}{tt.block, false}).Return(tt.rpcResponse, tt.rpcError)
+
+			ethRpcClient := EthRpcClient{EthClient: ethClientConn,
+				RpcClient: rpcClientConn, RawUrl: "http://127.0.0.1:8545/"}
+			callOpts, err := ethRpcClient.GetDataForCall(currContext, tt.block, from)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, callOpts)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, callOpts.From, from)
+			assert.Equal(t, callOpts.BlockNumber, tt.expectedBlockNumber)
+			assert.Equal(t, callOpts.Pending, tt.expectedPending)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"strconv"
+	"strings"
+)
+
+// RpcTransaction is a transaction as returned by the node. It is read from
+// the raw JSON-RPC response rather than through the go-ethereum types so
+// that the sender given by the node is kept and nodes such as Ethermint,
+// whose blocks the go-ethereum types can't always verify, are supported.
+type RpcTransaction struct {
+	Hash             common.Hash     `json:"hash"`
+	BlockHash        *common.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Big    `json:"blockNumber"`
+	TransactionIndex *hexutil.Uint64 `json:"transactionIndex"`
+	From             common.Address  `json:"from"`
+	To               *common.Address `json:"to"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	Value            *hexutil.Big    `json:"value"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         *hexutil.Big    `json:"gasPrice"`
+	GasFeeCap        *hexutil.Big    `json:"maxFeePerGas"`
+	GasTipCap        *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	Type             hexutil.Uint64  `json:"type"`
+	Input            hexutil.Bytes   `json:"input"`
+}
+
+// RpcReceipt is a transaction receipt as returned by the node, the
+// effective gas price is only given by nodes supporting EIP-1559
+type RpcReceipt struct {
+	TransactionHash   common.Hash     `json:"transactionHash"`
+	BlockHash         common.Hash     `json:"blockHash"`
+	BlockNumber       *hexutil.Big    `json:"blockNumber"`
+	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
+	Status            hexutil.Uint64  `json:"status"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	Logs              []*types.Log    `json:"logs"`
+}
+
+// RpcBlock is a block as returned by the node, its transactions are only
+// filled when they are requested, their hashes otherwise
+type RpcBlock struct {
+	Number            *hexutil.Big     `json:"number"`
+	Hash              common.Hash      `json:"hash"`
+	ParentHash        common.Hash      `json:"parentHash"`
+	Timestamp         hexutil.Uint64   `json:"timestamp"`
+	Miner             common.Address   `json:"miner"`
+	GasLimit          hexutil.Uint64   `json:"gasLimit"`
+	GasUsed           hexutil.Uint64   `json:"gasUsed"`
+	BaseFee           *hexutil.Big     `json:"baseFeePerGas"`
+	Size              hexutil.Uint64   `json:"size"`
+	Transactions      []RpcTransaction `json:"-"`
+	TransactionHashes []common.Hash    `json:"-"`
+}
+
+// UnmarshalJSON reads the transactions of the block either as objects or
+// as hashes depending on what was requested
+func (b *RpcBlock) UnmarshalJSON(input []byte) error {
+	type block RpcBlock
+	var raw struct {
+		block
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+	err := json.Unmarshal(input, &raw)
+	if err != nil {
+		return err
+	}
+	*b = RpcBlock(raw.block)
+	for _, tx := range raw.Transactions {
+		if len(tx) != 0 && tx[0] == '"' {
+			var hash common.Hash
+			err1 := json.Unmarshal(tx, &hash)
+			if err1 != nil {
+				return err1
+			}
+			b.TransactionHashes = append(b.TransactionHashes, hash)
+			continue
+		}
+		var full RpcTransaction
+		err2 := json.Unmarshal(tx, &full)
+		if err2 != nil {
+			return err2
+		}
+		b.Transactions = append(b.Transactions, full)
+		b.TransactionHashes = append(b.TransactionHashes, full.Hash)
+	}
+	return nil
+}
+
+// GetTransaction retrieves a transaction with eth_getTransactionByHash,
+// ethereum.NotFound is returned when the node doesn't know it
+func (e *EthRpcClient) GetTransaction(
+	ctx context.Context,
+	hash common.Hash,
+) (*RpcTransaction, error) {
+	var tx *RpcTransaction
+	err := e.RpcClient.CallContext(ctx, &tx, "eth_getTransactionByHash",
+		hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, ethereum.NotFound
+	}
+	return tx, nil
+}
+
+// GetReceipt retrieves the receipt of a transaction with
+// eth_getTransactionReceipt, ethereum.NotFound is returned while the
+// transaction isn't mined
+func (e *EthRpcClient) GetReceipt(
+	ctx context.Context,
+	hash common.Hash,
+) (*RpcReceipt, error) {
+	var receipt *RpcReceipt
+	err := e.RpcClient.CallContext(ctx, &receipt,
+		"eth_getTransactionReceipt", hash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return receipt, nil
+}
+
+// GetBlock retrieves a block given as a number, a block hash or one of the
+// tags latest, pending, earliest, safe and finalized, an empty block
+// means latest. The transactions are only retrieved when requested.
+func (e *EthRpcClient) GetBlock(
+	ctx context.Context,
+	block string,
+	fullTransactions bool,
+) (*RpcBlock, error) {
+	var result *RpcBlock
+	var err error
+	block = strings.ToLower(strings.TrimSpace(block))
+	if block == "" {
+		block = "latest"
+	}
+	switch {
+	case block == "latest" || block == "pending" || block == "earliest" ||
+		block == "safe" || block == "finalized":
+		err = e.RpcClient.CallContext(ctx, &result, "eth_getBlockByNumber",
+			block, fullTransactions)
+	case len(block) == 66 && strings.HasPrefix(block, "0x"):
+		err = e.RpcClient.CallContext(ctx, &result, "eth_getBlockByHash",
+			common.HexToHash(block), fullTransactions)
+	default:
+		number, err1 := strconv.ParseUint(block, 0, 64)
+		if err1 != nil {
+			return nil, fmt.Errorf("error: invalid block %q, expected a "+
+				"number, a block hash, latest, pending, earliest, safe or "+
+				"finalized", block)
+		}
+		err = e.RpcClient.CallContext(ctx, &result, "eth_getBlockByNumber",
+			hexutil.EncodeUint64(number), fullTransactions)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to retrieve block %s: %v",
+			block, err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("error: block %s doesn't exist", block)
+	}
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestEthRpcClientGetBlock(t *testing.T) {
+	blockHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	txHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+	tests := []struct {
+		testName          string
+		block             string
+		full              bool
+		method            string
+		args              []interface{}
+		rpcResponse       interface{}
+		rpcError          error
+		expectedNumber    *big.Int
+		expectedHashes    []common.Hash
+		expectedFullCount int
+		expectedError     error
+	}{
+		{
+			testName:       "GetBlock latest with hashes.",
+			block:          "",
+			method:         "eth_getBlockByNumber",
+			args:           []interface{}{"latest", false},
+			rpcResponse:    `{"number":"0x2a","transactions":["` + txHash + `"]}`,
+			expectedNumber: big.NewInt(42),
+			expectedHashes: []common.Hash{common.HexToHash(txHash)},
+		},
+		{
+			testName: "GetBlock number with full transactions.",
+			block:    "42",
+			full:     true,
+			method:   "eth_getBlockByNumber",
+			args:     []interface{}{"0x2a", true},
+			rpcResponse: `{"number":"0x2a","transactions":[{"hash":"` +
+				txHash + `","nonce":"0x1","gas":"0x5208","input":"0x"}]}`,
+			expectedNumber:    big.NewInt(42),
+			expectedHashes:    []common.Hash{common.HexToHash(txHash)},
+			expectedFullCount: 1,
+		},
+		{
+			testName:       "GetBlock by hash.",
+			block:          blockHash,
+			method:         "eth_getBlockByHash",
+			args:           []interface{}{common.HexToHash(blockHash), false},
+			rpcResponse:    `{"number":"0x7","transactions":[]}`,
+			expectedNumber: big.NewInt(7),
+		},
+		{
+			testName:      "GetBlock missing block.",
+			block:         "0x64",
+			method:        "eth_getBlockByNumber",
+			args:          []interface{}{"0x64", false},
+			rpcResponse:   `null`,
+			expectedError: errors.New("error: block 0x64 doesn't exist"),
+		},
+		{
+			testName: "GetBlock failed call.",
+			block:    "safe",
+			method:   "eth_getBlockByNumber",
+			args:     []interface{}{"safe", false},
+			rpcError: errors.New("invalid block tag"),
+			expectedError: errors.New("error: failed to retrieve block safe: " +
+				"invalid block tag"),
+		},
+		{
+			testName: "GetBlock invalid block.",
+			block:    "yesterday",
+			expectedError: errors.New("error: invalid block \"yesterday\", " +
+				"expected a number, a block hash, latest, pending, earliest, " +
+				"safe or finalized"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			currContext := context.Background()
+			rpcClientConn := new(MockedRpcClient)
+			rpcClientConn.On("CallContext", currContext, tt.method,
+				tt.args).Return(tt.rpcResponse, tt.rpcError)
+
+			ethRpcClient := EthRpcClient{RpcClient: rpcClientConn,
+				RawUrl: "http://127.0.0.1:8545/"}
+			block, err := ethRpcClient.GetBlock(currContext, tt.block, tt.full)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, block)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedNumber, block.Number.ToInt())
+			assert.Equal(t, tt.expectedHashes, block.TransactionHashes)
+			assert.Equal(t, tt.expectedFullCount, len(block.Transactions))
+		})
+	}
+}
+
+func TestEthRpcClientGetTransaction(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	tests := []struct {
+		testName      string
+		rpcResponse   interface{}
+		rpcError      error
+		expectedNonce uint64
+		expectedError error
+	}{
+		{
+			testName:      "GetTransaction known.",
+			rpcResponse:   `{"hash":"0x01","nonce":"0x5","gas":"0x5208"}`,
+			expectedNonce: 5,
+		},
+		{
+			testName:      "GetTransaction unknown.",
+			rpcResponse:   `null`,
+			expectedError: ethereum.NotFound,
+		},
+		{
+			testName:      "GetTransaction failed call.",
+			rpcError:      errors.New("connection refused"),
+			expectedError: errors.New("connection refused"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			currContext := context.Background()
+			rpcClientConn := new(MockedRpcClient)
+			rpcClientConn.On("CallContext", currContext,
+				"eth_getTransactionByHash", []interface{}{hash}).Return(
+				tt.rpcResponse, tt.rpcError)
+
+			ethRpcClient := EthRpcClient{RpcClient: rpcClientConn,
+				RawUrl: "http://127.0.0.1:8545/"}
+			tx, err := ethRpcClient.GetTransaction(currContext, hash)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, tx)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedNonce, uint64(tx.Nonce))
+		})
+	}
+}
@@ -0,0 +1,456 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Read policies deciding which endpoint serves a read
+const (
+	// ReadPolicyRoundRobin spreads the reads evenly over healthy endpoints
+	ReadPolicyRoundRobin = "roundrobin"
+	// ReadPolicyFreshest sends the reads to the endpoint with the highest
+	// block
+	ReadPolicyFreshest = "freshest"
+)
+
+// EndpointConfig controls how requests are spread over several endpoints
+type EndpointConfig struct {
+	// ReadPolicy is either ReadPolicyRoundRobin or ReadPolicyFreshest
+	ReadPolicy string
+	// MaxBlockLag is how many blocks an endpoint may trail the freshest
+	// one before it stops receiving requests
+	MaxBlockLag uint64
+	// HealthCheckInterval is how often the endpoints are checked again,
+	// which lets recovered endpoints back in
+	HealthCheckInterval time.Duration
+	// HealthCheckTimeout bounds the calls made by a health check
+	HealthCheckTimeout time.Duration
+}
+
+// DefaultEndpointConfig is used by CreateClient when several endpoints
+// are given, the CLIs override it with the values given on the command
+// line
+var DefaultEndpointConfig = EndpointConfig{
+	ReadPolicy:          ReadPolicyRoundRobin,
+	MaxBlockLag:         5,
+	HealthCheckInterval: 30 * time.Second,
+	HealthCheckTimeout:  5 * time.Second,
+}
+
+// endpoint is a single node of a pool together with its last known state
+type endpoint struct {
+	url         string
+	client      IEthClient
+	rpcClient   IRpcClient
+	healthy     bool
+	wrongChain  bool
+	blockNumber uint64
+}
+
+// endpointPool keeps track of the health of several endpoints serving the
+// same chain. Writes go to the primary endpoint while reads are spread
+// according to the read policy, and an endpoint which stops responding is
+// skipped until a later health check finds it healthy again.
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*endpoint
+	primary   int
+	next      int
+	chainId   *big.Int
+	lastCheck time.Time
+	config    EndpointConfig
+}
+
+// newEndpointPool creates a pool over the given endpoints and checks their
+// health, the first endpoint starts as the primary
+func newEndpointPool(
+	ctx context.Context,
+	endpoints []*endpoint,
+	config EndpointConfig,
+) (*endpointPool, error) {
+	if config.ReadPolicy != ReadPolicyRoundRobin &&
+		config.ReadPolicy != ReadPolicyFreshest {
+		return nil, fmt.Errorf("error: invalid read policy %q, expected %s "+
+			"or %s", config.ReadPolicy, ReadPolicyRoundRobin, ReadPolicyFreshest)
+	}
+	p := &endpointPool{endpoints: endpoints, config: config}
+	p.checkHealth(ctx)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.chainId == nil {
+		return nil, fmt.Errorf("error: none of the %d endpoints responded",
+			len(endpoints))
+	}
+	for _, e := range p.endpoints {
+		status := "healthy"
+		if e.wrongChain {
+			status = "excluded, chain id mismatch"
+		} else if !e.healthy {
+			status = "unhealthy"
+		}
+		fmt.Printf("info: endpoint %s at block %d is %s\n", e.url,
+			e.blockNumber, status)
+	}
+	fmt.Printf("info: using %s as primary endpoint\n",
+		p.endpoints[p.primary].url)
+	return p, nil
+}
+
+// checkHealth queries the chain id and block height of every endpoint.
+// Endpoints on another chain than the first responding one are excluded
+// for good, endpoints that don't respond or trail the freshest one by more
+// than MaxBlockLag blocks are marked unhealthy.
+func (p *endpointPool) checkHealth(ctx context.Context) {
+	type state struct {
+		chainId     *big.Int
+		blockNumber uint64
+		err         error
+	}
+	states := make([]state, len(p.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx,
+				p.config.HealthCheckTimeout)
+			defer cancel()
+			states[i].chainId, states[i].err = e.client.ChainID(checkCtx)
+			if states[i].err == nil {
+				states[i].blockNumber, states[i].err = e.client.BlockNumber(
+					checkCtx)
+			}
+		}(i, e)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastCheck = time.Now()
+	var highest uint64
+	for i, e := range p.endpoints {
+		if states[i].err != nil || e.wrongChain {
+			continue
+		}
+		if p.chainId == nil {
+			p.chainId = states[i].chainId
+		}
+		if states[i].chainId.Cmp(p.chainId) != 0 {
+			fmt.Printf("warning: endpoint %s is on chain %d instead of %d, "+
+				"excluding it\n", e.url, states[i].chainId, p.chainId)
+			e.wrongChain = true
+			continue
+		}
+		e.blockNumber = states[i].blockNumber
+		if e.blockNumber > highest {
+			highest = e.blockNumber
+		}
+	}
+	for i, e := range p.endpoints {
+		e.healthy = states[i].err == nil && !e.wrongChain &&
+			e.blockNumber+p.config.MaxBlockLag >= highest
+	}
+	p.electPrimary()
+}
+
+// electPrimary promotes the first healthy endpoint when the primary isn't
+// healthy anymore. The caller must hold the lock.
+func (p *endpointPool) electPrimary() {
+	if p.endpoints[p.primary].healthy {
+		return
+	}
+	for i, e := range p.endpoints {
+		if e.healthy {
+			fmt.Printf("warning: primary endpoint %s is unhealthy, failing "+
+				"over to %s\n", p.endpoints[p.primary].url, e.url)
+			p.primary = i
+			return
+		}
+	}
+}
+
+// candidates returns the endpoints to try for a request in order of
+// preference. Healthy endpoints come first, ordered by the read policy or
+// starting with the primary for writes, followed by the unhealthy ones as
+// a last resort. Endpoints on another chain are never returned.
+func (p *endpointPool) candidates(ctx context.Context, write bool) []*endpoint {
+	p.mu.Lock()
+	stale := time.Since(p.lastCheck) > p.config.HealthCheckInterval
+	p.mu.Unlock()
+	if stale {
+		p.checkHealth(ctx)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var healthy, unhealthy []*endpoint
+	count := len(p.endpoints)
+	start := p.primary
+	if !write && p.config.ReadPolicy == ReadPolicyRoundRobin {
+		start = p.next % count
+		p.next++
+	}
+	for i := 0; i < count; i++ {
+		e := p.endpoints[(start+i)%count]
+		if e.wrongChain {
+			continue
+		}
+		if e.healthy {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	if !write && p.config.ReadPolicy == ReadPolicyFreshest {
+		sort.SliceStable(healthy, func(i, j int) bool {
+			return healthy[i].blockNumber > healthy[j].blockNumber
+		})
+	}
+	return append(healthy, unhealthy...)
+}
+
+// markDown flags an endpoint which failed to respond as unhealthy
+func (p *endpointPool) markDown(e *endpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e.healthy {
+		fmt.Printf("warning: endpoint %s failed: %v, marking it unhealthy\n",
+			e.url, err)
+	}
+	e.healthy = false
+	p.electPrimary()
+}
+
+// do runs the request on the candidate endpoints until one of them
+// answers. Only transport failures move on to the next endpoint, an error
+// returned by the node for the request itself is returned as is.
+func (p *endpointPool) do(ctx context.Context, write bool,
+	call func(e *endpoint) error) error {
+	var err error
+	for _, e := range p.candidates(ctx, write) {
+		err = call(e)
+		if err == nil || !isRetryableError(err) || ctx.Err() != nil {
+			return err
+		}
+		p.markDown(e, err)
+		if write {
+			// The retry logic above the pool decides whether a failed
+			// write may be sent again
+			return err
+		}
+	}
+	return err
+}
+
+// multiEthClient implements IEthClient over a pool of endpoints
+type multiEthClient struct {
+	pool *endpointPool
+}
+
+func (m *multiEthClient) CodeAt(ctx context.Context, contract common.Address,
+	blockNumber *big.Int) (code []byte, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		code, err = e.client.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (m *multiEthClient) CallContract(ctx context.Context,
+	call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		result, err = e.client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (m *multiEthClient) HeaderByNumber(ctx context.Context,
+	number *big.Int) (header *types.Header, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		header, err = e.client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (m *multiEthClient) HeaderByHash(ctx context.Context,
+	hash common.Hash) (header *types.Header, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		header, err = e.client.HeaderByHash(ctx, hash)
+		return err
+	})
+	return header, err
+}
+
+func (m *multiEthClient) PendingCodeAt(ctx context.Context,
+	account common.Address) (code []byte, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		code, err = e.client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+// PendingNonceAt is served by the primary so that the nonce matches the
+// pool of the node the transactions are sent to
+func (m *multiEthClient) PendingNonceAt(ctx context.Context,
+	account common.Address) (nonce uint64, err error) {
+	err = m.pool.do(ctx, true, func(e *endpoint) error {
+		nonce, err = e.client.PendingNonceAt(ctx, account)
+		return err
+	})
+	return nonce, err
+}
+
+func (m *multiEthClient) SuggestGasPrice(ctx context.Context) (
+	price *big.Int, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		price, err = e.client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (m *multiEthClient) SuggestGasTipCap(ctx context.Context) (
+	tip *big.Int, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		tip, err = e.client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return tip, err
+}
+
+func (m *multiEthClient) EstimateGas(ctx context.Context,
+	call ethereum.CallMsg) (gas uint64, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		gas, err = e.client.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+// SendTransaction always goes to the primary, when it fails to respond
+// the next healthy endpoint becomes the primary for the following sends
+func (m *multiEthClient) SendTransaction(ctx context.Context,
+	tx *types.Transaction) error {
+	return m.pool.do(ctx, true, func(e *endpoint) error {
+		return e.client.SendTransaction(ctx, tx)
+	})
+}
+
+func (m *multiEthClient) TransactionByHash(ctx context.Context,
+	hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		tx, isPending, err = e.client.TransactionByHash(ctx, hash)
+		return err
+	})
+	return tx, isPending, err
+}
+
+func (m *multiEthClient) TransactionReceipt(ctx context.Context,
+	txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		receipt, err = e.client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (m *multiEthClient) BalanceAt(ctx context.Context,
+	account common.Address, blockNumber *big.Int) (balance *big.Int,
+	err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		balance, err = e.client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (m *multiEthClient) StorageAt(ctx context.Context,
+	account common.Address, key common.Hash, blockNumber *big.Int) (
+	value []byte, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		value, err = e.client.StorageAt(ctx, account, key, blockNumber)
+		return err
+	})
+	return value, err
+}
+
+func (m *multiEthClient) FilterLogs(ctx context.Context,
+	query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		logs, err = e.client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes through the primary so that a stream
+// isn't tied to a node only serving reads
+func (m *multiEthClient) SubscribeFilterLogs(ctx context.Context,
+	query ethereum.FilterQuery, ch chan<- types.Log) (
+	sub ethereum.Subscription, err error) {
+	err = m.pool.do(ctx, true, func(e *endpoint) error {
+		sub, err = e.client.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
+
+// ChainID returns the chain id all endpoints agreed on
+func (m *multiEthClient) ChainID(ctx context.Context) (*big.Int, error) {
+	m.pool.mu.Lock()
+	defer m.pool.mu.Unlock()
+	return new(big.Int).Set(m.pool.chainId), nil
+}
+
+func (m *multiEthClient) BlockNumber(ctx context.Context) (
+	blockNumber uint64, err error) {
+	err = m.pool.do(ctx, false, func(e *endpoint) error {
+		blockNumber, err = e.client.BlockNumber(ctx)
+		return err
+	})
+	return blockNumber, err
+}
+
+func (m *multiEthClient) Close() {
+	for _, e := range m.pool.endpoints {
+		e.client.Close()
+	}
+}
+
+// multiRpcClient implements IRpcClient over a pool of endpoints, raw
+// requests are treated as reads
+type multiRpcClient struct {
+	pool *endpointPool
+}
+
+func (m *multiRpcClient) CallContext(ctx context.Context,
+	result interface{}, method string, args ...interface{}) error {
+	return m.pool.do(ctx, false, func(e *endpoint) error {
+		return e.rpcClient.CallContext(ctx, result, method, args...)
+	})
+}
+
+func (m *multiRpcClient) BatchCallContext(ctx context.Context,
+	b []rpc.BatchElem) error {
+	return m.pool.do(ctx, false, func(e *endpoint) error {
+		return e.rpcClient.BatchCallContext(ctx, b)
+	})
+}
+
+func (m *multiRpcClient) Close() {
+	for _, e := range m.pool.endpoints {
+		e.rpcClient.Close()
+	}
+}
@@ -0,0 +1,180 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// newTestEndpoint creates an endpoint whose health check reports the given
+// chain id and block number
+func newTestEndpoint(url string, chainId int64, blockNumber uint64,
+	err error) (*endpoint, *MockedEthClient) {
+	mEthClient := new(MockedEthClient)
+	mEthClient.On("ChainID", mock.Anything).Return(big.NewInt(chainId), err)
+	mEthClient.On("BlockNumber", mock.Anything).Return(blockNumber, err)
+	return &endpoint{url: url, client: mEthClient}, mEthClient
+}
+
+// testEndpointConfig never rechecks the health on its own
+var testEndpointConfig = EndpointConfig{
+	ReadPolicy:          ReadPolicyRoundRobin,
+	MaxBlockLag:         5,
+	HealthCheckInterval: time.Hour,
+	HealthCheckTimeout:  time.Second,
+}
+
+func TestNewEndpointPoolHealthCheck(t *testing.T) {
+	tests := []struct {
+		testName        string
+		chainIds        []int64
+		blockNumbers    []uint64
+		errors          []error
+		expectedHealthy []bool
+		expectedPrimary string
+		expectedError   error
+	}{
+		{
+			testName:        "Endpoints all healthy.",
+			chainIds:        []int64{1337, 1337, 1337},
+			blockNumbers:    []uint64{100, 98, 101},
+			errors:          []error{nil, nil, nil},
+			expectedHealthy: []bool{true, true, true},
+			expectedPrimary: "node0",
+		},
+		{
+			testName:        "Endpoint on another chain and lagging endpoint.",
+			chainIds:        []int64{1337, 1, 1337},
+			blockNumbers:    []uint64{100, 500, 90},
+			errors:          []error{nil, nil, nil},
+			expectedHealthy: []bool{true, false, false},
+			expectedPrimary: "node0",
+		},
+		{
+			testName:        "Primary down at start.",
+			chainIds:        []int64{1337, 1337, 1337},
+			blockNumbers:    []uint64{100, 100, 100},
+			errors:          []error{errors.New("connection refused"), nil, nil},
+			expectedHealthy: []bool{false, true, true},
+			expectedPrimary: "node1",
+		},
+		{
+			testName:     "Endpoints all down.",
+			chainIds:     []int64{1337, 1337},
+			blockNumbers: []uint64{100, 100},
+			errors: []error{errors.New("connection refused"),
+				errors.New("connection refused")},
+			expectedError: errors.New("error: none of the 2 endpoints responded"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			var endpoints []*endpoint
+			for i := range tt.chainIds {
+				e, _ := newTestEndpoint("node"+string(rune('0'+i)),
+					tt.chainIds[i], tt.blockNumbers[i], tt.errors[i])
+				endpoints = append(endpoints, e)
+			}
+			pool, err := newEndpointPool(context.Background(), endpoints,
+				testEndpointConfig)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, pool)
+				return
+			}
+			assert.NoError(t, err)
+			for i, e := range endpoints {
+				assert.Equal(t, e.healthy, tt.expectedHealthy[i])
+			}
+			assert.Equal(t, pool.endpoints[pool.primary].url, tt.expectedPrimary)
+		})
+	}
+}
+
+func TestMultiEthClientReadPolicies(t *testing.T) {
+	tests := []struct {
+		testName       string
+		readPolicy     string
+		expectedServed []string
+	}{
+		{
+			testName:       "Round robin reads rotate over the endpoints.",
+			readPolicy:     ReadPolicyRoundRobin,
+			expectedServed: []string{"node0", "node1", "node2", "node0"},
+		},
+		{
+			testName:       "Freshest reads go to the highest endpoint.",
+			readPolicy:     ReadPolicyFreshest,
+			expectedServed: []string{"node2", "node2", "node2", "node2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			var endpoints []*endpoint
+			for i, blockNumber := range []uint64{100, 99, 102} {
+				e, _ := newTestEndpoint("node"+string(rune('0'+i)), 1337,
+					blockNumber, nil)
+				endpoints = append(endpoints, e)
+			}
+			config := testEndpointConfig
+			config.ReadPolicy = tt.readPolicy
+			pool, err := newEndpointPool(context.Background(), endpoints,
+				config)
+			assert.NoError(t, err)
+			for _, expected := range tt.expectedServed {
+				served := ""
+				err1 := pool.do(context.Background(), false,
+					func(e *endpoint) error {
+						served = e.url
+						return nil
+					})
+				assert.NoError(t, err1)
+				assert.Equal(t, served, expected)
+			}
+		})
+	}
+}
+
+func TestMultiEthClientFailover(t *testing.T) {
+	tx := types.NewTransaction(0, [20]byte{}, big.NewInt(0), 21000,
+		big.NewInt(1000), nil)
+	node0, mNode0 := newTestEndpoint("node0", 1337, 100, nil)
+	node1, mNode1 := newTestEndpoint("node1", 1337, 100, nil)
+	pool, err := newEndpointPool(context.Background(),
+		[]*endpoint{node0, node1}, testEndpointConfig)
+	assert.NoError(t, err)
+	client := &multiEthClient{pool}
+
+	// A node refusing the request itself doesn't trigger a failover
+	mNode0.On("SendTransaction", mock.Anything, tx).Return(
+		errors.New("nonce too low")).Once()
+	err1 := client.SendTransaction(context.Background(), tx)
+	assert.Equal(t, err1.Error(), "nonce too low")
+	assert.True(t, node0.healthy)
+
+	// The primary stops responding, the write fails and node1 takes over
+	mNode0.On("SendTransaction", mock.Anything, tx).Return(
+		errors.New("connection refused")).Once()
+	err2 := client.SendTransaction(context.Background(), tx)
+	assert.Equal(t, err2.Error(), "connection refused")
+	assert.False(t, node0.healthy)
+	assert.Equal(t, pool.endpoints[pool.primary].url, "node1")
+
+	mNode1.On("SendTransaction", mock.Anything, tx).Return(nil).Once()
+	err3 := client.SendTransaction(context.Background(), tx)
+	assert.NoError(t, err3)
+
+	// Reads skip the unhealthy node as long as another one answers
+	for i := 0; i < 2; i++ {
+		blockNumber, err4 := client.BlockNumber(context.Background())
+		assert.NoError(t, err4)
+		assert.Equal(t, blockNumber, uint64(100))
+	}
+	mNode1.AssertNumberOfCalls(t, "BlockNumber", 3)
+	mNode0.AssertNumberOfCalls(t, "BlockNumber", 1)
+}
@@ -0,0 +1,401 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"io"
+	"math/big"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryConfig controls how long a single RPC call may take and how
+// transient failures are retried
+type RetryConfig struct {
+	// CallTimeout bounds every individual attempt, zero disables it
+	CallTimeout time.Duration
+	// MaxRetries is the number of attempts made after the first one fails
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry, it doubles on
+	// every following retry up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryConfig is used by CreateClient, the CLIs override it with
+// the values given on the command line
+var DefaultRetryConfig = RetryConfig{
+	CallTimeout:    30 * time.Second,
+	MaxRetries:     4,
+	InitialBackoff: 250 * time.Millisecond,
+	MaxBackoff:     8 * time.Second,
+}
+
+// retryableErrors are fragments of the errors returned by nodes and
+// transports for failures which are worth retrying
+var retryableErrors = []string{
+	"connection reset",
+	"connection refused",
+	"broken pipe",
+	"header not found",
+	"too many requests",
+	"timeout",
+	"eof",
+}
+
+// isRetryableError reports whether the call failed because of a transient
+// transport or node problem rather than because of the request itself
+func isRetryableError(err error) bool {
+	var statusErr *unknownTxStatusError
+	if errors.As(err, &statusErr) {
+		return false
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == 429 || httpErr.StatusCode >= 500
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range retryableErrors {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownTxStatusError is returned when a send failed and the node
+// couldn't tell whether it received the transaction, resending it blindly
+// could then broadcast it twice
+type unknownTxStatusError struct {
+	hash      common.Hash
+	sendErr   error
+	lookupErr error
+}
+
+func (e *unknownTxStatusError) Error() string {
+	return fmt.Sprintf("error: sending transaction %s failed with %v and "+
+		"its status is unknown: %v", e.hash.Hex(), e.sendErr, e.lookupErr)
+}
+
+// isKnownTransactionError reports whether the node refused a transaction
+// because it already has it in its pool
+func isKnownTransactionError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") ||
+		strings.Contains(msg, "known transaction")
+}
+
+// withCallTimeout derives the context of a single attempt, a zero timeout
+// leaves the parent deadline in charge
+func withCallTimeout(ctx context.Context, timeout time.Duration) (
+	context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// sleepFunc makes it easier to test by keeping it outside retry
+var sleepFunc = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retry runs the call with the configured per call timeout, retrying
+// retryable failures with an exponential backoff and full jitter
+func retry(ctx context.Context, config RetryConfig, method string,
+	call func(ctx context.Context) error) error {
+	backoff := config.InitialBackoff
+	for attempt := 0; ; attempt++ {
+		callCtx, cancel := withCallTimeout(ctx, config.CallTimeout)
+		err := call(callCtx)
+		cancel()
+		if err == nil {
+			return nil
+		}
+		if attempt >= config.MaxRetries || ctx.Err() != nil ||
+			!isRetryableError(err) {
+			return err
+		}
+		delay := backoff
+		if delay > 0 {
+			delay = time.Duration(rand.Int63n(int64(backoff))) + 1
+		}
+		fmt.Printf("warning: %s failed: %v, retrying (%d/%d)\n", method, err,
+			attempt+1, config.MaxRetries)
+		if err1 := sleepFunc(ctx, delay); err1 != nil {
+			return err
+		}
+		backoff *= 2
+		if backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+}
+
+// retryEthClient wraps an IEthClient so that every idempotent call gets
+// a timeout and is retried on transient failures
+type retryEthClient struct {
+	client IEthClient
+	config RetryConfig
+}
+
+// NewRetryEthClient wraps the client with the given retry configuration
+func NewRetryEthClient(client IEthClient, config RetryConfig) IEthClient {
+	return &retryEthClient{client: client, config: config}
+}
+
+func (r *retryEthClient) CodeAt(ctx context.Context, contract common.Address,
+	blockNumber *big.Int) (code []byte, err error) {
+	err = retry(ctx, r.config, "eth_getCode", func(ctx context.Context) error {
+		code, err = r.client.CodeAt(ctx, contract, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (r *retryEthClient) CallContract(ctx context.Context,
+	call ethereum.CallMsg, blockNumber *big.Int) (result []byte, err error) {
+	err = retry(ctx, r.config, "eth_call", func(ctx context.Context) error {
+		result, err = r.client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (r *retryEthClient) HeaderByNumber(ctx context.Context,
+	number *big.Int) (header *types.Header, err error) {
+	err = retry(ctx, r.config, "eth_getBlockByNumber",
+		func(ctx context.Context) error {
+			header, err = r.client.HeaderByNumber(ctx, number)
+			return err
+		})
+	return header, err
+}
+
+func (r *retryEthClient) HeaderByHash(ctx context.Context,
+	hash common.Hash) (header *types.Header, err error) {
+	err = retry(ctx, r.config, "eth_getBlockByHash",
+		func(ctx context.Context) error {
+			header, err = r.client.HeaderByHash(ctx, hash)
+			return err
+		})
+	return header, err
+}
+
+func (r *retryEthClient) PendingCodeAt(ctx context.Context,
+	account common.Address) (code []byte, err error) {
+	err = retry(ctx, r.config, "eth_getCode", func(ctx context.Context) error {
+		code, err = r.client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (r *retryEthClient) PendingNonceAt(ctx context.Context,
+	account common.Address) (nonce uint64, err error) {
+	err = retry(ctx, r.config, "eth_getTransactionCount",
+		func(ctx context.Context) error {
+			nonce, err = r.client.PendingNonceAt(ctx, account)
+			return err
+		})
+	return nonce, err
+}
+
+func (r *retryEthClient) SuggestGasPrice(ctx context.Context) (
+	price *big.Int, err error) {
+	err = retry(ctx, r.config, "eth_gasPrice", func(ctx context.Context) error {
+		price, err = r.client.SuggestGasPrice(ctx)
+		return err
+	})
+	return price, err
+}
+
+func (r *retryEthClient) SuggestGasTipCap(ctx context.Context) (
+	tip *big.Int, err error) {
+	err = retry(ctx, r.config, "eth_maxPriorityFeePerGas",
+		func(ctx context.Context) error {
+			tip, err = r.client.SuggestGasTipCap(ctx)
+			return err
+		})
+	return tip, err
+}
+
+func (r *retryEthClient) EstimateGas(ctx context.Context,
+	call ethereum.CallMsg) (gas uint64, err error) {
+	err = retry(ctx, r.config, "eth_estimateGas",
+		func(ctx context.Context) error {
+			gas, err = r.client.EstimateGas(ctx, call)
+			return err
+		})
+	return gas, err
+}
+
+// SendTransaction is not idempotent from our point of view: a send which
+// timed out may still have reached the node. A failed send is therefore
+// only retried once the node confirms it doesn't know the transaction,
+// and a transaction found on the node is treated as sent.
+func (r *retryEthClient) SendTransaction(ctx context.Context,
+	tx *types.Transaction) error {
+	return retry(ctx, r.config, "eth_sendRawTransaction",
+		func(callCtx context.Context) error {
+			err := r.client.SendTransaction(callCtx, tx)
+			if err != nil && isKnownTransactionError(err) {
+				// A previous attempt did reach the node
+				return nil
+			}
+			if err == nil || !isRetryableError(err) {
+				return err
+			}
+			lookupCtx, cancel := withCallTimeout(ctx, r.config.CallTimeout)
+			defer cancel()
+			_, _, err1 := r.client.TransactionByHash(lookupCtx, tx.Hash())
+			if err1 == nil {
+				fmt.Printf("info: transaction %s reached the node despite "+
+					"error: %v\n", tx.Hash().Hex(), err)
+				return nil
+			}
+			if errors.Is(err1, ethereum.NotFound) {
+				return err
+			}
+			return &unknownTxStatusError{tx.Hash(), err, err1}
+		})
+}
+
+func (r *retryEthClient) TransactionByHash(ctx context.Context,
+	hash common.Hash) (tx *types.Transaction, isPending bool, err error) {
+	err = retry(ctx, r.config, "eth_getTransactionByHash",
+		func(ctx context.Context) error {
+			tx, isPending, err = r.client.TransactionByHash(ctx, hash)
+			return err
+		})
+	return tx, isPending, err
+}
+
+func (r *retryEthClient) TransactionReceipt(ctx context.Context,
+	txHash common.Hash) (receipt *types.Receipt, err error) {
+	err = retry(ctx, r.config, "eth_getTransactionReceipt",
+		func(ctx context.Context) error {
+			receipt, err = r.client.TransactionReceipt(ctx, txHash)
+			return err
+		})
+	return receipt, err
+}
+
+func (r *retryEthClient) BalanceAt(ctx context.Context,
+	account common.Address, blockNumber *big.Int) (balance *big.Int,
+	err error) {
+	err = retry(ctx, r.config, "eth_getBalance", func(ctx context.Context) error {
+		balance, err = r.client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (r *retryEthClient) StorageAt(ctx context.Context,
+	account common.Address, key common.Hash, blockNumber *big.Int) (
+	value []byte, err error) {
+	err = retry(ctx, r.config, "eth_getStorageAt",
+		func(ctx context.Context) error {
+			value, err = r.client.StorageAt(ctx, account, key, blockNumber)
+			return err
+		})
+	return value, err
+}
+
+func (r *retryEthClient) FilterLogs(ctx context.Context,
+	query ethereum.FilterQuery) (logs []types.Log, err error) {
+	err = retry(ctx, r.config, "eth_getLogs", func(ctx context.Context) error {
+		logs, err = r.client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs is passed through untouched, the subscription
+// lives as long as its context and its failures are handled by the caller
+func (r *retryEthClient) SubscribeFilterLogs(ctx context.Context,
+	query ethereum.FilterQuery, ch chan<- types.Log) (
+	ethereum.Subscription, error) {
+	return r.client.SubscribeFilterLogs(ctx, query, ch)
+}
+
+func (r *retryEthClient) ChainID(ctx context.Context) (
+	chainId *big.Int, err error) {
+	err = retry(ctx, r.config, "eth_chainId", func(ctx context.Context) error {
+		chainId, err = r.client.ChainID(ctx)
+		return err
+	})
+	return chainId, err
+}
+
+func (r *retryEthClient) BlockNumber(ctx context.Context) (
+	blockNumber uint64, err error) {
+	err = retry(ctx, r.config, "eth_blockNumber",
+		func(ctx context.Context) error {
+			blockNumber, err = r.client.BlockNumber(ctx)
+			return err
+		})
+	return blockNumber, err
+}
+
+func (r *retryEthClient) Close() {
+	r.client.Close()
+}
+
+// retryRpcClient wraps an IRpcClient so that raw calls get the same
+// timeout and retries as the IEthClient ones. Only read methods are sent
+// through it.
+type retryRpcClient struct {
+	client IRpcClient
+	config RetryConfig
+}
+
+// NewRetryRpcClient wraps the raw client with the given retry configuration
+func NewRetryRpcClient(client IRpcClient, config RetryConfig) IRpcClient {
+	return &retryRpcClient{client: client, config: config}
+}
+
+func (r *retryRpcClient) CallContext(ctx context.Context,
+	result interface{}, method string, args ...interface{}) error {
+	return retry(ctx, r.config, method, func(ctx context.Context) error {
+		return r.client.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext retries the whole batch when it fails to go through,
+// failures of individual requests are left to the caller
+func (r *retryRpcClient) BatchCallContext(ctx context.Context,
+	b []rpc.BatchElem) error {
+	return retry(ctx, r.config, "batch", func(ctx context.Context) error {
+		return r.client.BatchCallContext(ctx, b)
+	})
+}
+
+func (r *retryRpcClient) Close() {
+	r.client.Close()
+}
@@ -0,0 +1,168 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// testRetryConfig retries quickly so that the tests don't wait
+var testRetryConfig = RetryConfig{
+	CallTimeout:    time.Second,
+	MaxRetries:     2,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     time.Millisecond,
+}
+
+func TestRetryEthClientChainID(t *testing.T) {
+	tests := []struct {
+		testName      string
+		errors        []error
+		expectedCalls int
+		expectedError error
+	}{
+		{
+			testName:      "ChainID successful on first attempt.",
+			errors:        []error{nil},
+			expectedCalls: 1,
+			expectedError: nil,
+		},
+		{
+			testName: "ChainID successful after connection reset and 503.",
+			errors: []error{
+				errors.New("read tcp 127.0.0.1:8545: connection reset by peer"),
+				rpc.HTTPError{StatusCode: 503, Status: "503 Service Unavailable"},
+				nil,
+			},
+			expectedCalls: 3,
+			expectedError: nil,
+		},
+		{
+			testName: "ChainID failed retries exhausted.",
+			errors: []error{
+				rpc.HTTPError{StatusCode: 429, Status: "429 Too Many Requests"},
+				errors.New("header not found"),
+				errors.New("header not found"),
+			},
+			expectedCalls: 3,
+			expectedError: errors.New("header not found"),
+		},
+		{
+			testName: "ChainID failed not retryable.",
+			errors: []error{
+				rpc.HTTPError{StatusCode: 401, Status: "401 Unauthorized"},
+			},
+			expectedCalls: 1,
+			expectedError: rpc.HTTPError{StatusCode: 401,
+				Status: "401 Unauthorized"},
+		},
+	}
+	sleepFunc = func(_ context.Context, _ time.Duration) error { return nil }
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mEthClient := new(MockedEthClient)
+			for _, err := range tt.errors {
+				mEthClient.On("ChainID", mock.Anything).Return(
+					big.NewInt(1337), err).Once()
+			}
+			client := NewRetryEthClient(mEthClient, testRetryConfig)
+			chainId, err := client.ChainID(context.Background())
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, chainId, big.NewInt(1337))
+			}
+			mEthClient.AssertNumberOfCalls(t, "ChainID", tt.expectedCalls)
+		})
+	}
+}
+
+func TestRetryEthClientSendTransaction(t *testing.T) {
+	tx := types.NewTransaction(0, [20]byte{}, big.NewInt(0), 21000,
+		big.NewInt(1000), nil)
+	timeoutErr := errors.New("Post \"http://127.0.0.1:8545\": i/o timeout")
+	tests := []struct {
+		testName      string
+		sendErrors    []error
+		lookupError   error
+		expectedSends int
+		expectedError error
+	}{
+		{
+			testName:      "SendTransaction successful on first attempt.",
+			sendErrors:    []error{nil},
+			expectedSends: 1,
+			expectedError: nil,
+		},
+		{
+			testName:      "SendTransaction timed out but reached the node.",
+			sendErrors:    []error{timeoutErr},
+			lookupError:   nil,
+			expectedSends: 1,
+			expectedError: nil,
+		},
+		{
+			testName:      "SendTransaction timed out and resent once unknown.",
+			sendErrors:    []error{timeoutErr, nil},
+			lookupError:   ethereum.NotFound,
+			expectedSends: 2,
+			expectedError: nil,
+		},
+		{
+			testName:      "SendTransaction resent and already known.",
+			sendErrors:    []error{timeoutErr, errors.New("already known")},
+			lookupError:   ethereum.NotFound,
+			expectedSends: 2,
+			expectedError: nil,
+		},
+		{
+			testName:      "SendTransaction not resent when status unknown.",
+			sendErrors:    []error{timeoutErr},
+			lookupError:   errors.New("connection refused"),
+			expectedSends: 1,
+			expectedError: errors.New("error: sending transaction " +
+				tx.Hash().Hex() + " failed with " + timeoutErr.Error() +
+				" and its status is unknown: connection refused"),
+		},
+		{
+			testName:      "SendTransaction not resent when rejected.",
+			sendErrors:    []error{errors.New("nonce too low")},
+			expectedSends: 1,
+			expectedError: errors.New("nonce too low"),
+		},
+	}
+	sleepFunc = func(_ context.Context, _ time.Duration) error { return nil }
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mEthClient := new(MockedEthClient)
+			for _, err := range tt.sendErrors {
+				mEthClient.On("SendTransaction", mock.Anything, tx).Return(
+					err).Once()
+			}
+			mEthClient.On("TransactionByHash", mock.Anything, tx.Hash()).Return(
+				tx, true, tt.lookupError)
+			client := NewRetryEthClient(mEthClient, RetryConfig{
+				CallTimeout:    time.Second,
+				MaxRetries:     1,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     time.Millisecond,
+			})
+			err := client.SendTransaction(context.Background(), tx)
+			if tt.expectedError != nil {
+				assert.Equal(t, err.Error(), tt.expectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			mEthClient.AssertNumberOfCalls(t, "SendTransaction",
+				tt.expectedSends)
+		})
+	}
+}
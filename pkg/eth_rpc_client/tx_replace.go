@@ -0,0 +1,262 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+	"time"
+)
+
+// MinPriceBump is the fee increase in percent nodes require by default to
+// replace a pending transaction with another one of the same nonce
+const MinPriceBump = 10
+
+// cancelGasLimit is the gas of the zero value self transfer replacing a
+// cancelled transaction
+const cancelGasLimit = 21000
+
+// SpeedUpTransaction sends the pending transaction of the account of the
+// options again with the same nonce and fees bumped by bumpPercent, or up
+// to the fees currently suggested by the node when they are higher. The
+// replacement is signed with the signer of the options. The original and
+// the replacement transactions are returned.
+func (e *EthRpcClient) SpeedUpTransaction(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+	chainId *big.Int,
+	hash common.Hash,
+	bumpPercent int,
+) (*types.Transaction, *types.Transaction, error) {
+	return e.replaceTransaction(ctx, auth, chainId, hash, bumpPercent,
+		false)
+}
+
+// CancelTransaction replaces the pending transaction of the account of the
+// options with a zero value transfer to itself using the same nonce and
+// fees bumped by bumpPercent, so that the original transaction can't be
+// mined anymore. The replacement is signed with the signer of the options.
+// The original and the replacement transactions are returned.
+func (e *EthRpcClient) CancelTransaction(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+	chainId *big.Int,
+	hash common.Hash,
+	bumpPercent int,
+) (*types.Transaction, *types.Transaction, error) {
+	return e.replaceTransaction(ctx, auth, chainId, hash, bumpPercent,
+		true)
+}
+
+// replaceTransaction builds, signs and sends the replacement of a pending
+// transaction sent by the account
+func (e *EthRpcClient) replaceTransaction(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+	chainId *big.Int,
+	hash common.Hash,
+	bumpPercent int,
+	cancel bool,
+) (*types.Transaction, *types.Transaction, error) {
+	if bumpPercent < MinPriceBump {
+		return nil, nil, fmt.Errorf("error: the fee bump must be at least "+
+			"%d%% for nodes to accept the replacement", MinPriceBump)
+	}
+	original, isPending, err := e.EthClient.TransactionByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, nil, fmt.Errorf("error: transaction %s is unknown "+
+				"to the node", hash.Hex())
+		}
+		return nil, nil, fmt.Errorf("error: failed to retrieve transaction "+
+			"%s: %v", hash.Hex(), err)
+	}
+	if !isPending {
+		return nil, nil, fmt.Errorf("error: transaction %s is already mined "+
+			"and can't be replaced anymore", hash.Hex())
+	}
+	signer := types.LatestSignerForChainID(chainId)
+	from, err1 := types.Sender(signer, original)
+	if err1 != nil {
+		return nil, nil, fmt.Errorf("error: failed to recover the sender of "+
+			"transaction %s: %v", hash.Hex(), err1)
+	}
+	if from != auth.From {
+		return nil, nil, fmt.Errorf("error: transaction %s was sent by %s, "+
+			"only its sender can replace it", hash.Hex(), from.Hex())
+	}
+
+	to, value, data, gas := original.To(), original.Value(), original.Data(),
+		original.Gas()
+	if cancel {
+		self := auth.From
+		to, value, data, gas = &self, new(big.Int), nil, cancelGasLimit
+	}
+	var replacement types.TxData
+	switch original.Type() {
+	case types.DynamicFeeTxType:
+		tip, feeCap, err2 := e.replacementDynamicFees(ctx, original,
+			bumpPercent)
+		if err2 != nil {
+			return nil, nil, err2
+		}
+		replacement = &types.DynamicFeeTx{
+			ChainID:    chainId,
+			Nonce:      original.Nonce(),
+			GasTipCap:  tip,
+			GasFeeCap:  feeCap,
+			Gas:        gas,
+			To:         to,
+			Value:      value,
+			Data:       data,
+			AccessList: original.AccessList(),
+		}
+	default:
+		gasPrice, err3 := e.replacementGasPrice(ctx, original, bumpPercent)
+		if err3 != nil {
+			return nil, nil, err3
+		}
+		if original.Type() == types.AccessListTxType {
+			replacement = &types.AccessListTx{
+				ChainID:    chainId,
+				Nonce:      original.Nonce(),
+				GasPrice:   gasPrice,
+				Gas:        gas,
+				To:         to,
+				Value:      value,
+				Data:       data,
+				AccessList: original.AccessList(),
+			}
+		} else {
+			replacement = &types.LegacyTx{
+				Nonce:    original.Nonce(),
+				GasPrice: gasPrice,
+				Gas:      gas,
+				To:       to,
+				Value:    value,
+				Data:     data,
+			}
+		}
+	}
+	signedTx, err4 := auth.Signer(auth.From, types.NewTx(replacement))
+	if err4 != nil {
+		return nil, nil, err4
+	}
+	err5 := e.EthClient.SendTransaction(ctx, signedTx)
+	if err5 != nil {
+		return nil, nil, fmt.Errorf("error: failed to send the replacement "+
+			"of transaction %s: %v", hash.Hex(), err5)
+	}
+	return original, signedTx, nil
+}
+
+// replacementGasPrice bumps the gas price of the original transaction,
+// the price suggested by the node is used instead when it is higher
+func (e *EthRpcClient) replacementGasPrice(
+	ctx context.Context,
+	original *types.Transaction,
+	bumpPercent int,
+) (*big.Int, error) {
+	suggested, err := e.EthClient.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to retrieve the suggested gas "+
+			"price: %v", err)
+	}
+	return maxBig(BumpFee(original.GasPrice(), bumpPercent), suggested), nil
+}
+
+// replacementDynamicFees bumps the tip and the fee cap of the original
+// transaction, the suggested values are used instead when they are higher
+func (e *EthRpcClient) replacementDynamicFees(
+	ctx context.Context,
+	original *types.Transaction,
+	bumpPercent int,
+) (*big.Int, *big.Int, error) {
+	suggestedTip, err := e.EthClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error: failed to retrieve the suggested "+
+			"gas tip: %v", err)
+	}
+	suggestedPrice, err1 := e.EthClient.SuggestGasPrice(ctx)
+	if err1 != nil {
+		return nil, nil, fmt.Errorf("error: failed to retrieve the suggested "+
+			"gas price: %v", err1)
+	}
+	tip := maxBig(BumpFee(original.GasTipCap(), bumpPercent), suggestedTip)
+	feeCap := maxBig(BumpFee(original.GasFeeCap(), bumpPercent),
+		suggestedPrice)
+	// The fee cap can never be below the tip
+	return tip, maxBig(feeCap, tip), nil
+}
+
+// BumpFee increases the fee by percent, rounding up so that the
+// replacement minimum of the nodes is always met
+func BumpFee(fee *big.Int, percent int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(int64(100+percent)))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
+
+// maxBig returns the greater of the two values
+func maxBig(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// WaitForReplacement waits until one of the transactions sharing the nonce
+// of the account is mined and returns its receipt. An error is returned
+// when the nonce was used by a transaction which isn't one of the given
+// ones, such as an earlier replacement, or when the context is done.
+func (e *EthRpcClient) WaitForReplacement(
+	ctx context.Context,
+	account common.Address,
+	nonce uint64,
+	hashes []common.Hash,
+	interval time.Duration,
+) (*types.Receipt, error) {
+	for {
+		for _, hash := range hashes {
+			receipt, err := e.EthClient.TransactionReceipt(ctx, hash)
+			if err == nil && receipt != nil {
+				return receipt, nil
+			}
+			if err != nil && !errors.Is(err, ethereum.NotFound) {
+				fmt.Printf("warning: failed to retrieve the receipt of %s: "+
+					"%v\n", hash.Hex(), err)
+			}
+		}
+		// The nonce is only consumed once one of the transactions is mined
+		var count hexutil.Uint64
+		err1 := e.RpcClient.CallContext(ctx, &count,
+			"eth_getTransactionCount", account, "latest")
+		if err1 == nil && uint64(count) > nonce {
+			// Check the receipts again in case a transaction was mined
+			// right after they were checked
+			for _, hash := range hashes {
+				receipt, err2 := e.EthClient.TransactionReceipt(ctx, hash)
+				if err2 == nil && receipt != nil {
+					return receipt, nil
+				}
+			}
+			return nil, fmt.Errorf("error: nonce %d of %s was used by "+
+				"another transaction", nonce, account.Hex())
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("error: none of the transactions with "+
+				"nonce %d was mined in time: %v", nonce, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
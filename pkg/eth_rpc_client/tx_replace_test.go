@@ -0,0 +1,314 @@
+package eth_rpc_client
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	ea "go-evm-client/pkg/eth_account"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestBumpFee(t *testing.T) {
+	tests := []struct {
+		testName    string
+		fee         *big.Int
+		percent     int
+		expectedFee *big.Int
+	}{
+		{
+			testName:    "BumpFee exact percentage.",
+			fee:         big.NewInt(1000),
+			percent:     10,
+			expectedFee: big.NewInt(1100),
+		},
+		{
+			testName:    "BumpFee rounds up.",
+			fee:         big.NewInt(1001),
+			percent:     10,
+			expectedFee: big.NewInt(1102),
+		},
+		{
+			testName:    "BumpFee increases tiny fees.",
+			fee:         big.NewInt(1),
+			percent:     10,
+			expectedFee: big.NewInt(2),
+		},
+		{
+			testName:    "BumpFee increases zero fees.",
+			fee:         big.NewInt(0),
+			percent:     10,
+			expectedFee: big.NewInt(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(t, tt.expectedFee, BumpFee(tt.fee, tt.percent))
+		})
+	}
+}
+
+func TestEthRpcClientReplaceTransaction(t *testing.T) {
+	chainId := big.NewInt(1337)
+	signer := types.LatestSignerForChainID(chainId)
+	userAccount, _ := ea.CreateAccount(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	otherAccount, _ := ea.CreateAccount(
+		"0000000000000000000000000000000000000000000000000000000000000002")
+	recipient := common.HexToAddress(
+		"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	legacyTx, _ := types.SignNewTx(userAccount.PrivateKey, signer,
+		&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(1000), Gas: 60000,
+			To: &recipient, Value: big.NewInt(5), Data: []byte{1, 2}})
+	dynamicTx, _ := types.SignNewTx(userAccount.PrivateKey, signer,
+		&types.DynamicFeeTx{ChainID: chainId, Nonce: 3,
+			GasTipCap: big.NewInt(100), GasFeeCap: big.NewInt(2000),
+			Gas: 60000, To: &recipient, Value: big.NewInt(5)})
+	otherTx, _ := types.SignNewTx(otherAccount.PrivateKey, signer,
+		&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1000), Gas: 21000,
+			To: &recipient, Value: big.NewInt(5)})
+	tests := []struct {
+		testName          string
+		tx                *types.Transaction
+		isPending         bool
+		lookupError       error
+		signerError       error
+		cancel            bool
+		bumpPercent       int
+		suggestedPrice    *big.Int
+		suggestedTip      *big.Int
+		expectedTo        common.Address
+		expectedValue     *big.Int
+		expectedGas       uint64
+		expectedData      []byte
+		expectedGasPrice  *big.Int
+		expectedGasTipCap *big.Int
+		expectedError     error
+	}{
+		{
+			testName:         "SpeedUpTransaction legacy bumped.",
+			tx:               legacyTx,
+			isPending:        true,
+			bumpPercent:      10,
+			suggestedPrice:   big.NewInt(500),
+			expectedTo:       recipient,
+			expectedValue:    big.NewInt(5),
+			expectedGas:      60000,
+			expectedData:     []byte{1, 2},
+			expectedGasPrice: big.NewInt(1100),
+		},
+		{
+			testName:         "SpeedUpTransaction legacy to suggested price.",
+			tx:               legacyTx,
+			isPending:        true,
+			bumpPercent:      10,
+			suggestedPrice:   big.NewInt(5000),
+			expectedTo:       recipient,
+			expectedValue:    big.NewInt(5),
+			expectedGas:      60000,
+			expectedData:     []byte{1, 2},
+			expectedGasPrice: big.NewInt(5000),
+		},
+		{
+			testName:          "SpeedUpTransaction dynamic fees bumped.",
+			tx:                dynamicTx,
+			isPending:         true,
+			bumpPercent:       20,
+			suggestedPrice:    big.NewInt(1000),
+			suggestedTip:      big.NewInt(1),
+			expectedTo:        recipient,
+			expectedValue:     big.NewInt(5),
+			expectedGas:       60000,
+			expectedData:      nil,
+			expectedGasPrice:  big.NewInt(2400),
+			expectedGasTipCap: big.NewInt(120),
+		},
+		{
+			testName:         "CancelTransaction legacy self transfer.",
+			tx:               legacyTx,
+			isPending:        true,
+			cancel:           true,
+			bumpPercent:      10,
+			suggestedPrice:   big.NewInt(500),
+			expectedTo:       userAccount.Account,
+			expectedValue:    big.NewInt(0),
+			expectedGas:      21000,
+			expectedData:     nil,
+			expectedGasPrice: big.NewInt(1100),
+		},
+		{
+			testName:    "ReplaceTransaction fail bump too low.",
+			tx:          legacyTx,
+			isPending:   true,
+			bumpPercent: 5,
+			expectedError: errors.New("error: the fee bump must be at " +
+				"least 10% for nodes to accept the replacement"),
+		},
+		{
+			testName:    "ReplaceTransaction fail unknown transaction.",
+			tx:          legacyTx,
+			lookupError: ethereum.NotFound,
+			bumpPercent: 10,
+			expectedError: errors.New("error: transaction " +
+				legacyTx.Hash().Hex() + " is unknown to the node"),
+		},
+		{
+			testName:    "ReplaceTransaction fail already mined.",
+			tx:          legacyTx,
+			isPending:   false,
+			bumpPercent: 10,
+			expectedError: errors.New("error: transaction " +
+				legacyTx.Hash().Hex() + " is already mined and can't be " +
+				"replaced anymore"),
+		},
+		{
+			testName:    "ReplaceTransaction fail other sender.",
+			tx:          otherTx,
+			isPending:   true,
+			bumpPercent: 10,
+			expectedError: errors.New("error: transaction " +
+				otherTx.Hash().Hex() + " was sent by " +
+				otherAccount.Account.Hex() + ", only its sender can " +
+				"replace it"),
+		},
+		{
+			testName:       "ReplaceTransaction fail signer refuses.",
+			tx:             legacyTx,
+			isPending:      true,
+			bumpPercent:    10,
+			suggestedPrice: big.NewInt(500),
+			signerError:    errors.New("error: blocked by the policy"),
+			expectedError:  errors.New("error: blocked by the policy"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mEthClient := new(MockedEthClient)
+			mEthClient.On("TransactionByHash", mock.Anything,
+				tt.tx.Hash()).Return(tt.tx, tt.isPending, tt.lookupError)
+			mEthClient.On("SuggestGasPrice", mock.Anything).Return(
+				tt.suggestedPrice, nil)
+			mEthClient.On("SuggestGasTipCap", mock.Anything).Return(
+				tt.suggestedTip, nil)
+			mEthClient.On("SendTransaction", mock.Anything,
+				mock.Anything).Return(nil)
+			ethRpcClient := EthRpcClient{EthClient: mEthClient}
+			auth, _ := bind.NewKeyedTransactorWithChainID(
+				userAccount.PrivateKey, chainId)
+			if tt.signerError != nil {
+				auth.Signer = func(common.Address, *types.Transaction) (
+					*types.Transaction, error) {
+					return nil, tt.signerError
+				}
+			}
+			replace := ethRpcClient.SpeedUpTransaction
+			if tt.cancel {
+				replace = ethRpcClient.CancelTransaction
+			}
+			original, replacement, err := replace(context.Background(),
+				auth, chainId, tt.tx.Hash(), tt.bumpPercent)
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				mEthClient.AssertNotCalled(t, "SendTransaction",
+					mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.tx.Hash(), original.Hash())
+			assert.Equal(t, tt.tx.Type(), replacement.Type())
+			assert.Equal(t, tt.tx.Nonce(), replacement.Nonce())
+			assert.Equal(t, tt.expectedTo, *replacement.To())
+			assert.Equal(t, tt.expectedValue, replacement.Value())
+			assert.Equal(t, tt.expectedGas, replacement.Gas())
+			assert.Equal(t, tt.expectedData, replacement.Data())
+			assert.Equal(t, tt.expectedGasPrice, replacement.GasFeeCap())
+			if tt.expectedGasTipCap != nil {
+				assert.Equal(t, tt.expectedGasTipCap, replacement.GasTipCap())
+			}
+			from, err1 := types.Sender(signer, replacement)
+			assert.NoError(t, err1)
+			assert.Equal(t, userAccount.Account, from)
+			mEthClient.AssertCalled(t, "SendTransaction", mock.Anything,
+				replacement)
+		})
+	}
+}
+
+func TestEthRpcClientWaitForReplacement(t *testing.T) {
+	account := common.HexToAddress(
+		"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	original := common.HexToHash("0x01")
+	replacement := common.HexToHash("0x02")
+	tests := []struct {
+		testName        string
+		receipts        map[common.Hash]*types.Receipt
+		nonceCount      string
+		expectedReceipt *types.Receipt
+		expectedError   error
+	}{
+		{
+			testName: "WaitForReplacement replacement mined.",
+			receipts: map[common.Hash]*types.Receipt{
+				replacement: {TxHash: replacement},
+			},
+			nonceCount:      `"0x8"`,
+			expectedReceipt: &types.Receipt{TxHash: replacement},
+		},
+		{
+			testName: "WaitForReplacement original mined.",
+			receipts: map[common.Hash]*types.Receipt{
+				original: {TxHash: original},
+			},
+			nonceCount:      `"0x8"`,
+			expectedReceipt: &types.Receipt{TxHash: original},
+		},
+		{
+			testName:   "WaitForReplacement fail nonce used by another one.",
+			receipts:   map[common.Hash]*types.Receipt{},
+			nonceCount: `"0x8"`,
+			expectedError: errors.New("error: nonce 7 of " + account.Hex() +
+				" was used by another transaction"),
+		},
+		{
+			testName:   "WaitForReplacement fail timeout.",
+			receipts:   map[common.Hash]*types.Receipt{},
+			nonceCount: `"0x7"`,
+			expectedError: errors.New("error: none of the transactions " +
+				"with nonce 7 was mined in time: context deadline exceeded"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			mEthClient := new(MockedEthClient)
+			for _, hash := range []common.Hash{original, replacement} {
+				receipt, ok := tt.receipts[hash]
+				if ok {
+					mEthClient.On("TransactionReceipt", mock.Anything,
+						hash).Return(receipt, nil)
+				} else {
+					mEthClient.On("TransactionReceipt", mock.Anything,
+						hash).Return((*types.Receipt)(nil), ethereum.NotFound)
+				}
+			}
+			mRpcClient := new(MockedRpcClient)
+			mRpcClient.On("CallContext", mock.Anything,
+				"eth_getTransactionCount", mock.Anything).Return(
+				tt.nonceCount, nil)
+			ethRpcClient := EthRpcClient{EthClient: mEthClient,
+				RpcClient: mRpcClient}
+			ctx, cancel := context.WithTimeout(context.Background(),
+				50*time.Millisecond)
+			defer cancel()
+			receipt, err := ethRpcClient.WaitForReplacement(ctx, account, 7,
+				[]common.Hash{original, replacement}, 10*time.Millisecond)
+			assert.Equal(t, tt.expectedError, err)
+			assert.Equal(t, tt.expectedReceipt, receipt)
+		})
+	}
+}
@@ -0,0 +1,457 @@
+package load_generator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	erc20 "go-evm-client/pkg/contracts/erc20_token"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// IBackend is what the load generator needs from the chain, it is
+// implemented by the RPC client as well as by the simulated backend
+type IBackend interface {
+	bind.ContractBackend
+	TransactionReceipt(
+		ctx context.Context,
+		txHash common.Hash,
+	) (*types.Receipt, error)
+	BalanceAt(
+		ctx context.Context,
+		account common.Address,
+		blockNumber *big.Int,
+	) (*big.Int, error)
+}
+
+// committer is implemented by the simulated backend, which only mines the
+// pending transactions when asked to
+type committer interface {
+	Commit()
+}
+
+// Config describes the load to generate
+type Config struct {
+	// Senders is the number of accounts derived from the funder which send
+	// the transactions, each of them sends its own nonces in order
+	Senders int
+	// Token is the ERC20 token transferred, native coins are sent when nil
+	Token *common.Address
+	// Rate is the number of transactions submitted per second, zero sends
+	// them as fast as Concurrency allows
+	Rate float64
+	// Concurrency is the maximum number of transactions waiting to be
+	// included at the same time
+	Concurrency int
+	// Duration is how long transactions are submitted
+	Duration time.Duration
+	GasLimit uint64
+	GasPrice *big.Int
+	// FundAmount is the native balance every sender is topped up to
+	FundAmount *big.Int
+	// TokenAmount is the token balance every sender is topped up to
+	TokenAmount *big.Int
+	// PollInterval is the delay between two inclusion checks
+	PollInterval time.Duration
+	// InclusionTimeout is how long the transactions still pending at the
+	// end of the duration are waited for
+	InclusionTimeout time.Duration
+}
+
+// sender is a derived account sending transactions with its own nonces
+type sender struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+	nonce   uint64
+}
+
+// LoadGenerator funds derived sender accounts and submits transactions
+// from them at a target rate or concurrency while tracking each of them
+// until it is included
+type LoadGenerator struct {
+	backend     IBackend
+	chainId     *big.Int
+	funder      *sender
+	senders     []*sender
+	config      Config
+	transferABI abi.ABI
+	signer      types.Signer
+	// FunderSigner signs the funding transactions of the funder, it can be
+	// wrapped to check or record them. The transactions of the senders are
+	// always signed with their derived keys.
+	FunderSigner bind.SignerFn
+}
+
+// NewLoadGenerator derives the sender accounts from the funder key, the
+// same funder always derives the same senders so that they can be reused
+func NewLoadGenerator(
+	backend IBackend,
+	chainId *big.Int,
+	funderKey *ecdsa.PrivateKey,
+	config Config,
+) (*LoadGenerator, error) {
+	if config.Senders <= 0 {
+		return nil, fmt.Errorf("error: at least one sender is needed")
+	}
+	if config.Concurrency <= 0 {
+		return nil, fmt.Errorf("error: the concurrency must be positive")
+	}
+	if config.Rate < 0 {
+		return nil, fmt.Errorf("error: the rate can't be negative")
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = time.Second
+	}
+	parsed, err := erc20.ERC20MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	senders := make([]*sender, config.Senders)
+	for i := range senders {
+		key, err1 := DeriveKey(funderKey, i)
+		if err1 != nil {
+			return nil, err1
+		}
+		senders[i] = &sender{key: key, address: crypto.PubkeyToAddress(
+			key.PublicKey)}
+	}
+	signer := types.LatestSignerForChainID(chainId)
+	return &LoadGenerator{
+		backend: backend,
+		chainId: chainId,
+		funder: &sender{key: funderKey, address: crypto.PubkeyToAddress(
+			funderKey.PublicKey)},
+		senders:     senders,
+		config:      config,
+		transferABI: *parsed,
+		signer:      signer,
+		FunderSigner: func(from common.Address, tx *types.Transaction) (
+			*types.Transaction, error) {
+			return types.SignTx(tx, signer, funderKey)
+		},
+	}, nil
+}
+
+// DeriveKey derives the private key of the sender at the index from the
+// funder key
+func DeriveKey(funderKey *ecdsa.PrivateKey, index int) (*ecdsa.PrivateKey,
+	error) {
+	indexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBytes, uint64(index))
+	seed := crypto.Keccak256(crypto.FromECDSA(funderKey),
+		[]byte("go-evm-client loadtest"), indexBytes)
+	return crypto.ToECDSA(seed)
+}
+
+// Senders returns the addresses of the derived sender accounts
+func (l *LoadGenerator) Senders() []common.Address {
+	addresses := make([]common.Address, len(l.senders))
+	for i, s := range l.senders {
+		addresses[i] = s.address
+	}
+	return addresses
+}
+
+// Fund tops the senders up to the configured native and token balances
+// from the funder and waits for the funding to be included. The funder
+// balances are checked before anything is sent.
+func (l *LoadGenerator) Fund(ctx context.Context) error {
+	type topUp struct {
+		to     common.Address
+		native *big.Int
+		token  *big.Int
+	}
+	var topUps []topUp
+	nativeTotal, tokenTotal := new(big.Int), new(big.Int)
+	for _, s := range l.senders {
+		native, err := l.backend.BalanceAt(ctx, s.address, nil)
+		if err != nil {
+			return fmt.Errorf("error: failed to read the balance of %s: %v",
+				s.address.Hex(), err)
+		}
+		up := topUp{to: s.address}
+		if native.Cmp(l.config.FundAmount) < 0 {
+			up.native = new(big.Int).Sub(l.config.FundAmount, native)
+			nativeTotal.Add(nativeTotal, up.native)
+		}
+		if l.config.Token != nil {
+			tokens, err1 := l.tokenBalance(ctx, s.address)
+			if err1 != nil {
+				return err1
+			}
+			if tokens.Cmp(l.config.TokenAmount) < 0 {
+				up.token = new(big.Int).Sub(l.config.TokenAmount, tokens)
+				tokenTotal.Add(tokenTotal, up.token)
+			}
+		}
+		if up.native != nil || up.token != nil {
+			topUps = append(topUps, up)
+		}
+	}
+	if len(topUps) == 0 {
+		fmt.Printf("info: The %d senders are already funded\n",
+			len(l.senders))
+		return nil
+	}
+
+	// Every funding transaction pays for its gas on top of the value
+	funderBalance, err2 := l.backend.BalanceAt(ctx, l.funder.address, nil)
+	if err2 != nil {
+		return fmt.Errorf("error: failed to read the funder balance: %v", err2)
+	}
+	gasCost := new(big.Int).Mul(l.config.GasPrice,
+		new(big.Int).SetUint64(l.config.GasLimit*uint64(2*len(topUps))))
+	needed := new(big.Int).Add(nativeTotal, gasCost)
+	if funderBalance.Cmp(needed) < 0 {
+		return fmt.Errorf("error: funding the senders needs %d wei but the "+
+			"funder %s only holds %d wei", needed, l.funder.address.Hex(),
+			funderBalance)
+	}
+	if tokenTotal.Sign() > 0 {
+		funderTokens, err3 := l.tokenBalance(ctx, l.funder.address)
+		if err3 != nil {
+			return err3
+		}
+		if funderTokens.Cmp(tokenTotal) < 0 {
+			return fmt.Errorf("error: funding the senders needs %d tokens "+
+				"but the funder %s only holds %d", tokenTotal,
+				l.funder.address.Hex(), funderTokens)
+		}
+	}
+
+	nonce, err4 := l.backend.PendingNonceAt(ctx, l.funder.address)
+	if err4 != nil {
+		return fmt.Errorf("error: failed to retrieve the funder nonce: %v",
+			err4)
+	}
+	l.funder.nonce = nonce
+	var hashes []common.Hash
+	for _, up := range topUps {
+		if up.native != nil {
+			tx, err5 := l.send(ctx, l.funder, up.to, up.native, nil)
+			if err5 != nil {
+				return fmt.Errorf("error: failed to fund %s: %v", up.to.Hex(),
+					err5)
+			}
+			hashes = append(hashes, tx.Hash())
+		}
+		if up.token != nil {
+			data, _ := l.transferABI.Pack("transfer", up.to, up.token)
+			tx, err6 := l.send(ctx, l.funder, *l.config.Token, nil, data)
+			if err6 != nil {
+				return fmt.Errorf("error: failed to fund %s with tokens: %v",
+					up.to.Hex(), err6)
+			}
+			hashes = append(hashes, tx.Hash())
+		}
+	}
+	fmt.Printf("info: Funding %d senders with %d transactions\n",
+		len(topUps), len(hashes))
+	return l.waitFunding(ctx, hashes)
+}
+
+// waitFunding waits for the funding transactions to be included
+func (l *LoadGenerator) waitFunding(ctx context.Context,
+	hashes []common.Hash) error {
+	timeout := l.config.InclusionTimeout
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, hash := range hashes {
+		for {
+			l.commit()
+			receipt, err := l.backend.TransactionReceipt(ctx, hash)
+			if err == nil && receipt != nil {
+				if receipt.Status != types.ReceiptStatusSuccessful {
+					return fmt.Errorf("error: funding transaction %s "+
+						"reverted", hash.Hex())
+				}
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("error: funding transaction %s wasn't "+
+					"included after %s", hash.Hex(), timeout)
+			case <-time.After(l.config.PollInterval):
+			}
+		}
+	}
+	return nil
+}
+
+// tokenBalance reads the token balance of the account
+func (l *LoadGenerator) tokenBalance(ctx context.Context,
+	account common.Address) (*big.Int, error) {
+	data, _ := l.transferABI.Pack("balanceOf", account)
+	output, err := l.backend.CallContract(ctx, ethereum.CallMsg{
+		To:   l.config.Token,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read the token balance of "+
+			"%s: %v", account.Hex(), err)
+	}
+	values, err1 := l.transferABI.Unpack("balanceOf", output)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: failed to read the token balance of "+
+			"%s, is the address an ERC20 token? %v", account.Hex(), err1)
+	}
+	return values[0].(*big.Int), nil
+}
+
+// send signs and submits a transaction with the next nonce of the sender,
+// the nonce is only consumed when the node accepts the transaction
+func (l *LoadGenerator) send(
+	ctx context.Context,
+	from *sender,
+	to common.Address,
+	value *big.Int,
+	data []byte,
+) (*types.Transaction, error) {
+	if value == nil {
+		value = new(big.Int)
+	}
+	unsigned := types.NewTransaction(from.nonce, to, value,
+		l.config.GasLimit, l.config.GasPrice, data)
+	var tx *types.Transaction
+	var err error
+	if from == l.funder {
+		tx, err = l.FunderSigner(from.address, unsigned)
+	} else {
+		tx, err = types.SignTx(unsigned, l.signer, from.key)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err1 := l.backend.SendTransaction(ctx, tx)
+	if err1 != nil {
+		return nil, err1
+	}
+	from.nonce++
+	return tx, nil
+}
+
+// commit mines the pending transactions of the simulated backend
+func (l *LoadGenerator) commit() {
+	if c, ok := l.backend.(committer); ok {
+		c.Commit()
+	}
+}
+
+// Run submits transactions from every sender for the configured duration
+// and tracks them until they are included or the inclusion timeout
+// expires. Each sender sends to the next one so that the balances only
+// circulate between the senders.
+func (l *LoadGenerator) Run(ctx context.Context) (*Report, error) {
+	for _, s := range l.senders {
+		nonce, err := l.backend.PendingNonceAt(ctx, s.address)
+		if err != nil {
+			return nil, fmt.Errorf("error: failed to retrieve the nonce of "+
+				"%s: %v", s.address.Hex(), err)
+		}
+		s.nonce = nonce
+	}
+	report := newReport()
+	tracker := newTracker(l.backend, report, l.config.Concurrency)
+	trackerCtx, stopTracker := context.WithCancel(ctx)
+	trackerDone := make(chan struct{})
+	go func() {
+		tracker.run(trackerCtx, l.config.PollInterval, l.commit)
+		close(trackerDone)
+	}()
+
+	sendCtx, stopSending := context.WithTimeout(ctx, l.config.Duration)
+	defer stopSending()
+	var tickets <-chan time.Time
+	if l.config.Rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) /
+			l.config.Rate))
+		defer ticker.Stop()
+		tickets = ticker.C
+	}
+	report.start = time.Now()
+	var wg sync.WaitGroup
+	for i, s := range l.senders {
+		wg.Add(1)
+		go func(from *sender, to common.Address) {
+			defer wg.Done()
+			l.sendLoop(sendCtx, from, to, tickets, tracker)
+		}(s, l.senders[(i+1)%len(l.senders)].address)
+	}
+	wg.Wait()
+	report.end = time.Now()
+
+	// Give the transactions still pending some time to be included
+	tracker.waitIdle(ctx, l.config.InclusionTimeout)
+	stopTracker()
+	<-trackerDone
+	err1 := report.loadBlocks(ctx, l.backend)
+	if err1 != nil {
+		return nil, err1
+	}
+	return report, nil
+}
+
+// sendLoop submits transactions from the sender until the context is
+// done, waiting for a ticket when a rate is set and for a free in flight
+// slot in any case
+func (l *LoadGenerator) sendLoop(
+	ctx context.Context,
+	from *sender,
+	to common.Address,
+	tickets <-chan time.Time,
+	tracker *tracker,
+) {
+	value, data := big.NewInt(1), []byte(nil)
+	target := to
+	if l.config.Token != nil {
+		value, target = nil, *l.config.Token
+		data, _ = l.transferABI.Pack("transfer", to, big.NewInt(1))
+	}
+	for {
+		if tickets != nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tickets:
+			}
+		}
+		if !tracker.acquire(ctx) {
+			return
+		}
+		submitted := time.Now()
+		tx, err := l.send(ctx, from, target, value, data)
+		latency := time.Since(submitted)
+		if err != nil {
+			tracker.release()
+			// Sends interrupted by the end of the duration aren't failures
+			if ctx.Err() != nil {
+				return
+			}
+			tracker.report.addFailure(classifyError(err))
+			// Resynchronize the nonce, the node may have taken it anyway
+			nonce, err1 := l.backend.PendingNonceAt(ctx, from.address)
+			if err1 == nil {
+				from.nonce = nonce
+			}
+			// Back off instead of flooding a node which rejects everything
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(l.config.PollInterval):
+			}
+			continue
+		}
+		tracker.track(tx.Hash(), submitted, latency)
+	}
+}
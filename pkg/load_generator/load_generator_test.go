@@ -0,0 +1,495 @@
+package load_generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBackend is a minimal chain which accepts transactions with the
+// expected nonce and includes the pending ones on every commit. The
+// embedded backend is nil, only the methods used by the load generator
+// are implemented.
+type fakeBackend struct {
+	bind.ContractBackend
+	mu       sync.Mutex
+	signer   types.Signer
+	nonces   map[common.Address]uint64
+	balances map[common.Address]*big.Int
+	tokens   map[common.Address]*big.Int
+	pending  []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+	headers  map[uint64]*types.Header
+	block    uint64
+	sendErr  error
+}
+
+func newFakeBackend(chainId *big.Int) *fakeBackend {
+	return &fakeBackend{
+		signer:   types.LatestSignerForChainID(chainId),
+		nonces:   map[common.Address]uint64{},
+		balances: map[common.Address]*big.Int{},
+		tokens:   map[common.Address]*big.Int{},
+		receipts: map[common.Hash]*types.Receipt{},
+		headers:  map[uint64]*types.Header{},
+	}
+}
+
+func (f *fakeBackend) balance(
+	balances map[common.Address]*big.Int,
+	account common.Address,
+) *big.Int {
+	if balances[account] == nil {
+		balances[account] = new(big.Int)
+	}
+	return balances[account]
+}
+
+func (f *fakeBackend) SendTransaction(ctx context.Context,
+	tx *types.Transaction) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	from, err := types.Sender(f.signer, tx)
+	if err != nil {
+		return err
+	}
+	if tx.Nonce() < f.nonces[from] {
+		return errors.New("nonce too low")
+	}
+	if tx.Nonce() > f.nonces[from] {
+		return errors.New("nonce too high")
+	}
+	f.nonces[from]++
+	f.pending = append(f.pending, tx)
+	return nil
+}
+
+func (f *fakeBackend) Commit() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.pending) == 0 {
+		return
+	}
+	f.block++
+	header := &types.Header{Number: new(big.Int).SetUint64(f.block),
+		GasLimit: 30000000}
+	for _, tx := range f.pending {
+		from, _ := types.Sender(f.signer, tx)
+		f.balance(f.balances, from).Sub(f.balance(f.balances, from),
+			tx.Value())
+		f.balance(f.balances, *tx.To()).Add(f.balance(f.balances, *tx.To()),
+			tx.Value())
+		gasUsed := uint64(21000)
+		if len(tx.Data()) != 0 {
+			// Token transfers credit the recipient encoded in the call
+			gasUsed = 35000
+			to := common.BytesToAddress(tx.Data()[4:36])
+			amount := new(big.Int).SetBytes(tx.Data()[36:68])
+			f.balance(f.tokens, from).Sub(f.balance(f.tokens, from), amount)
+			f.balance(f.tokens, to).Add(f.balance(f.tokens, to), amount)
+		}
+		header.GasUsed += gasUsed
+		f.receipts[tx.Hash()] = &types.Receipt{
+			Status:      types.ReceiptStatusSuccessful,
+			GasUsed:     gasUsed,
+			BlockNumber: header.Number,
+		}
+	}
+	f.headers[f.block] = header
+	f.pending = nil
+}
+
+func (f *fakeBackend) PendingNonceAt(ctx context.Context,
+	account common.Address) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.nonces[account], nil
+}
+
+func (f *fakeBackend) BalanceAt(ctx context.Context, account common.Address,
+	blockNumber *big.Int) (*big.Int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return new(big.Int).Set(f.balance(f.balances, account)), nil
+}
+
+func (f *fakeBackend) CallContract(ctx context.Context,
+	call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	account := common.BytesToAddress(call.Data[4:36])
+	return common.LeftPadBytes(f.balance(f.tokens, account).Bytes(), 32), nil
+}
+
+func (f *fakeBackend) TransactionReceipt(ctx context.Context,
+	txHash common.Hash) (*types.Receipt, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	receipt, ok := f.receipts[txHash]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return receipt, nil
+}
+
+func (f *fakeBackend) HeaderByNumber(ctx context.Context,
+	number *big.Int) (*types.Header, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	header, ok := f.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return header, nil
+}
+
+func newTestGenerator(t *testing.T, backend *fakeBackend,
+	config Config) (*LoadGenerator, common.Address) {
+	funderKey, _ := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	funder := crypto.PubkeyToAddress(funderKey.PublicKey)
+	backend.balances[funder] = new(big.Int).Exp(big.NewInt(10),
+		big.NewInt(20), nil)
+	backend.tokens[funder] = big.NewInt(1000000)
+	generator, err := NewLoadGenerator(backend, big.NewInt(1337), funderKey,
+		config)
+	assert.NoError(t, err)
+	return generator, funder
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		testName      string
+		err           error
+		expectedClass string
+	}{
+		{
+			testName:      "classifyError nonce too low.",
+			err:           errors.New("nonce too low"),
+			expectedClass: FailureNonceTooLow,
+		},
+		{
+			testName:      "classifyError known transaction.",
+			err:           errors.New("known transaction: 0xabc"),
+			expectedClass: FailureAlreadyKnown,
+		},
+		{
+			testName: "classifyError replacement underpriced.",
+			err: errors.New("replacement transaction " +
+				"underpriced"),
+			expectedClass: FailureUnderpriced,
+		},
+		{
+			testName: "classifyError insufficient funds.",
+			err: errors.New("Insufficient funds for gas * " +
+				"price + value"),
+			expectedClass: FailureInsufficientFunds,
+		},
+		{
+			testName:      "classifyError intrinsic gas.",
+			err:           errors.New("intrinsic gas too low"),
+			expectedClass: FailureGasLimit,
+		},
+		{
+			testName:      "classifyError txpool full.",
+			err:           errors.New("txpool is full"),
+			expectedClass: FailurePoolFull,
+		},
+		{
+			testName:      "classifyError deadline.",
+			err:           context.DeadlineExceeded,
+			expectedClass: FailureTimeout,
+		},
+		{
+			testName: "classifyError connection refused.",
+			err: errors.New("dial tcp 127.0.0.1:8545: connect: " +
+				"connection refused"),
+			expectedClass: FailureConnection,
+		},
+		{
+			testName:      "classifyError other.",
+			err:           errors.New("execution aborted"),
+			expectedClass: FailureOther,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(t, tt.expectedClass, classifyError(tt.err))
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []time.Duration{}
+	for i := 10; i >= 1; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	tests := []struct {
+		testName        string
+		latencies       []time.Duration
+		percent         float64
+		expectedLatency time.Duration
+	}{
+		{
+			testName:        "Percentile no latencies.",
+			latencies:       nil,
+			percent:         50,
+			expectedLatency: 0,
+		},
+		{
+			testName:        "Percentile p50.",
+			latencies:       latencies,
+			percent:         50,
+			expectedLatency: 5 * time.Millisecond,
+		},
+		{
+			testName:        "Percentile p90.",
+			latencies:       latencies,
+			percent:         90,
+			expectedLatency: 9 * time.Millisecond,
+		},
+		{
+			testName:        "Percentile p99 rounds up.",
+			latencies:       latencies,
+			percent:         99,
+			expectedLatency: 10 * time.Millisecond,
+		},
+		{
+			testName:        "Percentile p0 is the minimum.",
+			latencies:       latencies,
+			percent:         0,
+			expectedLatency: time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			assert.Equal(t, tt.expectedLatency,
+				Percentile(tt.latencies, tt.percent))
+		})
+	}
+	// The latencies given aren't reordered
+	assert.Equal(t, 10*time.Millisecond, latencies[0])
+}
+
+func TestDeriveKey(t *testing.T) {
+	funderKey, _ := crypto.HexToECDSA(
+		"0000000000000000000000000000000000000000000000000000000000000001")
+	first, err := DeriveKey(funderKey, 0)
+	assert.NoError(t, err)
+	again, err1 := DeriveKey(funderKey, 0)
+	assert.NoError(t, err1)
+	second, err2 := DeriveKey(funderKey, 1)
+	assert.NoError(t, err2)
+	assert.Equal(t, crypto.FromECDSA(first), crypto.FromECDSA(again))
+	assert.NotEqual(t, crypto.FromECDSA(first), crypto.FromECDSA(second))
+	assert.NotEqual(t, crypto.FromECDSA(funderKey), crypto.FromECDSA(first))
+}
+
+func TestNewLoadGeneratorConfig(t *testing.T) {
+	tests := []struct {
+		testName      string
+		config        Config
+		expectedError error
+	}{
+		{
+			testName:      "NewLoadGenerator fail no senders.",
+			config:        Config{Senders: 0, Concurrency: 1},
+			expectedError: errors.New("error: at least one sender is needed"),
+		},
+		{
+			testName: "NewLoadGenerator fail no concurrency.",
+			config:   Config{Senders: 1, Concurrency: 0},
+			expectedError: errors.New("error: the concurrency must be " +
+				"positive"),
+		},
+		{
+			testName:      "NewLoadGenerator fail negative rate.",
+			config:        Config{Senders: 1, Concurrency: 1, Rate: -1},
+			expectedError: errors.New("error: the rate can't be negative"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			funderKey, _ := crypto.GenerateKey()
+			_, err := NewLoadGenerator(newFakeBackend(big.NewInt(1337)),
+				big.NewInt(1337), funderKey, tt.config)
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestLoadGeneratorFund(t *testing.T) {
+	token := common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	backend := newFakeBackend(big.NewInt(1337))
+	generator, _ := newTestGenerator(t, backend, Config{
+		Senders:      3,
+		Token:        &token,
+		Concurrency:  10,
+		GasLimit:     100000,
+		GasPrice:     big.NewInt(1),
+		FundAmount:   big.NewInt(1000),
+		TokenAmount:  big.NewInt(500),
+		PollInterval: time.Millisecond,
+	})
+	senders := generator.Senders()
+	// The first sender is partly funded already
+	backend.balances[senders[0]] = big.NewInt(400)
+	backend.tokens[senders[0]] = big.NewInt(500)
+
+	err := generator.Fund(context.Background())
+	assert.NoError(t, err)
+	for _, s := range senders {
+		assert.Equal(t, big.NewInt(1000), backend.balances[s])
+		assert.Equal(t, big.NewInt(500), backend.tokens[s])
+	}
+	// 3 native top ups and 2 token top ups
+	assert.Equal(t, 5, len(backend.receipts))
+
+	// Funded senders aren't topped up again
+	err1 := generator.Fund(context.Background())
+	assert.NoError(t, err1)
+	assert.Equal(t, 5, len(backend.receipts))
+}
+
+func TestLoadGeneratorFundSigner(t *testing.T) {
+	backend := newFakeBackend(big.NewInt(1337))
+	generator, funder := newTestGenerator(t, backend, Config{
+		Senders:      3,
+		Concurrency:  10,
+		GasLimit:     21000,
+		GasPrice:     big.NewInt(1),
+		FundAmount:   big.NewInt(1000),
+		PollInterval: time.Millisecond,
+	})
+	// The funding transactions go through the funder signer, which refuses
+	// the third one
+	var signed []*types.Transaction
+	signer := generator.FunderSigner
+	generator.FunderSigner = func(from common.Address,
+		tx *types.Transaction) (*types.Transaction, error) {
+		assert.Equal(t, funder, from)
+		if len(signed) == 2 {
+			return nil, errors.New("error: blocked by the policy")
+		}
+		signed = append(signed, tx)
+		return signer(from, tx)
+	}
+	err := generator.Fund(context.Background())
+	assert.Equal(t, fmt.Errorf("error: failed to fund %s: error: blocked "+
+		"by the policy", generator.Senders()[2].Hex()), err)
+	assert.Equal(t, 2, len(signed))
+	assert.Equal(t, big.NewInt(1000), signed[0].Value())
+}
+
+func TestLoadGeneratorFundInsufficientBalance(t *testing.T) {
+	backend := newFakeBackend(big.NewInt(1337))
+	generator, funder := newTestGenerator(t, backend, Config{
+		Senders:     2,
+		Concurrency: 10,
+		GasLimit:    21000,
+		GasPrice:    big.NewInt(1),
+		FundAmount:  new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil),
+	})
+	err := generator.Fund(context.Background())
+	assert.Equal(t, fmt.Errorf("error: funding the senders needs "+
+		"200000000000000084000 wei but the funder %s only holds "+
+		"100000000000000000000 wei", funder.Hex()), err)
+	assert.Equal(t, 0, len(backend.receipts))
+}
+
+func TestLoadGeneratorRun(t *testing.T) {
+	token := common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	tests := []struct {
+		testName string
+		token    *common.Address
+		gasUsed  uint64
+	}{
+		{
+			testName: "Run native sends.",
+			gasUsed:  21000,
+		},
+		{
+			testName: "Run token transfers.",
+			token:    &token,
+			gasUsed:  35000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			backend := newFakeBackend(big.NewInt(1337))
+			generator, _ := newTestGenerator(t, backend, Config{
+				Senders:          2,
+				Token:            tt.token,
+				Rate:             200,
+				Concurrency:      4,
+				Duration:         200 * time.Millisecond,
+				GasLimit:         100000,
+				GasPrice:         big.NewInt(1),
+				FundAmount:       big.NewInt(1000000),
+				TokenAmount:      big.NewInt(1000),
+				PollInterval:     5 * time.Millisecond,
+				InclusionTimeout: time.Second,
+			})
+			assert.NoError(t, generator.Fund(context.Background()))
+			funded := len(backend.receipts)
+
+			report, err := generator.Run(context.Background())
+			assert.NoError(t, err)
+			assert.True(t, report.Submitted > 0)
+			assert.Equal(t, report.Submitted, report.Included)
+			assert.Equal(t, report.Submitted, len(backend.receipts)-funded)
+			assert.Equal(t, 0, len(report.Failures))
+			assert.Equal(t, report.Submitted, len(report.InclusionLatencies))
+			transactions := 0
+			for i, block := range report.Blocks {
+				if i > 0 {
+					assert.True(t, block.Number > report.Blocks[i-1].Number)
+				}
+				assert.Equal(t, uint64(block.Transactions)*tt.gasUsed,
+					block.GasUsed)
+				assert.Equal(t, uint64(30000000), block.GasLimit)
+				transactions += block.Transactions
+			}
+			assert.Equal(t, report.Submitted, transactions)
+			assert.True(t, report.SubmittedTPS() > 0)
+			assert.True(t, strings.HasPrefix(report.String(),
+				"info: Load test of "))
+		})
+	}
+}
+
+func TestLoadGeneratorRunSendFailures(t *testing.T) {
+	backend := newFakeBackend(big.NewInt(1337))
+	generator, _ := newTestGenerator(t, backend, Config{
+		Senders:          1,
+		Concurrency:      4,
+		Duration:         50 * time.Millisecond,
+		GasLimit:         21000,
+		GasPrice:         big.NewInt(1),
+		FundAmount:       big.NewInt(1000000),
+		PollInterval:     5 * time.Millisecond,
+		InclusionTimeout: time.Second,
+	})
+	assert.NoError(t, generator.Fund(context.Background()))
+	backend.sendErr = errors.New("txpool is full")
+
+	report, err := generator.Run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, report.Submitted)
+	assert.True(t, report.Failures[FailurePoolFull] > 0)
+	assert.Equal(t, 0, len(report.Blocks))
+}
@@ -0,0 +1,237 @@
+package load_generator
+
+import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Failure classes of the transactions which didn't go through
+const (
+	FailureNonceTooLow       = "nonce too low"
+	FailureNonceTooHigh      = "nonce too high"
+	FailureAlreadyKnown      = "already known"
+	FailureUnderpriced       = "underpriced"
+	FailureInsufficientFunds = "insufficient funds"
+	FailureGasLimit          = "gas limit"
+	FailurePoolFull          = "txpool full"
+	FailureTimeout           = "timeout"
+	FailureConnection        = "connection"
+	FailureOther             = "other"
+	FailureReverted          = "reverted"
+	FailureNotIncluded       = "not included"
+)
+
+// failureFragments maps fragments of the errors returned by nodes to their
+// failure class, the first matching fragment wins
+var failureFragments = []struct {
+	fragment string
+	class    string
+}{
+	{"nonce too low", FailureNonceTooLow},
+	{"nonce too high", FailureNonceTooHigh},
+	{"already known", FailureAlreadyKnown},
+	{"known transaction", FailureAlreadyKnown},
+	{"underpriced", FailureUnderpriced},
+	{"insufficient funds", FailureInsufficientFunds},
+	{"intrinsic gas", FailureGasLimit},
+	{"gas limit", FailureGasLimit},
+	{"pool is full", FailurePoolFull},
+	{"timeout", FailureTimeout},
+	{"deadline exceeded", FailureTimeout},
+	{"connection", FailureConnection},
+	{"eof", FailureConnection},
+}
+
+// classifyError returns the failure class of a submission error
+func classifyError(err error) string {
+	message := strings.ToLower(err.Error())
+	for _, f := range failureFragments {
+		if strings.Contains(message, f.fragment) {
+			return f.class
+		}
+	}
+	return FailureOther
+}
+
+// BlockStats holds the load test transactions included in a block
+type BlockStats struct {
+	Number       uint64
+	Transactions int
+	// GasUsed is the gas used by the load test transactions
+	GasUsed uint64
+	// BlockGasUsed and GasLimit are those of the whole block
+	BlockGasUsed uint64
+	GasLimit     uint64
+}
+
+// Report is the outcome of a load test
+type Report struct {
+	Submitted           int
+	Included            int
+	Reverted            int
+	Failures            map[string]int
+	SubmissionLatencies []time.Duration
+	InclusionLatencies  []time.Duration
+	Blocks              []*BlockStats
+	blocks              map[uint64]*BlockStats
+	start               time.Time
+	end                 time.Time
+	lastInclusion       time.Time
+	mu                  sync.Mutex
+}
+
+// newReport creates an empty report
+func newReport() *Report {
+	return &Report{
+		Failures: map[string]int{},
+		blocks:   map[uint64]*BlockStats{},
+	}
+}
+
+// addSubmission records a transaction accepted by the node
+func (r *Report) addSubmission(latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Submitted++
+	r.SubmissionLatencies = append(r.SubmissionLatencies, latency)
+}
+
+// addFailure records a transaction which didn't go through
+func (r *Report) addFailure(class string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Failures[class]++
+}
+
+// addInclusion records an included transaction and its block
+func (r *Report) addInclusion(
+	receipt *types.Receipt,
+	included time.Time,
+	latency time.Duration,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Included++
+	r.InclusionLatencies = append(r.InclusionLatencies, latency)
+	if included.After(r.lastInclusion) {
+		r.lastInclusion = included
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		r.Reverted++
+		r.Failures[FailureReverted]++
+	}
+	number := receipt.BlockNumber.Uint64()
+	block, ok := r.blocks[number]
+	if !ok {
+		block = &BlockStats{Number: number}
+		r.blocks[number] = block
+	}
+	block.Transactions++
+	block.GasUsed += receipt.GasUsed
+}
+
+// loadBlocks reads the gas used and the gas limit of the blocks holding
+// load test transactions, sorted by number
+func (r *Report) loadBlocks(ctx context.Context, backend IBackend) error {
+	r.Blocks = r.Blocks[:0]
+	for _, block := range r.blocks {
+		header, err := backend.HeaderByNumber(ctx,
+			new(big.Int).SetUint64(block.Number))
+		if err != nil {
+			return fmt.Errorf("error: failed to retrieve block %d: %v",
+				block.Number, err)
+		}
+		block.BlockGasUsed, block.GasLimit = header.GasUsed, header.GasLimit
+		r.Blocks = append(r.Blocks, block)
+	}
+	sort.Slice(r.Blocks, func(i, j int) bool {
+		return r.Blocks[i].Number < r.Blocks[j].Number
+	})
+	return nil
+}
+
+// SubmittedTPS is the rate at which the node accepted the transactions
+// over the load test duration
+func (r *Report) SubmittedTPS() float64 {
+	return rate(r.Submitted, r.end.Sub(r.start))
+}
+
+// IncludedTPS is the rate at which the transactions were included, from
+// the start of the load test to the last inclusion
+func (r *Report) IncludedTPS() float64 {
+	return rate(r.Included-r.Reverted, r.lastInclusion.Sub(r.start))
+}
+
+// rate divides the count by the duration in seconds
+func rate(count int, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+	return float64(count) / duration.Seconds()
+}
+
+// Percentile returns the latency below which the given percentage of the
+// latencies fall, using the nearest rank method
+func Percentile(latencies []time.Duration, percent float64) time.Duration {
+	if len(latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration{}, latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := int(math.Ceil(percent / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// String shows the report as info lines
+func (r *Report) String() string {
+	var b strings.Builder
+	failed := 0
+	for _, count := range r.Failures {
+		failed += count
+	}
+	fmt.Fprintf(&b, "info: Load test of %s: %d transactions submitted, "+
+		"%d included, %d failed\n", r.end.Sub(r.start).Round(time.Millisecond),
+		r.Submitted, r.Included, failed)
+	fmt.Fprintf(&b, "info: Throughput: %.2f TPS submitted, %.2f TPS "+
+		"included successfully\n", r.SubmittedTPS(), r.IncludedTPS())
+	fmt.Fprintf(&b, "info: Submission latency: %s\n",
+		formatLatencies(r.SubmissionLatencies))
+	fmt.Fprintf(&b, "info: Inclusion latency: %s\n",
+		formatLatencies(r.InclusionLatencies))
+	classes := make([]string, 0, len(r.Failures))
+	for class := range r.Failures {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		fmt.Fprintf(&b, "info: Failures %s: %d\n", class, r.Failures[class])
+	}
+	for _, block := range r.Blocks {
+		fmt.Fprintf(&b, "info: Block %d: %d transactions using %d gas, "+
+			"block gas used %d of %d\n", block.Number, block.Transactions,
+			block.GasUsed, block.BlockGasUsed, block.GasLimit)
+	}
+	return b.String()
+}
+
+// formatLatencies shows the p50, p90, p99 and max latencies
+func formatLatencies(latencies []time.Duration) string {
+	if len(latencies) == 0 {
+		return "no transactions"
+	}
+	return fmt.Sprintf("p50 %s, p90 %s, p99 %s, max %s",
+		Percentile(latencies, 50).Round(time.Microsecond),
+		Percentile(latencies, 90).Round(time.Microsecond),
+		Percentile(latencies, 99).Round(time.Microsecond),
+		Percentile(latencies, 100).Round(time.Microsecond))
+}
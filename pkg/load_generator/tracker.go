@@ -0,0 +1,118 @@
+package load_generator
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+	"time"
+)
+
+// tracker follows the submitted transactions until they are included,
+// every tracked transaction holds one of the in flight slots
+type tracker struct {
+	backend IBackend
+	report  *Report
+	slots   chan struct{}
+	mu      sync.Mutex
+	pending map[common.Hash]time.Time
+}
+
+// newTracker creates a tracker allowing concurrency transactions in flight
+func newTracker(backend IBackend, report *Report, concurrency int) *tracker {
+	return &tracker{
+		backend: backend,
+		report:  report,
+		slots:   make(chan struct{}, concurrency),
+		pending: map[common.Hash]time.Time{},
+	}
+}
+
+// acquire waits for a free in flight slot, it fails once the context is
+// done
+func (t *tracker) acquire(ctx context.Context) bool {
+	select {
+	case t.slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// release frees an in flight slot
+func (t *tracker) release() {
+	<-t.slots
+}
+
+// track records a submitted transaction, its slot is released once it is
+// included
+func (t *tracker) track(hash common.Hash, submitted time.Time,
+	latency time.Duration) {
+	t.mu.Lock()
+	t.pending[hash] = submitted
+	t.mu.Unlock()
+	t.report.addSubmission(latency)
+}
+
+// run checks the pending transactions on every interval until the context
+// is done, the transactions never included are then reported as such
+func (t *tracker) run(ctx context.Context, interval time.Duration,
+	commit func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			t.mu.Lock()
+			for range t.pending {
+				t.report.addFailure(FailureNotIncluded)
+			}
+			t.pending = map[common.Hash]time.Time{}
+			t.mu.Unlock()
+			return
+		case <-ticker.C:
+			commit()
+			t.poll(ctx)
+		}
+	}
+}
+
+// poll looks for the receipts of the pending transactions
+func (t *tracker) poll(ctx context.Context) {
+	t.mu.Lock()
+	hashes := make([]common.Hash, 0, len(t.pending))
+	for hash := range t.pending {
+		hashes = append(hashes, hash)
+	}
+	t.mu.Unlock()
+	for _, hash := range hashes {
+		receipt, err := t.backend.TransactionReceipt(ctx, hash)
+		if err != nil || receipt == nil {
+			continue
+		}
+		now := time.Now()
+		t.mu.Lock()
+		submitted, ok := t.pending[hash]
+		delete(t.pending, hash)
+		t.mu.Unlock()
+		if !ok {
+			continue
+		}
+		t.report.addInclusion(receipt, now, now.Sub(submitted))
+		t.release()
+	}
+}
+
+// waitIdle waits until every tracked transaction is included or the
+// timeout expires
+func (t *tracker) waitIdle(ctx context.Context, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) && ctx.Err() == nil {
+		t.mu.Lock()
+		idle := len(t.pending) == 0
+		t.mu.Unlock()
+		if idle {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,295 @@
+package signature_db
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	abidec "go-evm-client/pkg/abi_decoder"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Kinds of signatures held by the database
+const (
+	FunctionKind = "function"
+	EventKind    = "event"
+)
+
+// keyedLine matches the lines of a 4byte-style file starting with the
+// selector or the topic of their signature
+var keyedLine = regexp.MustCompile(
+	`^(?:0x)?([0-9a-fA-F]{64}|[0-9a-fA-F]{8})[\s,:;]+(.+)$`)
+
+// Entry is a function or an event signature together with its key, the 4
+// byte selector of a function or the topic of an event, and the names of
+// the ABIs and files it was found in
+type Entry struct {
+	Kind      string   `json:"kind"`
+	Signature string   `json:"signature"`
+	Key       string   `json:"key"`
+	Sources   []string `json:"sources"`
+
+	method abi.Method
+}
+
+// Method is the function of a function entry, used to decode the arguments
+// of the calldata calling it
+func (e *Entry) Method() abi.Method {
+	return e.method
+}
+
+// Match lists the signatures of a selector or of a topic, several of them
+// are a collision
+type Match struct {
+	Kind    string   `json:"kind"`
+	Key     string   `json:"key"`
+	Entries []*Entry `json:"entries"`
+}
+
+// SignatureDB indexes function signatures by their 4 byte selector and
+// event signatures by their topic, the first topic of their logs. It is
+// built offline from ABIs and 4byte-style text files.
+type SignatureDB struct {
+	byKey       map[string][]*Entry
+	bySignature map[string]*Entry
+}
+
+// NewSignatureDB creates an empty signature database
+func NewSignatureDB() *SignatureDB {
+	return &SignatureDB{
+		byKey:       map[string][]*Entry{},
+		bySignature: map[string]*Entry{},
+	}
+}
+
+// AddABI indexes the functions and events of an ABI, anonymous events have
+// no topic and are skipped
+func (s *SignatureDB) AddABI(source string, parsed abi.ABI) {
+	for _, method := range parsed.Methods {
+		s.add(FunctionKind, method, hexutil.Encode(method.ID), source)
+	}
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		method := abi.NewMethod(event.RawName, event.RawName, abi.Function,
+			"", false, false, event.Inputs, nil)
+		s.add(EventKind, method, event.ID.Hex(), source)
+	}
+}
+
+// AddSignature indexes a text signature such as transfer(address,uint256),
+// parameter names are accepted and dropped
+func (s *SignatureDB) AddSignature(kind string, signature string,
+	source string) (*Entry, error) {
+	method, key, err := ParseSignature(kind, signature)
+	if err != nil {
+		return nil, err
+	}
+	return s.add(kind, method, key, source), nil
+}
+
+// ParseSignature parses a function or event text signature and computes
+// its selector or topic
+func ParseSignature(kind string, signature string) (abi.Method, string,
+	error) {
+	if kind != FunctionKind && kind != EventKind {
+		return abi.Method{}, "", fmt.Errorf("error: unknown signature kind "+
+			"%q, expected %s or %s", kind, FunctionKind, EventKind)
+	}
+	trimmed := strings.TrimSpace(signature)
+	trimmed = strings.TrimSpace(strings.TrimPrefix(trimmed, kind+" "))
+	method, err := abidec.ParseSignature(trimmed)
+	if err != nil {
+		return abi.Method{}, "", err
+	}
+	if kind == EventKind {
+		return method, crypto.Keccak256Hash([]byte(method.Sig)).Hex(), nil
+	}
+	return method, hexutil.Encode(method.ID), nil
+}
+
+// add indexes a signature once, later sources are appended to the entry
+func (s *SignatureDB) add(kind string, method abi.Method, key string,
+	source string) *Entry {
+	id := kind + " " + method.Sig
+	entry, ok := s.bySignature[id]
+	if !ok {
+		entry = &Entry{
+			Kind:      kind,
+			Signature: method.Sig,
+			Key:       key,
+			method:    method,
+		}
+		s.bySignature[id] = entry
+		s.byKey[kind+" "+key] = append(s.byKey[kind+" "+key], entry)
+	}
+	for _, known := range entry.Sources {
+		if known == source {
+			return entry
+		}
+	}
+	entry.Sources = append(entry.Sources, source)
+	return entry
+}
+
+// Import indexes the signatures of a 4byte-style text file, one signature
+// per line optionally preceded by its selector or topic. Lines can start
+// with the function or event keyword, a 32 byte key also marks an event,
+// and the other lines are functions. Empty lines and comments starting
+// with # are skipped. The number of signatures read is returned.
+func (s *SignatureDB) Import(reader io.Reader, source string) (int, error) {
+	scanner := bufio.NewScanner(reader)
+	count, line := 0, 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if comment := strings.Index(text, "#"); comment != -1 {
+			text = text[:comment]
+		}
+		text = strings.TrimSpace(text)
+		if len(text) == 0 {
+			continue
+		}
+		kind, key := FunctionKind, ""
+		if match := keyedLine.FindStringSubmatch(text); match != nil {
+			key, text = "0x"+strings.ToLower(match[1]), match[2]
+			if len(match[1]) == 64 {
+				kind = EventKind
+			}
+		}
+		if strings.HasPrefix(text, EventKind+" ") {
+			kind = EventKind
+		}
+		entry, err := s.AddSignature(kind, text, source)
+		if err != nil {
+			return count, fmt.Errorf("error: line %d of %s: %s", line, source,
+				strings.TrimPrefix(err.Error(), "error: "))
+		}
+		if len(key) != 0 && key != entry.Key {
+			return count, fmt.Errorf("error: line %d of %s: %s has the key "+
+				"%s, not %s", line, source, entry.Signature, entry.Key, key)
+		}
+		count++
+	}
+	err1 := scanner.Err()
+	if err1 != nil {
+		return count, fmt.Errorf("error: failed to read %s: %v", source, err1)
+	}
+	return count, nil
+}
+
+// ImportFile indexes the signatures of a 4byte-style text file, named
+// after the file in the sources of its entries
+func (s *SignatureDB) ImportFile(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("error: failed to open the signature file %s: "+
+			"%v", path, err)
+	}
+	defer file.Close()
+	return s.Import(file, filepath.Base(path))
+}
+
+// Lookup returns the signatures of a 4 byte selector, of a 32 byte event
+// topic, or of the selector or topic of a text signature, which can start
+// with the event keyword. The match has no entry when the key is unknown.
+func (s *SignatureDB) Lookup(query string) (*Match, error) {
+	query = strings.TrimSpace(query)
+	var kind, key string
+	if strings.Contains(query, "(") {
+		kind = FunctionKind
+		if strings.HasPrefix(query, EventKind+" ") {
+			kind = EventKind
+		}
+		var err error
+		_, key, err = ParseSignature(kind, query)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		decoded, err1 := hexutil.Decode(query)
+		switch {
+		case err1 == nil && len(decoded) == 4:
+			kind = FunctionKind
+		case err1 == nil && len(decoded) == 32:
+			kind = EventKind
+		default:
+			return nil, fmt.Errorf("error: %q is neither a 4 byte "+
+				"selector, a 32 byte topic nor a signature", query)
+		}
+		key = hexutil.Encode(decoded)
+	}
+	return s.match(kind, key), nil
+}
+
+// match lists the entries of a key sorted by signature
+func (s *SignatureDB) match(kind string, key string) *Match {
+	entries := append([]*Entry{}, s.byKey[kind+" "+key]...)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Signature < entries[j].Signature
+	})
+	return &Match{Kind: kind, Key: key, Entries: entries}
+}
+
+// Collisions lists the selectors and topics shared by several signatures,
+// functions first and sorted by key
+func (s *SignatureDB) Collisions() []*Match {
+	collisions := []*Match{}
+	for _, entries := range s.byKey {
+		if len(entries) > 1 {
+			collisions = append(collisions, s.match(entries[0].Kind,
+				entries[0].Key))
+		}
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		if collisions[i].Kind != collisions[j].Kind {
+			return collisions[i].Kind > collisions[j].Kind
+		}
+		return collisions[i].Key < collisions[j].Key
+	})
+	return collisions
+}
+
+// Size returns the number of function and event signatures indexed
+func (s *SignatureDB) Size() (int, int) {
+	functions, events := 0, 0
+	for _, entry := range s.bySignature {
+		if entry.Kind == FunctionKind {
+			functions++
+		} else {
+			events++
+		}
+	}
+	return functions, events
+}
+
+// Text formats the match with the sources of each signature
+func (m *Match) Text() string {
+	var b strings.Builder
+	name := "Function selector"
+	if m.Kind == EventKind {
+		name = "Event topic"
+	}
+	switch len(m.Entries) {
+	case 0:
+		fmt.Fprintf(&b, "%s %s: no known signature\n", name, m.Key)
+	case 1:
+		fmt.Fprintf(&b, "%s %s: 1 signature\n", name, m.Key)
+	default:
+		fmt.Fprintf(&b, "%s %s: %d signatures\n", name, m.Key,
+			len(m.Entries))
+	}
+	for _, entry := range m.Entries {
+		fmt.Fprintf(&b, "  %s\n", entry.Signature)
+		fmt.Fprintf(&b, "    found in: %s\n", strings.Join(entry.Sources,
+			", "))
+	}
+	return b.String()
+}
@@ -0,0 +1,223 @@
+package signature_db
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const tokenAbi = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+	 "inputs":[{"name":"recipient","type":"address"},
+	           {"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+	 "inputs":[{"name":"sender","type":"address"},
+	           {"name":"recipient","type":"address"},
+	           {"name":"amount","type":"uint256"}],
+	 "outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+	 "inputs":[{"name":"from","type":"address","indexed":true},
+	           {"name":"to","type":"address","indexed":true},
+	           {"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Hidden","anonymous":true,
+	 "inputs":[{"name":"value","type":"uint256","indexed":false}]}
+]`
+
+const signatureFile = `# 4byte-style signatures
+0xa9059cbb transfer(address,uint256)
+42966c68,burn(uint256)
+collate_propagate_storage(bytes16)   # shares the burn selector
+0x23B872DD: gasprice_bit_ether(int128)
+function approve(address spender, uint256 amount)
+
+event Deposit(address indexed dst, uint wad)
+0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c Deposit(address,uint256)
+`
+
+func newTestSignatureDB(t *testing.T) *SignatureDB {
+	parsed, err := abi.JSON(strings.NewReader(tokenAbi))
+	assert.NoError(t, err)
+	signatures := NewSignatureDB()
+	signatures.AddABI("token", parsed)
+	count, err1 := signatures.Import(strings.NewReader(signatureFile),
+		"4byte.txt")
+	assert.NoError(t, err1)
+	assert.Equal(t, 7, count)
+	return signatures
+}
+
+func TestSignatureDBLookup(t *testing.T) {
+	tests := []struct {
+		testName           string
+		query              string
+		expectedKind       string
+		expectedKey        string
+		expectedSignatures []string
+		expectedSources    [][]string
+		expectedError      string
+	}{
+		{
+			testName:           "Selector found in an ABI and a file",
+			query:              "0xa9059cbb",
+			expectedKind:       FunctionKind,
+			expectedKey:        "0xa9059cbb",
+			expectedSignatures: []string{"transfer(address,uint256)"},
+			expectedSources:    [][]string{{"token", "4byte.txt"}},
+		},
+		{
+			testName:     "Colliding selector",
+			query:        "0x23B872DD",
+			expectedKind: FunctionKind,
+			expectedKey:  "0x23b872dd",
+			expectedSignatures: []string{"gasprice_bit_ether(int128)",
+				"transferFrom(address,address,uint256)"},
+			expectedSources: [][]string{{"4byte.txt"}, {"token"}},
+		},
+		{
+			testName:     "Event topic",
+			query:        "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			expectedKind: EventKind,
+			expectedKey:  "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+			expectedSignatures: []string{
+				"Transfer(address,address,uint256)"},
+			expectedSources: [][]string{{"token"}},
+		},
+		{
+			testName:           "Function signature with names",
+			query:              "function approve(address a, uint256 b)",
+			expectedKind:       FunctionKind,
+			expectedKey:        "0x095ea7b3",
+			expectedSignatures: []string{"approve(address,uint256)"},
+			expectedSources:    [][]string{{"4byte.txt"}},
+		},
+		{
+			testName:           "Event signature",
+			query:              "event Deposit(address indexed, uint256)",
+			expectedKind:       EventKind,
+			expectedKey:        "0xe1fffcc4923d04b559f4d29a8bfc6cda04eb5b0d3c460751c2402c5c5cc9109c",
+			expectedSignatures: []string{"Deposit(address,uint256)"},
+			expectedSources:    [][]string{{"4byte.txt"}},
+		},
+		{
+			testName:           "Unknown selector",
+			query:              "0x12345678",
+			expectedKind:       FunctionKind,
+			expectedKey:        "0x12345678",
+			expectedSignatures: []string{},
+			expectedSources:    [][]string{},
+		},
+		{
+			testName: "Invalid query",
+			query:    "0x1234",
+			expectedError: "error: \"0x1234\" is neither a 4 byte selector, " +
+				"a 32 byte topic nor a signature",
+		},
+	}
+	signatures := newTestSignatureDB(t)
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			match, err := signatures.Lookup(tt.query)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, match)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedKind, match.Kind)
+			assert.Equal(t, tt.expectedKey, match.Key)
+			names := make([]string, len(match.Entries))
+			sources := make([][]string, len(match.Entries))
+			for i, entry := range match.Entries {
+				names[i] = entry.Signature
+				sources[i] = entry.Sources
+			}
+			assert.Equal(t, tt.expectedSignatures, names)
+			assert.Equal(t, tt.expectedSources, sources)
+		})
+	}
+}
+
+func TestSignatureDBCollisions(t *testing.T) {
+	signatures := newTestSignatureDB(t)
+	collisions := signatures.Collisions()
+	assert.Equal(t, 2, len(collisions))
+	assert.Equal(t, "0x23b872dd", collisions[0].Key)
+	assert.Equal(t, "0x42966c68", collisions[1].Key)
+	assert.Equal(t, "burn(uint256)", collisions[1].Entries[0].Signature)
+	assert.Equal(t, "collate_propagate_storage(bytes16)",
+		collisions[1].Entries[1].Signature)
+	functions, events := signatures.Size()
+	// The anonymous event has no topic
+	assert.Equal(t, 6, functions)
+	assert.Equal(t, 2, events)
+	assert.Equal(t, "Function selector 0x42966c68: 2 signatures\n"+
+		"  burn(uint256)\n"+
+		"    found in: 4byte.txt\n"+
+		"  collate_propagate_storage(bytes16)\n"+
+		"    found in: 4byte.txt\n", collisions[1].Text())
+}
+
+func TestSignatureDBImport(t *testing.T) {
+	tests := []struct {
+		testName      string
+		content       string
+		expectedCount int
+		expectedError string
+	}{
+		{
+			testName:      "Signatures with and without keys",
+			content:       signatureFile,
+			expectedCount: 7,
+		},
+		{
+			testName: "Key of another signature",
+			content:  "transfer(address,uint256)\n0xdeadbeef burn(uint256)\n",
+			expectedError: "error: line 2 of sigs.txt: burn(uint256) has the " +
+				"key 0x42966c68, not 0xdeadbeef",
+		},
+		{
+			testName: "Invalid signature",
+			content:  "\n\nburn(uint256\n",
+			expectedError: "error: line 3 of sigs.txt: \"burn(uint256\" is " +
+				"not a valid function signature",
+		},
+		{
+			testName: "Unknown type",
+			content:  "burn(unit256)\n",
+			expectedError: "error: line 1 of sigs.txt: invalid parameter " +
+				"\"unit256\" of burn",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "signatures")
+			assert.NoError(t, err)
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "sigs.txt")
+			assert.NoError(t, ioutil.WriteFile(path, []byte(tt.content), 0644))
+
+			signatures := NewSignatureDB()
+			count, err1 := signatures.ImportFile(path)
+			if len(tt.expectedError) != 0 {
+				assert.Error(t, err1)
+				assert.True(t, strings.HasPrefix(err1.Error(),
+					tt.expectedError), err1.Error())
+				return
+			}
+			assert.NoError(t, err1)
+			assert.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
+
+func TestSignatureDBImportMissingFile(t *testing.T) {
+	_, err := NewSignatureDB().ImportFile("/nonexistent/sigs.txt")
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "error: failed to open "+
+		"the signature file /nonexistent/sigs.txt"))
+}
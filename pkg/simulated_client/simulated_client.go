@@ -0,0 +1,73 @@
+package simulated_client
+
+import (
+	"context"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+)
+
+// gasLimit is the gas limit of the simulated blocks
+const gasLimit uint64 = 30000000
+
+// SimulatedClient wraps the simulated backend of go-ethereum so that it
+// implements eth_rpc_client.IEthClient. Every transaction is mined in its
+// own block as soon as it is sent, like on the development nodes the
+// contracts are usually tried on.
+type SimulatedClient struct {
+	*backends.SimulatedBackend
+}
+
+// NewSimulatedClient creates a simulated chain funding a new account, it
+// returns the client together with the transactor of that account
+func NewSimulatedClient() (*SimulatedClient, *bind.TransactOpts, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	balance, _ := new(big.Int).SetString("1000000000000000000000000", 10)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		crypto.PubkeyToAddress(key.PublicKey): {Balance: balance},
+	}, gasLimit)
+	client := &SimulatedClient{SimulatedBackend: backend}
+	chainID, err1 := client.ChainID(context.Background())
+	if err1 != nil {
+		return nil, nil, err1
+	}
+	auth, err2 := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err2 != nil {
+		return nil, nil, err2
+	}
+	return client, auth, nil
+}
+
+// ChainID returns the chain id of the simulated chain
+func (s *SimulatedClient) ChainID(_ context.Context) (*big.Int, error) {
+	return s.Blockchain().Config().ChainID, nil
+}
+
+// BlockNumber returns the number of the last mined block
+func (s *SimulatedClient) BlockNumber(_ context.Context) (uint64, error) {
+	return s.Blockchain().CurrentBlock().NumberU64(), nil
+}
+
+// Close stops the simulated chain
+func (s *SimulatedClient) Close() {
+	_ = s.SimulatedBackend.Close()
+}
+
+// SendTransaction sends the transaction and mines it right away
+func (s *SimulatedClient) SendTransaction(
+	ctx context.Context,
+	tx *types.Transaction,
+) error {
+	err := s.SimulatedBackend.SendTransaction(ctx, tx)
+	if err != nil {
+		return err
+	}
+	s.Commit()
+	return nil
+}
@@ -0,0 +1,257 @@
+package spending_policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go-evm-client/internal/utils"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dayFormat is the layout of the days of the state file, counted in UTC
+const dayFormat = "2006-01-02"
+
+// DailySpending is what an account spent on a network during a day
+type DailySpending struct {
+	Day    string                      `json:"day"`
+	Value  *big.Int                    `json:"value"`
+	Fee    *big.Int                    `json:"fee"`
+	Tokens map[common.Address]*big.Int `json:"tokens"`
+}
+
+// State is the spending of the current day written to the state file,
+// keyed by chain ID and then by account
+type State struct {
+	Networks map[string]map[common.Address]*DailySpending `json:"networks"`
+}
+
+// LoadState reads the state file, a missing file is an empty state
+func LoadState(path string) (*State, error) {
+	state := &State{Networks: map[string]map[common.Address]*DailySpending{}}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read the policy state "+
+			"file: %v", err)
+	}
+	err1 := json.Unmarshal(data, state)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: policy state file %s is corrupted: "+
+			"%v", path, err1)
+	}
+	if state.Networks == nil {
+		state.Networks = map[string]map[common.Address]*DailySpending{}
+	}
+	return state, nil
+}
+
+// Save writes the state to a temporary file which then replaces the state
+// file, so that an interruption never leaves a partially written state
+func (s *State) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error: failed to encode the policy state: %v", err)
+	}
+	tmpPath := path + ".tmp"
+	err1 := ioutil.WriteFile(tmpPath, data, 0644)
+	if err1 != nil {
+		return fmt.Errorf("error: failed to write the policy state file: %v",
+			err1)
+	}
+	err2 := os.Rename(tmpPath, path)
+	if err2 != nil {
+		return fmt.Errorf("error: failed to write the policy state file: %v",
+			err2)
+	}
+	return nil
+}
+
+// spendingOf returns what the account spent on the chain during the day,
+// the spending of a previous day is started over
+func (s *State) spendingOf(
+	chainId *big.Int,
+	account common.Address,
+	day string,
+) *DailySpending {
+	network := chainId.String()
+	if s.Networks[network] == nil {
+		s.Networks[network] = map[common.Address]*DailySpending{}
+	}
+	spending := s.Networks[network][account]
+	if spending == nil || spending.Day != day {
+		spending = &DailySpending{
+			Day:    day,
+			Value:  new(big.Int),
+			Fee:    new(big.Int),
+			Tokens: map[common.Address]*big.Int{},
+		}
+		s.Networks[network][account] = spending
+	}
+	if spending.Tokens == nil {
+		spending.Tokens = map[common.Address]*big.Int{}
+	}
+	return spending
+}
+
+// Check lists the rules broken by a transaction given what its sender
+// already spent today, an empty list allows it
+func (r *Rules) Check(s *Spending, today *DailySpending) []string {
+	var violations []string
+	for _, recipient := range s.Recipients {
+		if r.DenyRecipients[recipient] {
+			violations = append(violations, fmt.Sprintf("recipient %s is on "+
+				"the deny list", recipient.Hex()))
+		} else if len(r.AllowRecipients) != 0 && !r.AllowRecipients[recipient] {
+			violations = append(violations, fmt.Sprintf("recipient %s is not "+
+				"on the allow list", recipient.Hex()))
+		}
+	}
+	if s.Contract != nil {
+		contract := *s.Contract
+		if r.DenyContracts[contract] {
+			violations = append(violations, fmt.Sprintf("contract %s is on "+
+				"the deny list", contract.Hex()))
+		} else if len(r.AllowContracts) != 0 && !r.AllowContracts[contract] {
+			violations = append(violations, fmt.Sprintf("contract %s is not "+
+				"on the allow list", contract.Hex()))
+		}
+		if s.TokenAmount != nil {
+			if max := r.MaxTokenAmount[contract]; max != nil &&
+				s.TokenAmount.Cmp(max) > 0 {
+				violations = append(violations, fmt.Sprintf("transfer of %d "+
+					"base units of token %s exceeds the maximum of %d per "+
+					"transfer", s.TokenAmount, contract.Hex(), max))
+			}
+			if limit := r.DailyTokenCap[contract]; limit != nil {
+				total := new(big.Int).Add(s.TokenAmount,
+					bigOrZero(today.Tokens[contract]))
+				if total.Cmp(limit) > 0 {
+					violations = append(violations, fmt.Sprintf("transfer of "+
+						"%d base units of token %s brings today's total to "+
+						"%d, over the daily cap of %d", s.TokenAmount,
+						contract.Hex(), total, limit))
+				}
+			}
+		}
+	}
+	if r.MaxValue != nil && s.Value.Cmp(r.MaxValue) > 0 {
+		violations = append(violations, fmt.Sprintf("value of %s exceeds the "+
+			"maximum of %s per transaction", utils.FormatNativeAmount(s.Value),
+			utils.FormatNativeAmount(r.MaxValue)))
+	}
+	if r.DailyValueCap != nil && s.Value.Sign() > 0 {
+		total := new(big.Int).Add(s.Value, today.Value)
+		if total.Cmp(r.DailyValueCap) > 0 {
+			violations = append(violations, fmt.Sprintf("value of %s brings "+
+				"today's total to %s, over the daily cap of %s",
+				utils.FormatNativeAmount(s.Value),
+				utils.FormatNativeAmount(total),
+				utils.FormatNativeAmount(r.DailyValueCap)))
+		}
+	}
+	if r.MaxFee != nil && s.Fee.Cmp(r.MaxFee) > 0 {
+		violations = append(violations, fmt.Sprintf("fee of up to %s exceeds "+
+			"the maximum of %s per transaction", utils.FormatNativeAmount(s.Fee),
+			utils.FormatNativeAmount(r.MaxFee)))
+	}
+	if r.DailyFeeCap != nil {
+		total := new(big.Int).Add(s.Fee, today.Fee)
+		if total.Cmp(r.DailyFeeCap) > 0 {
+			violations = append(violations, fmt.Sprintf("fee of up to %s "+
+				"brings today's total to %s, over the daily cap of %s",
+				utils.FormatNativeAmount(s.Fee),
+				utils.FormatNativeAmount(total),
+				utils.FormatNativeAmount(r.DailyFeeCap)))
+		}
+	}
+	return violations
+}
+
+// record adds the spending of an allowed transaction to the day
+func (d *DailySpending) record(s *Spending) {
+	d.Value.Add(d.Value, s.Value)
+	d.Fee.Add(d.Fee, s.Fee)
+	if s.Contract != nil && s.TokenAmount != nil {
+		d.Tokens[*s.Contract] = new(big.Int).Add(s.TokenAmount,
+			bigOrZero(d.Tokens[*s.Contract]))
+	}
+}
+
+// bigOrZero returns zero in place of a missing amount
+func bigOrZero(amount *big.Int) *big.Int {
+	if amount == nil {
+		return new(big.Int)
+	}
+	return amount
+}
+
+// Guard enforces the rules of a network on the transactions signed for it
+// and records their spending in the state file
+type Guard struct {
+	rules     *Rules
+	chainId   *big.Int
+	statePath string
+	// Now returns the current time, days are counted in UTC
+	Now func() time.Time
+
+	mu sync.Mutex
+}
+
+// NewGuard creates the guard of the transactions sent on a chain, nothing
+// is checked nor recorded when the policy has no rules for the chain
+func NewGuard(policy *Policy, chainId *big.Int, statePath string) *Guard {
+	return &Guard{
+		rules:     policy.RulesOf(chainId),
+		chainId:   chainId,
+		statePath: statePath,
+		Now:       time.Now,
+	}
+}
+
+// Authorize checks a transaction signed by the account against the rules
+// and records its spending once it is allowed. The spending is counted
+// when the transaction is signed, even if it then fails to be sent.
+func (g *Guard) Authorize(from common.Address, tx *types.Transaction) error {
+	if g.rules == nil {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	state, err := LoadState(g.statePath)
+	if err != nil {
+		return err
+	}
+	spending := NewSpending(tx)
+	today := state.spendingOf(g.chainId, from,
+		g.Now().UTC().Format(dayFormat))
+	violations := g.rules.Check(spending, today)
+	if len(violations) != 0 {
+		return fmt.Errorf("error: the spending policy of chain %s blocks the "+
+			"transaction of %s:\n  %s", g.chainId, from.Hex(),
+			strings.Join(violations, "\n  "))
+	}
+	today.record(spending)
+	return state.Save(g.statePath)
+}
+
+// WrapSigner returns a signer which authorizes every transaction before
+// signing it with the given signer
+func (g *Guard) WrapSigner(signer bind.SignerFn) bind.SignerFn {
+	return func(from common.Address, tx *types.Transaction) (
+		*types.Transaction, error) {
+		err := g.Authorize(from, tx)
+		if err != nil {
+			return nil, err
+		}
+		return signer(from, tx)
+	}
+}
@@ -0,0 +1,192 @@
+package spending_policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"go-evm-client/internal/utils"
+	"io/ioutil"
+	"math/big"
+	"strings"
+)
+
+// AnyNetwork is the key of the rules applied to the networks which have no
+// rules of their own
+const AnyNetwork = "*"
+
+// networkRules is a network section as written in the policy file. Native
+// amounts are in wei or in decimal coins such as 0.5, token amounts are in
+// base units. Empty fields don't limit anything.
+type networkRules struct {
+	AllowRecipients []string          `json:"allowRecipients"`
+	DenyRecipients  []string          `json:"denyRecipients"`
+	AllowContracts  []string          `json:"allowContracts"`
+	DenyContracts   []string          `json:"denyContracts"`
+	MaxTokenAmount  map[string]string `json:"maxTokenAmount"`
+	MaxValue        string            `json:"maxValue"`
+	MaxFee          string            `json:"maxFee"`
+	DailyValueCap   string            `json:"dailyValueCap"`
+	DailyFeeCap     string            `json:"dailyFeeCap"`
+	DailyTokenCap   map[string]string `json:"dailyTokenCap"`
+}
+
+// Rules are the limits applied to the transactions sent on a network
+type Rules struct {
+	// AllowRecipients, when not empty, lists the only accounts which can
+	// receive native coins or tokens
+	AllowRecipients map[common.Address]bool
+	// DenyRecipients lists the accounts which can't receive native coins
+	// or tokens
+	DenyRecipients map[common.Address]bool
+	// AllowContracts, when not empty, lists the only contracts which can
+	// be called
+	AllowContracts map[common.Address]bool
+	// DenyContracts lists the contracts which can't be called
+	DenyContracts map[common.Address]bool
+	// MaxTokenAmount is the largest amount of a token sent by a single
+	// transfer, keyed by token contract
+	MaxTokenAmount map[common.Address]*big.Int
+	// MaxValue is the largest native value of a transaction
+	MaxValue *big.Int
+	// MaxFee is the largest fee a transaction can pay, its gas limit times
+	// its gas price or fee cap
+	MaxFee *big.Int
+	// DailyValueCap is the largest native value sent by an account in a day
+	DailyValueCap *big.Int
+	// DailyFeeCap is the largest fee paid by an account in a day
+	DailyFeeCap *big.Int
+	// DailyTokenCap is the largest amount of a token sent by an account in
+	// a day, keyed by token contract
+	DailyTokenCap map[common.Address]*big.Int
+}
+
+// Policy holds the rules of every network, keyed by chain ID
+type Policy struct {
+	Networks map[string]*Rules
+}
+
+// LoadPolicy reads and validates a policy file, a JSON object whose
+// "networks" field maps chain IDs, or * for any other network, to their
+// rules
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read the policy file: %v",
+			err)
+	}
+	var file struct {
+		Networks map[string]networkRules `json:"networks"`
+	}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	// A misspelled limit must not be silently ignored
+	decoder.DisallowUnknownFields()
+	err1 := decoder.Decode(&file)
+	if err1 != nil {
+		return nil, fmt.Errorf("error: policy file %s is invalid: %v", path,
+			err1)
+	}
+	policy := &Policy{Networks: map[string]*Rules{}}
+	for network, raw := range file.Networks {
+		if _, ok := new(big.Int).SetString(network, 10); !ok &&
+			network != AnyNetwork {
+			return nil, fmt.Errorf("error: policy file %s: network %q is "+
+				"neither a chain ID nor %s", path, network, AnyNetwork)
+		}
+		rules, err2 := raw.parse()
+		if err2 != nil {
+			return nil, fmt.Errorf("error: policy file %s: network %s: %s",
+				path, network, strings.TrimPrefix(err2.Error(), "error: "))
+		}
+		policy.Networks[network] = rules
+	}
+	return policy, nil
+}
+
+// RulesOf returns the rules of a chain, the rules of any network when the
+// chain has none, or nil when nothing applies
+func (p *Policy) RulesOf(chainId *big.Int) *Rules {
+	if rules, ok := p.Networks[chainId.String()]; ok {
+		return rules
+	}
+	return p.Networks[AnyNetwork]
+}
+
+// parse validates the addresses and amounts of a network section
+func (n networkRules) parse() (*Rules, error) {
+	rules := &Rules{}
+	var err error
+	if rules.AllowRecipients, err = parseAddresses(n.AllowRecipients); err != nil {
+		return nil, err
+	}
+	if rules.DenyRecipients, err = parseAddresses(n.DenyRecipients); err != nil {
+		return nil, err
+	}
+	if rules.AllowContracts, err = parseAddresses(n.AllowContracts); err != nil {
+		return nil, err
+	}
+	if rules.DenyContracts, err = parseAddresses(n.DenyContracts); err != nil {
+		return nil, err
+	}
+	if rules.MaxTokenAmount, err = parseTokenAmounts(n.MaxTokenAmount); err != nil {
+		return nil, err
+	}
+	if rules.DailyTokenCap, err = parseTokenAmounts(n.DailyTokenCap); err != nil {
+		return nil, err
+	}
+	if rules.MaxValue, err = parseNativeAmount(n.MaxValue); err != nil {
+		return nil, err
+	}
+	if rules.MaxFee, err = parseNativeAmount(n.MaxFee); err != nil {
+		return nil, err
+	}
+	if rules.DailyValueCap, err = parseNativeAmount(n.DailyValueCap); err != nil {
+		return nil, err
+	}
+	if rules.DailyFeeCap, err = parseNativeAmount(n.DailyFeeCap); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// parseAddresses validates a list of addresses with the strict argument
+// rules
+func parseAddresses(args []string) (map[common.Address]bool, error) {
+	var parser *utils.ArgParser
+	addresses := map[common.Address]bool{}
+	for _, arg := range args {
+		address, err := parser.ParseAddress(arg)
+		if err != nil {
+			return nil, err
+		}
+		addresses[address] = true
+	}
+	return addresses, nil
+}
+
+// parseTokenAmounts validates amounts in base units keyed by token contract
+func parseTokenAmounts(args map[string]string) (map[common.Address]*big.Int,
+	error) {
+	var parser *utils.ArgParser
+	amounts := map[common.Address]*big.Int{}
+	for token, arg := range args {
+		address, err := parser.ParseAddress(token)
+		if err != nil {
+			return nil, err
+		}
+		amount, err1 := utils.ParseTokenAmount(arg, 0, "")
+		if err1 != nil {
+			return nil, err1
+		}
+		amounts[address] = amount
+	}
+	return amounts, nil
+}
+
+// parseNativeAmount validates an amount in wei or in decimal coins, an
+// empty amount is no limit
+func parseNativeAmount(arg string) (*big.Int, error) {
+	if len(strings.TrimSpace(arg)) == 0 {
+		return nil, nil
+	}
+	return utils.ParseNativeAmount(arg)
+}
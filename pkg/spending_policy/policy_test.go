@@ -0,0 +1,259 @@
+package spending_policy
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	sender   = common.HexToAddress("0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf")
+	token    = common.HexToAddress("0xF2E246BB76DF876Cef8b38ae84130F4F55De395b")
+	friend   = common.HexToAddress("0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF")
+	stranger = common.HexToAddress("0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df")
+	blocked  = common.HexToAddress("0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384")
+	chainId  = big.NewInt(1337)
+	testDay  = time.Date(2026, 10, 18, 23, 0, 0, 0, time.UTC)
+	nextDay  = testDay.Add(2 * time.Hour)
+	gasPrice = big.NewInt(1000000000)
+	oneCoin  = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+	halfCoin = new(big.Int).Div(oneCoin, big.NewInt(2))
+)
+
+const testPolicy = `{
+	"networks": {
+		"1337": {
+			"allowRecipients": ["0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+				"0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF"],
+			"denyContracts": ["0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384"],
+			"maxTokenAmount": {"0xF2E246BB76DF876Cef8b38ae84130F4F55De395b": "1e6"},
+			"dailyTokenCap": {"0xF2E246BB76DF876Cef8b38ae84130F4F55De395b": "1500000"},
+			"maxValue": "1.0",
+			"maxFee": "0.001",
+			"dailyValueCap": "1.2"
+		},
+		"*": {
+			"denyRecipients": ["0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df"]
+		}
+	}
+}`
+
+// writePolicy writes the policy to a temporary directory which also holds
+// the state file
+func writePolicy(t *testing.T, content string) (string, func()) {
+	dir, err := ioutil.TempDir("", "policy")
+	assert.NoError(t, err)
+	path := filepath.Join(dir, "policy.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path, func() { os.RemoveAll(dir) }
+}
+
+// tokenTransferTx builds an ERC20 transfer of the test token
+func tokenTransferTx(recipient common.Address, amount int64) *types.Transaction {
+	method := tokenTransfers[0].method
+	args, _ := method.Inputs.Pack(recipient, big.NewInt(amount))
+	return types.NewTx(&types.LegacyTx{
+		To:       &token,
+		Gas:      100000,
+		GasPrice: gasPrice,
+		Value:    new(big.Int),
+		Data:     append(append([]byte{}, method.ID...), args...),
+	})
+}
+
+// nativeTransferTx builds a plain transfer of native coins
+func nativeTransferTx(recipient common.Address, value *big.Int) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{
+		To:       &recipient,
+		Gas:      21000,
+		GasPrice: gasPrice,
+		Value:    value,
+	})
+}
+
+func TestLoadPolicy(t *testing.T) {
+	tests := []struct {
+		testName      string
+		content       string
+		expectedError string
+	}{
+		{
+			testName: "Valid policy",
+			content:  testPolicy,
+		},
+		{
+			testName:      "Unknown limit",
+			content:       `{"networks": {"1": {"maxValues": "1"}}}`,
+			expectedError: "error: policy file POLICY is invalid: json: unknown field \"maxValues\"",
+		},
+		{
+			testName:      "Invalid network",
+			content:       `{"networks": {"mainnet": {}}}`,
+			expectedError: "error: policy file POLICY: network \"mainnet\" is neither a chain ID nor *",
+		},
+		{
+			testName: "Invalid address",
+			content:  `{"networks": {"1": {"denyContracts": ["0x1234"]}}}`,
+			expectedError: "error: policy file POLICY: network 1: \"0x1234\" " +
+				"is not a valid hex address",
+		},
+		{
+			testName: "Invalid amount",
+			content:  `{"networks": {"1": {"maxFee": "-1"}}}`,
+			expectedError: "error: policy file POLICY: network 1: negative " +
+				"amount \"-1\" is not allowed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			path, cleanup := writePolicy(t, tt.content)
+			defer cleanup()
+			policy, err := LoadPolicy(path)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err, strings.Replace(tt.expectedError,
+					"POLICY", path, 1))
+				return
+			}
+			assert.NoError(t, err)
+			rules := policy.RulesOf(chainId)
+			assert.Equal(t, oneCoin, rules.MaxValue)
+			assert.Equal(t, big.NewInt(1000000), rules.MaxTokenAmount[token])
+			assert.True(t, rules.AllowRecipients[friend])
+			assert.True(t, policy.RulesOf(big.NewInt(1)).DenyRecipients[stranger])
+		})
+	}
+}
+
+func TestNewSpending(t *testing.T) {
+	spending := NewSpending(tokenTransferTx(friend, 500))
+	assert.Equal(t, []common.Address{friend}, spending.Recipients)
+	assert.Equal(t, token, *spending.Contract)
+	assert.Equal(t, big.NewInt(500), spending.TokenAmount)
+	assert.Equal(t, new(big.Int).Mul(big.NewInt(100000), gasPrice),
+		spending.Fee)
+
+	spending1 := NewSpending(nativeTransferTx(friend, oneCoin))
+	assert.Equal(t, []common.Address{friend}, spending1.Recipients)
+	assert.Nil(t, spending1.Contract)
+	assert.Nil(t, spending1.TokenAmount)
+
+	spending2 := NewSpending(types.NewTx(&types.LegacyTx{Gas: 1000000,
+		GasPrice: gasPrice, Value: new(big.Int), Data: []byte{0x60, 0x80}}))
+	assert.True(t, spending2.Creation)
+	assert.Nil(t, spending2.Recipients)
+}
+
+func TestGuardAuthorize(t *testing.T) {
+	tests := []struct {
+		testName string
+		// Transactions signed before the checked one on the test day
+		previous      []*types.Transaction
+		tx            *types.Transaction
+		now           time.Time
+		expectedError string
+	}{
+		{
+			testName: "Allowed token transfer",
+			tx:       tokenTransferTx(friend, 1000000),
+			now:      testDay,
+		},
+		{
+			testName: "Recipient not allowed and token amount too large",
+			tx:       tokenTransferTx(stranger, 1000001),
+			now:      testDay,
+			expectedError: "recipient 0x86Be6FC9B05B55CBD04F3161f9b481f27F90a8Df " +
+				"is not on the allow list\n  transfer of 1000001 base units of " +
+				"token 0xF2E246BB76DF876Cef8b38ae84130F4F55De395b exceeds the " +
+				"maximum of 1000000 per transfer",
+		},
+		{
+			testName: "Daily token cap reached",
+			previous: []*types.Transaction{tokenTransferTx(friend, 1000000)},
+			tx:       tokenTransferTx(friend, 600000),
+			now:      testDay,
+			expectedError: "transfer of 600000 base units of token " +
+				"0xF2E246BB76DF876Cef8b38ae84130F4F55De395b brings today's " +
+				"total to 1600000, over the daily cap of 1500000",
+		},
+		{
+			testName: "Daily caps start over the next day",
+			previous: []*types.Transaction{tokenTransferTx(friend, 1000000),
+				nativeTransferTx(friend, oneCoin)},
+			tx:  tokenTransferTx(friend, 600000),
+			now: nextDay,
+		},
+		{
+			testName: "Denied contract",
+			tx: types.NewTx(&types.LegacyTx{To: &blocked, Gas: 50000,
+				GasPrice: gasPrice, Value: new(big.Int), Data: []byte{1, 2, 3, 4}}),
+			now: testDay,
+			expectedError: "contract 0x59Ba9FfE3bE7E39479B39eAD755AF9994E974384 " +
+				"is on the deny list",
+		},
+		{
+			testName: "Native value over the daily cap",
+			previous: []*types.Transaction{nativeTransferTx(friend, oneCoin)},
+			tx:       nativeTransferTx(sender, halfCoin),
+			now:      testDay,
+			expectedError: "value of 500000000000000000 wei (0.5 coins) brings " +
+				"today's total to 1500000000000000000 wei (1.5 coins), over the " +
+				"daily cap of 1200000000000000000 wei (1.2 coins)",
+		},
+		{
+			testName: "Fee over the maximum",
+			tx: types.NewTx(&types.LegacyTx{To: &friend, Gas: 2000000,
+				GasPrice: gasPrice, Value: new(big.Int)}),
+			now: testDay,
+			expectedError: "fee of up to 2000000000000000 wei (0.002 coins) " +
+				"exceeds the maximum of 1000000000000000 wei (0.001 coins) per " +
+				"transaction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			path, cleanup := writePolicy(t, testPolicy)
+			defer cleanup()
+			policy, err := LoadPolicy(path)
+			assert.NoError(t, err)
+			guard := NewGuard(policy, chainId, path+".state.json")
+			guard.Now = func() time.Time { return testDay }
+			for _, tx := range tt.previous {
+				assert.NoError(t, guard.Authorize(sender, tx))
+			}
+			guard.Now = func() time.Time { return tt.now }
+			err1 := guard.Authorize(sender, tt.tx)
+			if len(tt.expectedError) != 0 {
+				assert.EqualError(t, err1, "error: the spending policy of chain "+
+					"1337 blocks the transaction of "+
+					"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf:\n  "+
+					tt.expectedError)
+				return
+			}
+			assert.NoError(t, err1)
+			// The spending of the allowed transaction is recorded
+			state, err2 := LoadState(path + ".state.json")
+			assert.NoError(t, err2)
+			today := state.Networks["1337"][sender]
+			assert.Equal(t, tt.now.Format(dayFormat), today.Day)
+			assert.Equal(t, NewSpending(tt.tx).TokenAmount, today.Tokens[token])
+		})
+	}
+}
+
+func TestGuardWithoutRules(t *testing.T) {
+	path, cleanup := writePolicy(t, `{"networks": {"1": {"maxValue": "0"}}}`)
+	defer cleanup()
+	policy, err := LoadPolicy(path)
+	assert.NoError(t, err)
+	guard := NewGuard(policy, chainId, path+".state.json")
+	assert.NoError(t, guard.Authorize(sender, nativeTransferTx(friend, oneCoin)))
+	_, err1 := os.Stat(path + ".state.json")
+	assert.True(t, os.IsNotExist(err1))
+}
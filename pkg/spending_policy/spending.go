@@ -0,0 +1,103 @@
+package spending_policy
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	abidec "go-evm-client/pkg/abi_decoder"
+	"math/big"
+)
+
+// tokenTransfer is a transfer function of the token standards, with the
+// position of its recipient and, for ERC20 tokens, of its amount
+type tokenTransfer struct {
+	method    abi.Method
+	recipient int
+	amount    int
+}
+
+// tokenTransfers are the ERC20, ERC721 and ERC1155 transfer functions whose
+// recipient is checked. ERC721 transferFrom shares its selector with the
+// ERC20 one, its token id is only taken as an amount for the tokens listed
+// with an amount limit.
+var tokenTransfers = []tokenTransfer{
+	newTokenTransfer("transfer(address,uint256)", 0, 1),
+	newTokenTransfer("transferFrom(address,address,uint256)", 1, 2),
+	newTokenTransfer("safeTransferFrom(address,address,uint256)", 1, -1),
+	newTokenTransfer("safeTransferFrom(address,address,uint256,bytes)", 1, -1),
+	newTokenTransfer(
+		"safeTransferFrom(address,address,uint256,uint256,bytes)", 1, -1),
+	newTokenTransfer(
+		"safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)", 1,
+		-1),
+}
+
+// newTokenTransfer parses the signature of a transfer function
+func newTokenTransfer(signature string, recipient int, amount int) tokenTransfer {
+	method, err := abidec.ParseSignature(signature)
+	if err != nil {
+		panic(err)
+	}
+	return tokenTransfer{method: method, recipient: recipient, amount: amount}
+}
+
+// Spending is what a transaction spends as seen by the policy
+type Spending struct {
+	// Creation is set for contract deployments, which have no recipient
+	Creation bool
+	// Contract is the contract called, nil for plain native transfers
+	Contract *common.Address
+	// Recipients are the accounts receiving the native value or tokens
+	Recipients []common.Address
+	// Value is the native value sent
+	Value *big.Int
+	// Fee is the most the transaction can pay for its gas
+	Fee *big.Int
+	// TokenAmount is the amount sent by an ERC20 transfer of the called
+	// contract, nil for other calls
+	TokenAmount *big.Int
+}
+
+// NewSpending reads what a transaction spends from its recipient, value,
+// gas and calldata
+func NewSpending(tx *types.Transaction) *Spending {
+	spending := &Spending{
+		Value: tx.Value(),
+		Fee:   new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap()),
+	}
+	if tx.To() == nil {
+		spending.Creation = true
+		return spending
+	}
+	to := *tx.To()
+	data := tx.Data()
+	if len(data) == 0 {
+		spending.Recipients = []common.Address{to}
+		return spending
+	}
+	spending.Contract = &to
+	if spending.Value.Sign() > 0 {
+		spending.Recipients = append(spending.Recipients, to)
+	}
+	for _, transfer := range tokenTransfers {
+		if len(data) < 4 || !bytes.Equal(data[:4], transfer.method.ID) {
+			continue
+		}
+		args, err := transfer.method.Inputs.Unpack(data[4:])
+		if err != nil {
+			// Malformed calldata reverts without transferring anything
+			break
+		}
+		if recipient, ok := args[transfer.recipient].(common.Address); ok {
+			spending.Recipients = append(spending.Recipients, recipient)
+		}
+		if transfer.amount != -1 {
+			if amount, ok := args[transfer.amount].(*big.Int); ok {
+				spending.TokenAmount = amount
+			}
+		}
+		break
+	}
+	return spending
+}
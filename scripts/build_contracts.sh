@@ -1,23 +1,40 @@
 
-echo "Generating the ABIs for the contracts"
-
-solcjs --abi contracts/tokens/FastTestToken.sol -o abi
-solcjs --abi contracts/tokens/DetailedTestToken.sol -o abi
-
-echo "Generating the EVM bytecode for the contracts"
-
-solcjs --bin contracts/tokens/FastTestToken.sol -o bytecode
-solcjs --bin contracts/tokens/DetailedTestToken.sol -o bytecode
+# compile writes the ABIs and the EVM bytecode of the contracts of a source
+# file compiled with solc 0.8.21. solcjs has no EVM version option, so the
+# standard JSON input pins london, the latest fork go-ethereum v1.10.8 runs.
+compile() {
+  node scripts/solc_input.js "$1" | npx -p solc@0.8.21 solcjs --standard-json | node scripts/solc_output.js abi bytecode
+}
+
+echo "Generating the ABIs and the EVM bytecode for the contracts"
+
+compile contracts/tokens/FastTestToken.sol
+compile contracts/tokens/DetailedTestToken.sol
+compile contracts/tokens/DetailedPermitToken.sol
+compile contracts/tokens/TestNFT.sol
+compile contracts/tokens/TestMultiToken.sol
+compile contracts/proxy/TransparentUpgradeableProxy.sol
+compile contracts/utils/Multicall3.sol
 
 echo "Making directories for the to be created packages"
 
 mkdir -p pkg/contracts/detailed_test_token
 mkdir -p pkg/contracts/fast_test_token
+mkdir -p pkg/contracts/test_nft
+mkdir -p pkg/contracts/test_multi_token
+mkdir -p pkg/contracts/proxy
+mkdir -p pkg/contracts/multicall3
 
 echo "Compiling the abi and bytecode for the contracts to create go packages"
 
 abigen --bin=./bytecode/contracts_tokens_FastTestToken_sol_FastTestToken.bin --abi=./abi/contracts_tokens_FastTestToken_sol_FastTestToken.abi --pkg=fast_test_token --out=pkg/contracts/fast_test_token/fast_test_token.go
 abigen --bin=./bytecode/contracts_tokens_DetailedTestToken_sol_DetailedTestToken.bin --abi=./abi/contracts_tokens_DetailedTestToken_sol_DetailedTestToken.abi --pkg=detailed_test_token --out=pkg/contracts/detailed_test_token/detailed_test_token.go
+abigen --bin=./bytecode/contracts_tokens_DetailedPermitToken_sol_DetailedPermitToken.bin --abi=./abi/contracts_tokens_DetailedPermitToken_sol_DetailedPermitToken.abi --pkg=detailed_test_token --type=DetailedPermitToken --out=pkg/contracts/detailed_test_token/detailed_permit_token.go
+abigen --bin=./bytecode/contracts_tokens_TestNFT_sol_TestNFT.bin --abi=./abi/contracts_tokens_TestNFT_sol_TestNFT.abi --pkg=test_nft --type=TestNFT --out=pkg/contracts/test_nft/test_nft.go
+abigen --bin=./bytecode/contracts_tokens_TestMultiToken_sol_TestMultiToken.bin --abi=./abi/contracts_tokens_TestMultiToken_sol_TestMultiToken.abi --pkg=test_multi_token --type=TestMultiToken --out=pkg/contracts/test_multi_token/test_multi_token.go
+abigen --bin=./bytecode/contracts_proxy_TransparentUpgradeableProxy_sol_TransparentUpgradeableProxy.bin --abi=./abi/contracts_proxy_TransparentUpgradeableProxy_sol_TransparentUpgradeableProxy.abi --pkg=proxy --type=TransparentUpgradeableProxy --out=pkg/contracts/proxy/transparent_upgradeable_proxy.go
+abigen --bin=./bytecode/contracts_proxy_TransparentUpgradeableProxy_sol_ProxyAdmin.bin --abi=./abi/contracts_proxy_TransparentUpgradeableProxy_sol_ProxyAdmin.abi --pkg=proxy --type=ProxyAdmin --out=pkg/contracts/proxy/proxy_admin.go
+abigen --bin=./bytecode/contracts_utils_Multicall3_sol_Multicall3.bin --abi=./abi/contracts_utils_Multicall3_sol_Multicall3.abi --pkg=multicall3 --type=Multicall3 --out=pkg/contracts/multicall3/multicall3.go
 
 echo "Clearing the generated bytecode files"
 
@@ -1 +1 @@
-go run cmd/contract_deployer/main.go -p "fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19" -r "http://127.0.0.1:8545" -c "detailed_test_token" -a "MintSwapToken" -a "MST" -a "100000000000000000000000000"
+go run cmd/contract_deployer/main.go -p "fad9c8855b740a0b7ed4c221dbad0f33a83a49cad6b3fe8d5817ac83d38b6a19" -r "http://127.0.0.1:8545" -c "detailed_test_token" -a "MintSwapToken" -a "MST" -a "18" -a "100000000"
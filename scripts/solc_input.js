@@ -0,0 +1,22 @@
+// Prints the solc standard JSON input compiling the given source files, it
+// is piped into `solcjs --standard-json` by build_contracts.sh.
+//
+// The EVM version is pinned to london, the latest fork run by go-ethereum
+// v1.10.8 and so by its simulated backend. solc 0.8.20 and later default to
+// shanghai, whose PUSH0 opcode the simulated backend rejects.
+const fs = require('fs');
+
+const sources = {};
+for (const file of process.argv.slice(2)) {
+  sources[file] = { content: fs.readFileSync(file, 'utf8') };
+}
+
+process.stdout.write(JSON.stringify({
+  language: 'Solidity',
+  sources,
+  settings: {
+    evmVersion: 'london',
+    optimizer: { enabled: false, runs: 200 },
+    outputSelection: { '*': { '*': ['abi', 'evm.bytecode.object'] } },
+  },
+}));
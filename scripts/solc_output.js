@@ -0,0 +1,33 @@
+// Reads the solc standard JSON output from stdin and writes the ABI and
+// the bytecode of every contract to <abi dir> and <bytecode dir>, named
+// like `solcjs --abi` and `solcjs --bin` name them:
+// contracts_tokens_TestNFT_sol_TestNFT.abi
+//
+// Usage: node solc_output.js <abi dir> <bytecode dir>
+const fs = require('fs');
+const path = require('path');
+
+const [abiDir, binDir] = process.argv.slice(2);
+const output = JSON.parse(fs.readFileSync(0, 'utf8'));
+
+let failed = false;
+for (const error of output.errors || []) {
+  console.error(error.formattedMessage);
+  if (error.severity === 'error') {
+    failed = true;
+  }
+}
+if (failed) {
+  process.exit(1);
+}
+
+for (const file in output.contracts) {
+  for (const name in output.contracts[file]) {
+    const contract = output.contracts[file][name];
+    const base = file.replace(/[/.]/g, '_') + '_' + name;
+    fs.writeFileSync(path.join(abiDir, base + '.abi'),
+      JSON.stringify(contract.abi));
+    fs.writeFileSync(path.join(binDir, base + '.bin'),
+      contract.evm.bytecode.object);
+  }
+}